@@ -13,6 +13,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	humanize "github.com/dustin/go-humanize"
 	"github.com/siderolabs/gen/slices"
 	"github.com/spf13/cobra"
 
@@ -107,6 +108,18 @@ var eventsCmd = &cobra.Command{
 							),
 						),
 					}
+				case *machine.DiskHealthEvent:
+					status := "healthy"
+					if !msg.GetHealthy() {
+						status = fmt.Sprintf("WARNINGS: %s", strings.Join(msg.GetWarnings(), ", "))
+					}
+
+					args = []interface{}{msg.GetDisk(), status}
+				case *machine.PartitionGrowthEvent:
+					args = []interface{}{
+						msg.GetPartition(),
+						fmt.Sprintf("grown %s -> %s (%s)", humanize.Bytes(msg.GetPreviousSize()), humanize.Bytes(msg.GetSize()), msg.GetMountPoint()),
+					}
 				}
 
 				args = append([]interface{}{event.Node, event.ID, event.TypeURL, event.ActorID}, args...)
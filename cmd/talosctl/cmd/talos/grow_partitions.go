@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// growPartitionsCmd represents the grow-partitions command.
+var growPartitionsCmd = &cobra.Command{
+	Use:   "grow-partitions",
+	Short: "Grow EPHEMERAL and user disk partitions to the free space after them",
+	Long: `Grows the EPHEMERAL partition (including the encrypted mapping) and the last partition of user disks
+sized to occupy the full disk along with their filesystems, without a reboot.
+
+This is useful after the disk was enlarged, e.g. in a virtual machine.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.GrowPartitions(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error growing partitions: %s", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tPARTITION\tMOUNTPOINT\tPREVIOUS SIZE\tSIZE\tGROWN")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, partition := range msg.Partitions {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%v\n",
+						node,
+						partition.Partition,
+						partition.MountPoint,
+						humanize.Bytes(partition.PreviousSize),
+						humanize.Bytes(partition.Size),
+						partition.Grown,
+					)
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	addCommand(growPartitionsCmd)
+}
@@ -6,6 +6,7 @@ package talos
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -13,19 +14,25 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/mattn/go-isatty"
 	"github.com/siderolabs/go-kubeconfig"
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	taloskubeconfig "github.com/talos-systems/talos/pkg/kubeconfig"
 	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 )
 
 var (
 	force            bool
 	forceContextName string
 	merge            bool
+	oidc             bool
+	oidcExtraScopes  []string
 )
 
 // kubeconfigCmd represents the kubeconfig command.
@@ -34,7 +41,10 @@ var kubeconfigCmd = &cobra.Command{
 	Short: "Download the admin kubeconfig from the node",
 	Long: `Download the admin kubeconfig from the node.
 If merge flag is defined, config will be merged with ~/.kube/config or [local-path] if specified.
-Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
+Otherwise kubeconfig will be written to PWD or [local-path] if specified.
+
+If oidc flag is defined, the admin credentials are replaced with the OIDC exec credential plugin (kubelogin),
+which uses the OIDC settings of the API server.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
@@ -115,6 +125,13 @@ Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
 				return err
 			}
 
+			if oidc {
+				data, err = convertToOIDC(ctx, c, data)
+				if err != nil {
+					return err
+				}
+			}
+
 			if merge {
 				return extractAndMerge(data, localPath)
 			}
@@ -124,6 +141,58 @@ Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
 	},
 }
 
+// convertToOIDC replaces the admin credentials in the kubeconfig with the OIDC exec credential plugin.
+func convertToOIDC(ctx context.Context, c *client.Client, data []byte) ([]byte, error) {
+	apiServerConfig, err := safe.StateGet[*k8s.APIServerConfig](
+		ctx,
+		c.COSI,
+		resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.APIServerConfigType, k8s.APIServerConfigID, resource.VersionUndefined),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting API server config: %w", err)
+	}
+
+	oidcConfig := apiServerConfig.TypedSpec().OIDC
+
+	if !oidcConfig.Enabled() {
+		return nil, fmt.Errorf("OIDC authentication is not configured for the API server")
+	}
+
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("current context %q is not found in the kubeconfig", config.CurrentContext)
+	}
+
+	cluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster %q is not found in the kubeconfig", kubeContext.Cluster)
+	}
+
+	var buf bytes.Buffer
+
+	if err = taloskubeconfig.GenerateOIDC(&taloskubeconfig.GenerateOIDCInput{
+		ClusterName: kubeContext.Cluster,
+		Endpoint:    cluster.Server,
+		CACert:      cluster.CertificateAuthorityData,
+
+		IssuerURL:   oidcConfig.IssuerURL,
+		ClientID:    oidcConfig.ClientID,
+		ExtraScopes: oidcExtraScopes,
+
+		Username:    "oidc",
+		ContextName: "oidc",
+	}, &buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
 func extractAndMerge(data []byte, localPath string) error {
 	config, err := clientcmd.Load(data)
 	if err != nil {
@@ -185,5 +254,7 @@ func init() {
 	kubeconfigCmd.Flags().BoolVarP(&force, "force", "f", false, "Force overwrite of kubeconfig if already present, force overwrite on kubeconfig merge")
 	kubeconfigCmd.Flags().StringVar(&forceContextName, "force-context-name", "", "Force context name for kubeconfig merge")
 	kubeconfigCmd.Flags().BoolVarP(&merge, "merge", "m", true, "Merge with existing kubeconfig")
+	kubeconfigCmd.Flags().BoolVar(&oidc, "oidc", false, "Generate kubeconfig which authenticates via OIDC exec credential plugin (kubelogin)")
+	kubeconfigCmd.Flags().StringSliceVar(&oidcExtraScopes, "oidc-extra-scope", nil, "Extra OIDC scopes to request (e.g. email, groups)")
 	addCommand(kubeconfigCmd)
 }
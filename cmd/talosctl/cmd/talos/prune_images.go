@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var pruneImagesCmdFlags struct {
+	dryRun bool
+}
+
+// pruneImagesCmd represents the prune-images command.
+var pruneImagesCmd = &cobra.Command{
+	Use:   "prune-images",
+	Short: "Remove unreferenced images from the system containerd namespace",
+	Long: `Removes images from the system containerd namespace (installer, system extension and etcd images)
+which are not used by Talos services, the machine configuration, and the current and previous Talos installation.
+
+Images pulled recently (see machine.imageGC.minAge) are kept.
+Use --dry-run to list the images which would be removed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.PruneImages(ctx, pruneImagesCmdFlags.dryRun, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error pruning images: %s", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tIMAGE\tDIGEST\tSIZE\tCREATED\tPRUNED")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, image := range msg.Images {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%v\n",
+						node,
+						image.Name,
+						image.Digest,
+						humanize.Bytes(uint64(image.Size)),
+						humanize.Time(image.CreatedAt.AsTime()),
+						!msg.DryRun,
+					)
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	pruneImagesCmd.Flags().BoolVar(&pruneImagesCmdFlags.dryRun, "dry-run", false, "list the images which would be removed without removing them")
+	addCommand(pruneImagesCmd)
+}
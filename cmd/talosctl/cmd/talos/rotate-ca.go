@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/cluster/check"
+	"github.com/talos-systems/talos/pkg/cluster/rotate"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+)
+
+var rotateCACmdFlags struct {
+	clusterState       clusterNodes
+	clusterWaitTimeout time.Duration
+	forceEndpoint      string
+	rotateTalosCA      bool
+	output             string
+}
+
+// rotateCACmd represents the rotate-ca command.
+var rotateCACmd = &cobra.Command{
+	Use:   "rotate-ca",
+	Short: "Rotate Talos API CA, Kubernetes CA and service account key in the Talos cluster.",
+	Long: `Command rotates the Kubernetes CA and the service account key in three phases:
+the new secrets are added as accepted, then they become current, and finally the old secrets are dropped.
+
+With --talos, Talos API CA is rotated the same way, Talos API certificates are re-issued by the nodes,
+and the client configuration (talosconfig) is updated with the client certificate issued by the new CA.
+
+Cluster health is verified after each phase.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := rotateCACmdFlags.clusterState.InitNodeInfos(); err != nil {
+			return err
+		}
+
+		return WithClientNoNodes(rotateCA)
+	},
+}
+
+var rotateCAOptions rotate.KubernetesCAOptions
+
+func init() {
+	rotateCACmd.Flags().BoolVar(&rotateCAOptions.RotateCA, "kubernetes-ca", true, "rotate Kubernetes CA")
+	rotateCACmd.Flags().BoolVar(&rotateCAOptions.RotateServiceAccount, "service-account", true, "rotate Kubernetes service account key")
+	rotateCACmd.Flags().BoolVar(&rotateCACmdFlags.rotateTalosCA, "talos", false, "rotate Talos API CA")
+	rotateCACmd.Flags().StringVarP(&rotateCACmdFlags.output, "output", "o", "", "path to write the updated talosconfig to (defaults to updating the current talosconfig in place)")
+	rotateCACmd.Flags().BoolVar(&rotateCAOptions.DryRun, "dry-run", false, "skip the actual rotation and show the rotation plan instead")
+	rotateCACmd.Flags().StringVar(&rotateCACmdFlags.clusterState.InitNode, "init-node", "", "specify IPs of init node")
+	rotateCACmd.Flags().StringSliceVar(&rotateCACmdFlags.clusterState.ControlPlaneNodes, "control-plane-nodes", nil, "specify IPs of control plane nodes")
+	rotateCACmd.Flags().StringSliceVar(&rotateCACmdFlags.clusterState.WorkerNodes, "worker-nodes", nil, "specify IPs of worker nodes")
+	rotateCACmd.Flags().DurationVar(&rotateCACmdFlags.clusterWaitTimeout, "wait-timeout", time.Hour, "timeout to wait for the rotation to complete")
+	rotateCACmd.Flags().StringVar(&rotateCACmdFlags.forceEndpoint, "k8s-endpoint", "", "use endpoint instead of kubeconfig default")
+	addCommand(rotateCACmd)
+}
+
+func rotateCA(ctx context.Context, c *client.Client) error {
+	if !rotateCACmdFlags.rotateTalosCA && !rotateCAOptions.RotateCA && !rotateCAOptions.RotateServiceAccount {
+		return fmt.Errorf("nothing to rotate")
+	}
+
+	if err := helpers.ClientVersionCheck(ctx, c); err != nil {
+		return err
+	}
+
+	clusterInfo, err := buildClusterInfo(rotateCACmdFlags.clusterState)
+	if err != nil {
+		return err
+	}
+
+	rotateCtx, rotateCtxCancel := context.WithTimeout(ctx, rotateCACmdFlags.clusterWaitTimeout)
+	defer rotateCtxCancel()
+
+	// Kubernetes secrets are rotated first, as the Talos API CA rotation invalidates the current client
+	if rotateCAOptions.RotateCA || rotateCAOptions.RotateServiceAccount {
+		if err = rotateKubernetesCA(rotateCtx, c, clusterInfo); err != nil {
+			return err
+		}
+	}
+
+	if rotateCACmdFlags.rotateTalosCA {
+		return rotateTalosCA(rotateCtx, c, clusterInfo)
+	}
+
+	return nil
+}
+
+func rotateKubernetesCA(ctx context.Context, c *client.Client, clusterInfo cluster.Info) error {
+	clientProvider := &cluster.ConfigClientProvider{
+		DefaultClient: c,
+	}
+	defer clientProvider.Close() //nolint:errcheck
+
+	state := struct {
+		cluster.ClientProvider
+		cluster.K8sProvider
+		cluster.Info
+	}{
+		ClientProvider: clientProvider,
+		K8sProvider: &cluster.KubernetesClient{
+			ClientProvider: clientProvider,
+			ForceEndpoint:  rotateCACmdFlags.forceEndpoint,
+		},
+		Info: clusterInfo,
+	}
+
+	rotateCAOptions.Reporter = check.StderrReporter()
+
+	return rotate.KubernetesCA(ctx, &state, rotateCAOptions)
+}
+
+func rotateTalosCA(ctx context.Context, c *client.Client, clusterInfo cluster.Info) error {
+	cfg, err := clientconfig.Open(GlobalArgs.Talosconfig)
+	if err != nil {
+		return fmt.Errorf("failed to open config file %q: %w", GlobalArgs.Talosconfig, err)
+	}
+
+	contextName := cfg.Context
+	if GlobalArgs.CmdContext != "" {
+		contextName = GlobalArgs.CmdContext
+	}
+
+	configContext := cfg.Contexts[contextName]
+	if configContext == nil {
+		return fmt.Errorf("context %q is not defined in %q", contextName, cfg.Path().Path)
+	}
+
+	newContext, err := rotate.TalosCA(ctx, rotate.TalosCAOptions{
+		CurrentClient:      c,
+		TalosConfigContext: configContext,
+		ClusterInfo:        clusterInfo,
+		Endpoints:          GlobalArgs.Endpoints,
+		ForceEndpoint:      rotateCACmdFlags.forceEndpoint,
+		Options: rotate.Options{
+			Reporter: check.StderrReporter(),
+			DryRun:   rotateCAOptions.DryRun,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if rotateCAOptions.DryRun {
+		return nil
+	}
+
+	cfg.Contexts[contextName] = newContext
+
+	if err = cfg.Save(rotateCACmdFlags.output); err != nil {
+		return fmt.Errorf("error saving talosconfig: %w", err)
+	}
+
+	fmt.Printf("rotation is done, talosconfig %q is updated\n", cfg.Path().Path)
+
+	return nil
+}
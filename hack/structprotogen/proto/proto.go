@@ -437,6 +437,8 @@ func formatBasicTypeName(typPkg string, typ string) (importPath, fullName string
 		return enumsProto, "talos.resource.definitions.enums.NetworkOperator"
 	case typeData{"github.com/talos-systems/talos/pkg/machinery/nethelpers", "Family"}:
 		return enumsProto, "talos.resource.definitions.enums.NethelpersFamily"
+	case typeData{"github.com/talos-systems/talos/pkg/machinery/nethelpers", "IPv6Mode"}:
+		return enumsProto, "talos.resource.definitions.enums.NethelpersIPv6Mode"
 	case typeData{"github.com/talos-systems/talos/pkg/machinery/nethelpers", "AddressFlags"}:
 		return "", "uint32"
 	case typeData{"github.com/talos-systems/talos/pkg/machinery/nethelpers", "Scope"}:
@@ -8,6 +8,7 @@ package provider
 import (
 	"context"
 	stdlibtls "crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"log"
 	"sync"
@@ -15,10 +16,14 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/crypto/tls"
+	"github.com/siderolabs/gen/slices"
 
 	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
 )
 
+// alpnProtoH2 is the ALPN protocol used by gRPC.
+const alpnProtoH2 = "h2"
+
 // TLSConfig provides client & server TLS configs for apid.
 type TLSConfig struct {
 	certificateProvider *certificateProvider
@@ -71,20 +76,42 @@ func NewTLSConfig(resources state.State) (*TLSConfig, error) {
 }
 
 // ServerConfig generates server-side tls.Config.
+//
+// The pool of trusted client CAs is refreshed on each handshake, as the accepted CAs might change (e.g. during the CA rotation).
 func (tlsConfig *TLSConfig) ServerConfig() (*stdlibtls.Config, error) {
 	ca, err := tlsConfig.certificateProvider.GetCA()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get root CA: %w", err)
 	}
 
-	return tls.New(
+	cfg, err := tls.New(
 		tls.WithClientAuthType(tls.Mutual),
 		tls.WithCACertPEM(ca),
 		tls.WithServerCertificateProvider(tlsConfig.certificateProvider),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.GetConfigForClient = func(*stdlibtls.ClientHelloInfo) (*stdlibtls.Config, error) {
+		handshakeCfg := cfg.Clone()
+		handshakeCfg.GetConfigForClient = nil
+		handshakeCfg.ClientCAs = tlsConfig.certificateProvider.GetCAPool()
+
+		// gRPC adds "h2" to the original config only
+		if !slices.Contains(handshakeCfg.NextProtos, func(proto string) bool { return proto == alpnProtoH2 }) {
+			handshakeCfg.NextProtos = append(handshakeCfg.NextProtos, alpnProtoH2)
+		}
+
+		return handshakeCfg, nil
+	}
+
+	return cfg, nil
 }
 
 // ClientConfig generates client-side tls.Config.
+//
+// Server certificates are verified against the current pool of trusted CAs, as the accepted CAs might change (e.g. during the CA rotation).
 func (tlsConfig *TLSConfig) ClientConfig() (*stdlibtls.Config, error) {
 	if !tlsConfig.certificateProvider.HasClientCertificate() {
 		return nil, nil
@@ -95,11 +122,20 @@ func (tlsConfig *TLSConfig) ClientConfig() (*stdlibtls.Config, error) {
 		return nil, fmt.Errorf("failed to get root CA: %w", err)
 	}
 
-	return tls.New(
+	cfg, err := tls.New(
 		tls.WithClientAuthType(tls.Mutual),
 		tls.WithCACertPEM(ca),
 		tls.WithClientCertificateProvider(tlsConfig.certificateProvider),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// default verification is replaced with VerifyConnection below
+	cfg.InsecureSkipVerify = true
+	cfg.VerifyConnection = tlsConfig.certificateProvider.verifyServerConnection
+
+	return cfg, nil
 }
 
 type certificateProvider struct {
@@ -107,6 +143,8 @@ type certificateProvider struct {
 
 	apiCerts               *secrets.API
 	clientCert, serverCert *stdlibtls.Certificate
+	ca                     []byte
+	caPool                 *x509.CertPool
 }
 
 func (p *certificateProvider) Update(apiCerts *secrets.API) error {
@@ -122,6 +160,19 @@ func (p *certificateProvider) Update(apiCerts *secrets.API) error {
 
 	p.serverCert = &serverCert
 
+	p.ca = append([]byte(nil), p.apiCerts.TypedSpec().CA.Crt...)
+
+	for _, acceptedCA := range p.apiCerts.TypedSpec().AcceptedCAs {
+		p.ca = append(p.ca, '\n')
+		p.ca = append(p.ca, acceptedCA.Crt...)
+	}
+
+	p.caPool = x509.NewCertPool()
+
+	if !p.caPool.AppendCertsFromPEM(p.ca) {
+		return fmt.Errorf("failed to parse CA certificates")
+	}
+
 	if p.apiCerts.TypedSpec().Client != nil {
 		clientCert, err := stdlibtls.X509KeyPair(p.apiCerts.TypedSpec().Client.Crt, p.apiCerts.TypedSpec().Client.Key)
 		if err != nil {
@@ -140,7 +191,34 @@ func (p *certificateProvider) GetCA() ([]byte, error) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	return p.apiCerts.TypedSpec().CA.Crt, nil
+	return p.ca, nil
+}
+
+func (p *certificateProvider) GetCAPool() *x509.CertPool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.caPool
+}
+
+func (p *certificateProvider) verifyServerConnection(cs stdlibtls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return fmt.Errorf("no server certificate")
+	}
+
+	opts := x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         p.GetCAPool(),
+		Intermediates: x509.NewCertPool(),
+	}
+
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(opts)
+
+	return err
 }
 
 func (p *certificateProvider) GetCertificate(h *stdlibtls.ClientHelloInfo) (*stdlibtls.Certificate, error) {
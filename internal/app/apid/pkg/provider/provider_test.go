@@ -4,11 +4,118 @@
 
 package provider_test
 
-import "testing"
+import (
+	"context"
+	stdlibtls "crypto/tls"
+	stdlibx509 "crypto/x509"
+	"net"
+	"testing"
+	"time"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/apid/pkg/provider"
+	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
+)
+
+func newCA(t *testing.T) *x509.CertificateAuthority {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.ECDSA(true))
+	require.NoError(t, err)
+
+	return ca
+}
+
+func newCert(t *testing.T, ca *x509.CertificateAuthority, extKeyUsage stdlibx509.ExtKeyUsage) *x509.PEMEncodedCertificateAndKey {
+	keyPair, err := x509.NewKeyPair(ca,
+		x509.IPAddresses([]net.IP{net.ParseIP("127.0.0.1")}),
+		x509.NotAfter(time.Now().Add(time.Hour)),
+		x509.KeyUsage(stdlibx509.KeyUsageDigitalSignature),
+		x509.ExtKeyUsage([]stdlibx509.ExtKeyUsage{extKeyUsage}),
+	)
+	require.NoError(t, err)
+
+	return x509.NewCertificateAndKeyFromKeyPair(keyPair)
+}
+
+// handshake performs TLS handshake between the server with the given config and a client
+// which presents a client certificate issued by the clientCA.
+func handshake(t *testing.T, serverCfg *stdlibtls.Config, serverCA, clientCA *x509.CertificateAuthority) error {
+	clientCert := newCert(t, clientCA, stdlibx509.ExtKeyUsageClientAuth)
+
+	cert, err := stdlibtls.X509KeyPair(clientCert.Crt, clientCert.Key)
+	require.NoError(t, err)
+
+	roots := stdlibx509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(serverCA.CrtPEM))
+
+	serverConn, clientConn := net.Pipe()
+
+	defer serverConn.Close() //nolint:errcheck
+	defer clientConn.Close() //nolint:errcheck
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- stdlibtls.Server(serverConn, serverCfg).Handshake()
+
+		serverConn.Close() //nolint:errcheck
+	}()
+
+	clientErr := stdlibtls.Client(clientConn, &stdlibtls.Config{
+		ServerName:   "127.0.0.1",
+		RootCAs:      roots,
+		Certificates: []stdlibtls.Certificate{cert},
+		NextProtos:   []string{"h2"},
+	}).Handshake()
+
+	clientConn.Close() //nolint:errcheck
+
+	if serverErr := <-errCh; serverErr != nil {
+		return serverErr
+	}
+
+	return clientErr
+}
+
+func TestAcceptedCAs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	ca, acceptedCA, unknownCA := newCA(t), newCA(t), newCA(t)
+
+	apiCerts := secrets.NewAPI()
+	apiCerts.TypedSpec().CA = &x509.PEMEncodedCertificateAndKey{Crt: ca.CrtPEM}
+	apiCerts.TypedSpec().AcceptedCAs = []*x509.PEMEncodedCertificateAndKey{{Crt: acceptedCA.CrtPEM}}
+	apiCerts.TypedSpec().Server = newCert(t, ca, stdlibx509.ExtKeyUsageServerAuth)
+	apiCerts.TypedSpec().Client = newCert(t, ca, stdlibx509.ExtKeyUsageClientAuth)
+
+	require.NoError(t, st.Create(ctx, apiCerts))
+
+	tlsConfig, err := provider.NewTLSConfig(st)
+	require.NoError(t, err)
+
+	serverCfg, err := tlsConfig.ServerConfig()
+	require.NoError(t, err)
+
+	assert.NoError(t, handshake(t, serverCfg, ca, ca))
+	assert.NoError(t, handshake(t, serverCfg, ca, acceptedCA))
+	assert.Error(t, handshake(t, serverCfg, ca, unknownCA))
+
+	// drop the accepted CA
+	apiCerts.TypedSpec().AcceptedCAs = nil
+
+	require.NoError(t, st.Update(ctx, apiCerts))
+
+	assert.Eventually(t, func() bool {
+		return handshake(t, serverCfg, ca, acceptedCA) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, handshake(t, serverCfg, ca, ca))
 }
@@ -48,6 +48,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	installer "github.com/talos-systems/talos/cmd/installer/pkg/install"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
@@ -62,6 +63,7 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/containers"
 	taloscontainerd "github.com/talos-systems/talos/internal/pkg/containers/containerd"
 	"github.com/talos-systems/talos/internal/pkg/containers/cri"
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/internal/pkg/install"
 	"github.com/talos-systems/talos/internal/pkg/miniprocfs"
@@ -406,6 +408,81 @@ func (s *Server) Rollback(ctx context.Context, in *machine.RollbackRequest) (*ma
 	}, nil
 }
 
+// GrowPartitions implements the machine.MachineServer interface.
+func (s *Server) GrowPartitions(ctx context.Context, in *machine.GrowPartitionsRequest) (*machine.GrowPartitionsResponse, error) {
+	log.Printf("grow partitions via API received")
+
+	if err := s.checkSupported(runtime.GrowPartitions); err != nil {
+		return nil, err
+	}
+
+	growths, err := mount.GrowPartitions(ctx, s.Controller.Runtime())
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := make([]*machine.PartitionGrowth, 0, len(growths))
+
+	for _, growth := range growths {
+		partitions = append(partitions, &machine.PartitionGrowth{
+			Partition:    growth.Partition,
+			DevPath:      growth.DevPath,
+			MountPoint:   growth.MountPoint,
+			Encrypted:    growth.Encrypted,
+			PreviousSize: growth.PreviousSize,
+			Size:         growth.Size,
+			Grown:        growth.Grown(),
+		})
+	}
+
+	return &machine.GrowPartitionsResponse{
+		Messages: []*machine.GrowPartitions{
+			{
+				Partitions: partitions,
+			},
+		},
+	}, nil
+}
+
+// PruneImages implements the machine.MachineServer interface.
+func (s *Server) PruneImages(ctx context.Context, in *machine.PruneImagesRequest) (*machine.PruneImagesResponse, error) {
+	log.Printf("prune images via API received (dry run: %v)", in.GetDryRun())
+
+	cfg := s.Controller.Runtime().Config()
+	minAge := constants.DefaultImageGCMinAge
+
+	if cfg != nil {
+		minAge = cfg.Machine().ImageGC().MinAge()
+	}
+
+	referenced := image.SystemReferences(cfg, s.Controller.Runtime().State().Machine().InstallImageRefs())
+
+	pruned, err := image.GarbageCollectSystem(ctx, referenced, minAge, in.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	images := make([]*machine.PrunedImage, 0, len(pruned))
+
+	for _, img := range pruned {
+		images = append(images, &machine.PrunedImage{
+			Name:      img.Name,
+			Digest:    img.Digest,
+			Size:      img.Size,
+			CreatedAt: timestamppb.New(img.CreatedAt),
+		})
+	}
+
+	return &machine.PruneImagesResponse{
+		Messages: []*machine.PruneImages{
+			{
+				Images: images,
+				DryRun: in.GetDryRun(),
+			},
+		},
+	}, nil
+}
+
 // Bootstrap implements the machine.MachineServer interface.
 func (s *Server) Bootstrap(ctx context.Context, in *machine.BootstrapRequest) (reply *machine.BootstrapResponse, err error) {
 	log.Printf("bootstrap request received")
@@ -493,7 +570,12 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (reply
 
 	log.Printf("validating %q", in.GetImage())
 
-	if err = install.PullAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), in.GetImage()); err != nil {
+	if err = install.PullAndValidateInstallerImage(
+		ctx,
+		s.Controller.Runtime().Config().Machine().Registries(),
+		in.GetImage(),
+		image.WithCredentialProviderConfig(s.Controller.Runtime().Config().Machine().Kubelet().CredentialProviderConfig()),
+	); err != nil {
 		return nil, fmt.Errorf("error validating installer image %q: %w", in.GetImage(), err)
 	}
 
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/siderolabs/go-blockdevice/blockdevice/util/disk"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// DiskSpec adapter provides matching of disks against the disk selectors.
+//
+//nolint:revive,golint
+func DiskSpec(r *block.DiskSpec) diskSpec {
+	return diskSpec{
+		DiskSpec: r,
+	}
+}
+
+type diskSpec struct {
+	*block.DiskSpec
+}
+
+// Disk converts the spec to the disk representation used by the disk matchers.
+func (a diskSpec) Disk() *disk.Disk {
+	diskType := disk.TypeSSD
+
+	switch {
+	case a.Transport == "nvme":
+		diskType = disk.TypeNVMe
+	case a.Transport == "mmc":
+		diskType = disk.TypeSD
+	case a.Rotational:
+		diskType = disk.TypeHDD
+	}
+
+	return &disk.Disk{
+		Size:       a.Size,
+		Model:      a.Model,
+		DeviceName: a.DevPath,
+		Name:       a.Name,
+		Serial:     a.Serial,
+		Modalias:   a.Modalias,
+		WWID:       a.WWID,
+		UUID:       a.UUID,
+		Type:       diskType,
+		BusPath:    a.BusPath,
+		ReadOnly:   a.ReadOnly,
+	}
+}
+
+// Matches checks whether the disk matches all the matchers.
+func (a diskSpec) Matches(matchers ...disk.Matcher) bool {
+	return disk.Match(a.Disk(), matchers...)
+}
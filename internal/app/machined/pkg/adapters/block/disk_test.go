@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/go-blockdevice/blockdevice/util/disk"
+	"github.com/stretchr/testify/assert"
+
+	blockadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/block"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+func TestDiskSpecMatches(t *testing.T) {
+	hdd := block.DiskSpec{
+		DevPath:    "/dev/sda",
+		Size:       1000204886016,
+		Model:      "ST1000DM010-2EP1",
+		Serial:     "Z9A1B2C3",
+		WWID:       "naa.5000c500b1f5e3c4",
+		BusPath:    "/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/",
+		Transport:  "sata",
+		Rotational: true,
+	}
+
+	nvme := block.DiskSpec{
+		DevPath:   "/dev/nvme0n1",
+		Size:      1000204886016,
+		Model:     "Samsung SSD 970 EVO Plus 1TB",
+		Transport: "nvme",
+	}
+
+	ssd := block.DiskSpec{
+		DevPath:   "/dev/sdb",
+		Size:      256060514304,
+		Transport: "sata",
+	}
+
+	assert.Equal(t, disk.TypeHDD, blockadapter.DiskSpec(&hdd).Disk().Type)
+	assert.Equal(t, disk.TypeNVMe, blockadapter.DiskSpec(&nvme).Disk().Type)
+	assert.Equal(t, disk.TypeSSD, blockadapter.DiskSpec(&ssd).Disk().Type)
+
+	assert.True(t, blockadapter.DiskSpec(&hdd).Matches(disk.WithModel("ST1000*"), disk.WithType(disk.TypeHDD)))
+	assert.True(t, blockadapter.DiskSpec(&hdd).Matches(disk.WithBusPath("/pci0000:00/0000:00:1f.2/*")))
+	assert.False(t, blockadapter.DiskSpec(&hdd).Matches(disk.WithModel("ST1000*"), disk.WithType(disk.TypeSSD)))
+
+	assert.True(t, blockadapter.DiskSpec(&nvme).Matches(disk.WithModel("Samsung*")))
+	assert.False(t, blockadapter.DiskSpec(&ssd).Matches(disk.WithModel("Samsung*")))
+
+	// no matchers match any disk
+	assert.True(t, blockadapter.DiskSpec(&ssd).Matches())
+}
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/yaml"
 
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 )
 
@@ -74,6 +75,20 @@ func (a manifest) SetYAML(yamlBytes []byte) error {
 	return nil
 }
 
+// SetInventoryLabel labels all objects in the manifest with the Talos manifest inventory label, so that they are pruned once removed.
+func (a manifest) SetInventoryLabel() {
+	for _, obj := range a.Objects() {
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+
+		labels[constants.KubernetesManifestInventoryLabel] = constants.KubernetesManifestInventory
+
+		obj.SetLabels(labels)
+	}
+}
+
 // Objects returns list of unstructured object.
 func (a manifest) Objects() []*unstructured.Unstructured {
 	return slices.Map(a.Manifest.TypedSpec().Items, func(item k8s.SingleManifest) *unstructured.Unstructured {
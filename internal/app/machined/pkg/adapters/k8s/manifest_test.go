@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	k8sadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 )
 
@@ -50,3 +51,30 @@ rules:
 	assert.Len(t, adapter.Objects(), 1)
 	assert.Equal(t, adapter.Objects()[0].GetKind(), "Policy")
 }
+
+func TestManifestSetInventoryLabel(t *testing.T) {
+	manifest := k8s.NewManifest(k8s.ControlPlaneNamespaceName, "test")
+	adapter := k8sadapter.Manifest(manifest)
+
+	require.NoError(t, adapter.SetYAML([]byte(strings.TrimSpace(`
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: foo
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: bar
+  namespace: foo
+  labels:
+    app: bar
+`))))
+
+	adapter.SetInventoryLabel()
+
+	assert.Len(t, adapter.Objects(), 2)
+	assert.Equal(t, map[string]string{constants.KubernetesManifestInventoryLabel: constants.KubernetesManifestInventory}, adapter.Objects()[0].GetLabels())
+	assert.Equal(t, map[string]string{"app": "bar", constants.KubernetesManifestInventoryLabel: constants.KubernetesManifestInventory}, adapter.Objects()[1].GetLabels())
+}
@@ -0,0 +1,179 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package block provides controllers which manage block device related resources.
+package block
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/uevent"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// DevicesController populates Disk, Partition and Filesystem resources from sysfs and udev database.
+//
+// The controller rescans block devices on each block subsystem uevent, DiscoveryStatus is ready after the first scan.
+type DevicesController struct {
+	V1Alpha1Mode runtimetalos.Mode
+
+	SysfsPath    string
+	UdevDataPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DevicesController) Name() string {
+	return "block.DevicesController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DevicesController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DevicesController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.DiskType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: block.PartitionType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: block.FilesystemType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: block.DiscoveryStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *DevicesController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// block devices are not managed by Talos in container mode
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	s := scanner{
+		sysfsPath:    ctrl.SysfsPath,
+		udevDataPath: ctrl.UdevDataPath,
+	}
+
+	// kernel events trigger the rescan as soon as the device appears,
+	// udev events trigger the rescan once udev database is updated for the device
+	watcher, err := uevent.NewWatcher(r, uevent.GroupKernel|uevent.GroupUdev, "block")
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		inv, err := s.scan()
+		if err != nil {
+			return err
+		}
+
+		if err = ctrl.reconcile(ctx, r, inv); err != nil {
+			return err
+		}
+
+		if err = r.Modify(ctx, block.NewDiscoveryStatus(block.NamespaceName, block.DiscoveryStatusID), func(res resource.Resource) error {
+			res.(*block.DiscoveryStatus).TypedSpec().Ready = true
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating discovery status: %w", err)
+		}
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *DevicesController) reconcile(ctx context.Context, r controller.Runtime, inv *inventory) error {
+	touched := map[resource.Type]map[resource.ID]struct{}{
+		block.DiskType:       {},
+		block.PartitionType:  {},
+		block.FilesystemType: {},
+	}
+
+	for id, spec := range inv.disks {
+		spec := spec
+
+		if err := r.Modify(ctx, block.NewDisk(block.NamespaceName, id), func(res resource.Resource) error {
+			*res.(*block.Disk).TypedSpec() = spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating disk: %w", err)
+		}
+
+		touched[block.DiskType][id] = struct{}{}
+	}
+
+	for id, spec := range inv.partitions {
+		spec := spec
+
+		if err := r.Modify(ctx, block.NewPartition(block.NamespaceName, id), func(res resource.Resource) error {
+			*res.(*block.Partition).TypedSpec() = spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating partition: %w", err)
+		}
+
+		touched[block.PartitionType][id] = struct{}{}
+	}
+
+	for id, spec := range inv.filesystems {
+		spec := spec
+
+		if err := r.Modify(ctx, block.NewFilesystem(block.NamespaceName, id), func(res resource.Resource) error {
+			*res.(*block.Filesystem).TypedSpec() = spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating filesystem: %w", err)
+		}
+
+		touched[block.FilesystemType][id] = struct{}{}
+	}
+
+	// clean up resources of devices which are gone
+	for resourceType, ids := range touched {
+		list, err := r.List(ctx, resource.NewMetadata(block.NamespaceName, resourceType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if _, ok := ids[res.Metadata().ID()]; ok {
+				continue
+			}
+
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up %s: %w", res.Metadata(), err)
+			}
+		}
+	}
+
+	return nil
+}
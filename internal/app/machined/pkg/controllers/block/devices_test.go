@@ -0,0 +1,230 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	blockctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+type DevicesSuite struct {
+	ctest.DefaultSuite
+}
+
+// buildSysfs creates a fake sysfs and udev database with a SATA disk (two partitions), an NVMe disk
+// with a filesystem on the whole disk and a loop device.
+func buildSysfs(t *testing.T) (sysfsPath, udevDataPath string) {
+	root := t.TempDir()
+
+	sysfsPath = filepath.Join(root, "sys")
+	udevDataPath = filepath.Join(root, "udev")
+
+	write := func(path, contents string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(contents+"\n"), 0o644))
+	}
+
+	link := func(target, name string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(sysfsPath, "block"), 0o755))
+		require.NoError(t, os.Symlink(target, filepath.Join(sysfsPath, "block", name)))
+	}
+
+	// SATA HDD
+	sda := "devices/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/block/sda"
+	sdaPath := filepath.Join(sysfsPath, sda)
+
+	write(filepath.Join(sdaPath, "size"), "209715200")
+	write(filepath.Join(sdaPath, "ro"), "0")
+	write(filepath.Join(sdaPath, "dev"), "8:0")
+	write(filepath.Join(sdaPath, "queue", "rotational"), "1")
+	write(filepath.Join(sdaPath, "device", "model"), "ST1000DM010-2EP1")
+	write(filepath.Join(sdaPath, "device", "wwid"), "naa.5000c500b1f5e3c4")
+
+	write(filepath.Join(sdaPath, "sda1", "partition"), "1")
+	write(filepath.Join(sdaPath, "sda1", "start"), "2048")
+	write(filepath.Join(sdaPath, "sda1", "size"), "204800")
+	write(filepath.Join(sdaPath, "sda1", "dev"), "8:1")
+	write(filepath.Join(sdaPath, "sda1", "uevent"), "MAJOR=8\nMINOR=1\nDEVNAME=sda1\nDEVTYPE=partition\nPARTN=1\nPARTNAME=EFI")
+
+	write(filepath.Join(sdaPath, "sda2", "partition"), "2")
+	write(filepath.Join(sdaPath, "sda2", "start"), "206848")
+	write(filepath.Join(sdaPath, "sda2", "size"), "209508352")
+	write(filepath.Join(sdaPath, "sda2", "dev"), "8:2")
+	write(filepath.Join(sdaPath, "sda2", "uevent"), "MAJOR=8\nMINOR=2\nDEVNAME=sda2\nDEVTYPE=partition\nPARTN=2")
+
+	link("../"+sda, "sda")
+
+	write(filepath.Join(udevDataPath, "b8:0"), "S:disk/by-id/ata-ST1000DM010\nE:ID_BUS=ata\nE:ID_SERIAL_SHORT=Z9A1B2C3\nE:ID_PART_TABLE_TYPE=gpt\nE:ID_PART_TABLE_UUID=0b1bd5a4-3c2e-4d4e-9b4b-7f1f7a7b0c11\nG:systemd")
+	write(filepath.Join(udevDataPath, "b8:1"),
+		"E:ID_PART_ENTRY_UUID=3e2b1c9a-aaaa-4b4b-8c8c-000000000001\nE:ID_PART_ENTRY_NAME=EFI\nE:ID_PART_ENTRY_TYPE=c12a7328-f81f-11d2-ba4b-00a0c93ec93b\n"+
+			"E:ID_FS_TYPE=vfat\nE:ID_FS_UUID=1A2B-3C4D\nE:ID_FS_LABEL=EFI_SYSTEM\nE:ID_FS_LABEL_ENC=EFI\\x20SYSTEM\nE:ID_FS_VERSION=FAT32")
+	write(filepath.Join(udevDataPath, "b8:2"),
+		"E:ID_PART_ENTRY_UUID=3e2b1c9a-aaaa-4b4b-8c8c-000000000002\nE:ID_PART_ENTRY_NAME=my\\x20data\nE:ID_PART_ENTRY_TYPE=0fc63daf-8483-4772-8e79-3d69d8477de4")
+
+	// NVMe SSD without partitions
+	nvme := "devices/pci0000:00/0000:00:1d.0/0000:3d:00.0/nvme/nvme0/nvme0n1"
+	nvmePath := filepath.Join(sysfsPath, nvme)
+
+	write(filepath.Join(nvmePath, "size"), "1953525168")
+	write(filepath.Join(nvmePath, "ro"), "0")
+	write(filepath.Join(nvmePath, "dev"), "259:0")
+	write(filepath.Join(nvmePath, "queue", "rotational"), "0")
+	write(filepath.Join(nvmePath, "wwid"), "eui.0025385b71b0a1c2")
+	write(filepath.Join(nvmePath, "device", "model"), "Samsung SSD 970 EVO Plus 1TB")
+	write(filepath.Join(nvmePath, "device", "serial"), "S4EWNX0R123456")
+
+	link("../"+nvme, "nvme0n1")
+
+	write(filepath.Join(udevDataPath, "b259:0"), "E:ID_FS_TYPE=xfs\nE:ID_FS_UUID=6c6e2f7a-2b1d-4b8e-9f3e-2a1b0c9d8e7f\nE:ID_FS_LABEL=scratch")
+
+	// loop device, skipped
+	loop := "devices/virtual/block/loop0"
+
+	write(filepath.Join(sysfsPath, loop, "size"), "1024")
+	link("../"+loop, "loop0")
+
+	return sysfsPath, udevDataPath
+}
+
+func (suite *DevicesSuite) TestDiscovery() {
+	suite.AssertWithin(5*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		sda, err := ctest.Get[*block.Disk](suite, block.NewDisk(block.NamespaceName, "sda").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(block.DiskSpec{
+			DevPath:            "/dev/sda",
+			Size:               107374182400,
+			PrettySize:         "107 GB",
+			Model:              "ST1000DM010-2EP1",
+			Serial:             "Z9A1B2C3",
+			WWID:               "naa.5000c500b1f5e3c4",
+			BusPath:            "/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/",
+			Transport:          "sata",
+			Rotational:         true,
+			PartitionTableType: "gpt",
+			PartitionTableUUID: "0b1bd5a4-3c2e-4d4e-9b4b-7f1f7a7b0c11",
+		}, *sda.TypedSpec())
+
+		nvme, err := ctest.Get[*block.Disk](suite, block.NewDisk(block.NamespaceName, "nvme0n1").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal("/dev/nvme0n1", nvme.TypedSpec().DevPath)
+		assert.Equal("nvme", nvme.TypedSpec().Transport)
+		assert.False(nvme.TypedSpec().Rotational)
+		assert.Equal("S4EWNX0R123456", nvme.TypedSpec().Serial)
+		assert.Equal("eui.0025385b71b0a1c2", nvme.TypedSpec().WWID)
+
+		_, err = ctest.Get[*block.Disk](suite, block.NewDisk(block.NamespaceName, "loop0").Metadata())
+		assert.True(state.IsNotFoundError(err))
+
+		status, err := ctest.Get[*block.DiscoveryStatus](suite, block.NewDiscoveryStatus(block.NamespaceName, block.DiscoveryStatusID).Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.True(status.TypedSpec().Ready)
+
+		sda1, err := ctest.Get[*block.Partition](suite, block.NewPartition(block.NamespaceName, "sda1").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(block.PartitionSpec{
+			DevPath:        "/dev/sda1",
+			Parent:         "sda",
+			Number:         1,
+			Start:          1048576,
+			Size:           104857600,
+			PrettySize:     "105 MB",
+			PartitionUUID:  "3e2b1c9a-aaaa-4b4b-8c8c-000000000001",
+			PartitionLabel: "EFI",
+			TypeUUID:       "c12a7328-f81f-11d2-ba4b-00a0c93ec93b",
+		}, *sda1.TypedSpec())
+
+		sda2, err := ctest.Get[*block.Partition](suite, block.NewPartition(block.NamespaceName, "sda2").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		// label is decoded from the udev database if the kernel doesn't report it
+		assert.Equal("my data", sda2.TypedSpec().PartitionLabel)
+		assert.EqualValues(2, sda2.TypedSpec().Number)
+
+		filesystems, err := suite.State().List(suite.Ctx(), resource.NewMetadata(block.NamespaceName, block.FilesystemType, "", resource.VersionUndefined))
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Len(filesystems.Items, 2)
+
+		efi, err := ctest.Get[*block.Filesystem](suite, block.NewFilesystem(block.NamespaceName, "sda1").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(block.FilesystemSpec{
+			DevPath: "/dev/sda1",
+			Type:    "vfat",
+			UUID:    "1A2B-3C4D",
+			Label:   "EFI SYSTEM",
+			Version: "FAT32",
+		}, *efi.TypedSpec())
+
+		scratch, err := ctest.Get[*block.Filesystem](suite, block.NewFilesystem(block.NamespaceName, "nvme0n1").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal("xfs", scratch.TypedSpec().Type)
+		assert.Equal("scratch", scratch.TypedSpec().Label)
+	}))
+}
+
+func TestDevicesSuite(t *testing.T) {
+	suite.Run(t, &DevicesSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				sysfsPath, udevDataPath := buildSysfs(suite.T())
+
+				suite.Require().NoError(suite.Runtime().RegisterController(&blockctrl.DevicesController{
+					SysfsPath:    sysfsPath,
+					UdevDataPath: udevDataPath,
+				}))
+			},
+		},
+	})
+}
@@ -0,0 +1,274 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/siderolabs/gen/slices"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/smart"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// DefaultDiskHealthInterval is the default interval between disk health checks.
+const DefaultDiskHealthInterval = 30 * time.Minute
+
+// DiskHealthController reads S.M.A.R.T. (ATA) and SMART/health information log (NVMe) of the disks
+// and publishes DiskHealth resources.
+//
+// An event is published each time the set of health warnings for a disk changes (warnings are compared by their keys,
+// so that changes of the reported values don't trigger new events).
+type DiskHealthController struct {
+	V1Alpha1Mode   runtimetalos.Mode
+	V1Alpha1Events runtimetalos.Publisher
+
+	Interval   time.Duration
+	Thresholds smart.Thresholds
+
+	// ReadHealth reads the health of the device, defaults to smart.Read.
+	ReadHealth func(devPath string, protocol smart.Protocol) (*smart.Health, error)
+
+	// last reported warning keys by disk ID
+	warnings map[resource.ID][]string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DiskHealthController) Name() string {
+	return "block.DiskHealthController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DiskHealthController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.DiskType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DiskHealthController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.DiskHealthType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *DiskHealthController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// disks are not managed by Talos in container mode
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	interval := ctrl.Interval
+	if interval == 0 {
+		interval = DefaultDiskHealthInterval
+	}
+
+	if ctrl.ReadHealth == nil {
+		ctrl.ReadHealth = smart.Read
+	}
+
+	ctrl.warnings = map[resource.ID][]string{}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		if err := ctrl.reconcile(ctx, r, logger); err != nil {
+			return err
+		}
+	}
+}
+
+// readHealth reads the health of the disk.
+//
+// If health reporting is not supported for the disk, nil is returned.
+func (ctrl *DiskHealthController) readHealth(spec *block.DiskSpec) (*smart.Health, error) {
+	var protocol smart.Protocol
+
+	switch spec.Transport {
+	case "nvme":
+		protocol = smart.ProtocolNVMe
+	case "sata":
+		protocol = smart.ProtocolATA
+	default:
+		return nil, nil
+	}
+
+	health, err := ctrl.ReadHealth(spec.DevPath, protocol)
+	if errors.Is(err, smart.ErrNotSupported) {
+		return nil, nil
+	}
+
+	return health, err
+}
+
+func (ctrl *DiskHealthController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	disks, err := r.List(ctx, resource.NewMetadata(block.NamespaceName, block.DiskType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing disks: %w", err)
+	}
+
+	touched := map[resource.ID]struct{}{}
+
+	for _, res := range disks.Items {
+		disk := res.(*block.Disk) //nolint:errcheck,forcetypeassert
+
+		health, err := ctrl.readHealth(disk.TypedSpec())
+		if err != nil {
+			// health reporting is best effort, the disk is skipped until the next check
+			logger.Debug("failed to read disk health", zap.String("disk", disk.Metadata().ID()), zap.Error(err))
+
+			continue
+		}
+
+		touched[disk.Metadata().ID()] = struct{}{}
+
+		if health == nil {
+			// e.g. SAS/SCSI disks, USB bridges or virtual disks
+			if err = r.Modify(ctx, block.NewDiskHealth(block.NamespaceName, disk.Metadata().ID()), func(res resource.Resource) error {
+				*res.(*block.DiskHealth).TypedSpec() = block.DiskHealthSpec{
+					DevPath: disk.TypedSpec().DevPath,
+				}
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating disk health: %w", err)
+			}
+
+			continue
+		}
+
+		warnings := health.Warnings(ctrl.Thresholds)
+
+		if err = r.Modify(ctx, block.NewDiskHealth(block.NamespaceName, disk.Metadata().ID()), func(res resource.Resource) error {
+			spec := res.(*block.DiskHealth).TypedSpec()
+
+			*spec = block.DiskHealthSpec{
+				DevPath:              disk.TypedSpec().DevPath,
+				Protocol:             string(health.Protocol),
+				Supported:            true,
+				Healthy:              len(warnings) == 0,
+				Warnings:             warningMessages(warnings),
+				WarningKeys:          warningKeys(warnings),
+				Temperature:          int64(health.Temperature),
+				PowerOnHours:         health.PowerOnHours,
+				PowerCycles:          health.PowerCycles,
+				ReallocatedSectors:   health.ReallocatedSectors,
+				PendingSectors:       health.PendingSectors,
+				OfflineUncorrectable: health.OfflineUncorrectable,
+				CriticalWarning:      uint32(health.CriticalWarning),
+				AvailableSpare:       uint32(health.AvailableSpare),
+				PercentageUsed:       uint32(health.PercentageUsed),
+				MediaErrors:          health.MediaErrors,
+				UnsafeShutdowns:      health.UnsafeShutdowns,
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating disk health: %w", err)
+		}
+
+		ctrl.reportWarnings(ctx, logger, disk, warnings)
+	}
+
+	for id := range ctrl.warnings {
+		if _, ok := touched[id]; !ok {
+			delete(ctrl.warnings, id)
+		}
+	}
+
+	list, err := r.List(ctx, resource.NewMetadata(block.NamespaceName, block.DiskHealthType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for _, res := range list.Items {
+		if _, ok := touched[res.Metadata().ID()]; ok {
+			continue
+		}
+
+		if err = r.Destroy(ctx, res.Metadata()); err != nil {
+			return fmt.Errorf("error cleaning up %s: %w", res.Metadata(), err)
+		}
+	}
+
+	return nil
+}
+
+// reportWarnings publishes an event if the set of warnings changed since the last check.
+//
+// Healthy disks are not reported when they are seen for the first time.
+func (ctrl *DiskHealthController) reportWarnings(ctx context.Context, logger *zap.Logger, disk *block.Disk, warnings []smart.Warning) {
+	previous, seen := ctrl.warnings[disk.Metadata().ID()]
+
+	keys := warningKeys(warnings)
+
+	ctrl.warnings[disk.Metadata().ID()] = keys
+
+	if equalStrings(previous, keys) && (seen || len(warnings) == 0) {
+		return
+	}
+
+	if len(warnings) > 0 {
+		logger.Warn("disk health warnings", zap.String("disk", disk.Metadata().ID()), zap.Strings("warnings", warningMessages(warnings)))
+	} else {
+		logger.Info("disk health warnings cleared", zap.String("disk", disk.Metadata().ID()))
+	}
+
+	if ctrl.V1Alpha1Events != nil {
+		ctrl.V1Alpha1Events.Publish(ctx, &machine.DiskHealthEvent{
+			Disk:        disk.Metadata().ID(),
+			DevPath:     disk.TypedSpec().DevPath,
+			Healthy:     len(warnings) == 0,
+			Warnings:    warningMessages(warnings),
+			WarningKeys: keys,
+		})
+	}
+}
+
+func warningKeys(warnings []smart.Warning) []string {
+	return slices.Map(warnings, func(w smart.Warning) string { return w.Key })
+}
+
+func warningMessages(warnings []smart.Warning) []string {
+	return slices.Map(warnings, func(w smart.Warning) string { return w.Message })
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
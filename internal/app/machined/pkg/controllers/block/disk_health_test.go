@@ -0,0 +1,271 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	blockctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/talos-systems/talos/internal/pkg/smart"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+type mockEventsStream struct {
+	messagesMu sync.Mutex
+	messages   []proto.Message
+}
+
+func (s *mockEventsStream) Publish(_ context.Context, m proto.Message) {
+	s.messagesMu.Lock()
+	defer s.messagesMu.Unlock()
+
+	s.messages = append(s.messages, m)
+}
+
+func (s *mockEventsStream) diskHealthEvents() []*machine.DiskHealthEvent {
+	s.messagesMu.Lock()
+	defer s.messagesMu.Unlock()
+
+	var events []*machine.DiskHealthEvent
+
+	for _, m := range s.messages {
+		if event, ok := m.(*machine.DiskHealthEvent); ok {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+type mockHealthReader struct {
+	mu     sync.Mutex
+	health map[string]*smart.Health
+}
+
+func (r *mockHealthReader) set(devPath string, health *smart.Health) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.health[devPath] = health
+}
+
+func (r *mockHealthReader) read(devPath string, protocol smart.Protocol) (*smart.Health, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	health, ok := r.health[devPath]
+	if !ok || health.Protocol != protocol {
+		return nil, smart.ErrNotSupported
+	}
+
+	h := *health
+
+	return &h, nil
+}
+
+type DiskHealthSuite struct {
+	ctest.DefaultSuite
+
+	events *mockEventsStream
+	reader *mockHealthReader
+}
+
+func (suite *DiskHealthSuite) createDisk(id, devPath, transport string) {
+	disk := block.NewDisk(block.NamespaceName, id)
+	disk.TypedSpec().DevPath = devPath
+	disk.TypedSpec().Transport = transport
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), disk))
+}
+
+func (suite *DiskHealthSuite) assertHealth(id string, check func(*assert.Assertions, *block.DiskHealthSpec)) {
+	suite.AssertWithin(5*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		health, err := ctest.Get[*block.DiskHealth](suite, block.NewDiskHealth(block.NamespaceName, id).Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		check(assert, health.TypedSpec())
+	}))
+}
+
+func (suite *DiskHealthSuite) TestReconcile() {
+	suite.reader.set("/dev/sda", &smart.Health{
+		Protocol:     smart.ProtocolATA,
+		Temperature:  35,
+		PowerOnHours: 25630,
+	})
+	suite.reader.set("/dev/nvme0n1", &smart.Health{
+		Protocol:        smart.ProtocolNVMe,
+		Temperature:     45,
+		CriticalWarning: 0x04,
+		AvailableSpare:  100,
+		PercentageUsed:  3,
+		MediaErrors:     2,
+	})
+
+	suite.createDisk("sda", "/dev/sda", "sata")
+	suite.createDisk("nvme0n1", "/dev/nvme0n1", "nvme")
+	suite.createDisk("sdb", "/dev/sdb", "sas")
+
+	suite.assertHealth("sda", func(assert *assert.Assertions, spec *block.DiskHealthSpec) {
+		assert.Equal(block.DiskHealthSpec{
+			DevPath:      "/dev/sda",
+			Protocol:     "ata",
+			Supported:    true,
+			Healthy:      true,
+			Temperature:  35,
+			PowerOnHours: 25630,
+		}, *spec)
+	})
+
+	suite.assertHealth("nvme0n1", func(assert *assert.Assertions, spec *block.DiskHealthSpec) {
+		assert.Equal(block.DiskHealthSpec{
+			DevPath:   "/dev/nvme0n1",
+			Protocol:  "nvme",
+			Supported: true,
+			Healthy:   false,
+			Warnings: []string{
+				"critical warning: reliability is degraded due to media errors",
+				"2 media errors",
+			},
+			WarningKeys:     []string{"critical_warning_2", "media_errors"},
+			Temperature:     45,
+			CriticalWarning: 0x04,
+			AvailableSpare:  100,
+			PercentageUsed:  3,
+			MediaErrors:     2,
+		}, *spec)
+	})
+
+	suite.assertHealth("sdb", func(assert *assert.Assertions, spec *block.DiskHealthSpec) {
+		assert.Equal(block.DiskHealthSpec{
+			DevPath: "/dev/sdb",
+		}, *spec)
+	})
+
+	// healthy disk is not reported, the unhealthy one is reported once
+	suite.AssertWithin(5*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		events := suite.events.diskHealthEvents()
+
+		if !assert.Len(events, 1) {
+			return
+		}
+
+		assert.Equal("nvme0n1", events[0].Disk)
+		assert.Equal("/dev/nvme0n1", events[0].DevPath)
+		assert.False(events[0].Healthy)
+		assert.Len(events[0].Warnings, 2)
+		assert.Equal([]string{"critical_warning_2", "media_errors"}, events[0].WarningKeys)
+	}))
+
+	// the values change, but the warnings stay the same, so no new event is published
+	suite.reader.set("/dev/nvme0n1", &smart.Health{
+		Protocol:        smart.ProtocolNVMe,
+		Temperature:     46,
+		CriticalWarning: 0x04,
+		AvailableSpare:  100,
+		PercentageUsed:  3,
+		MediaErrors:     5,
+	})
+
+	suite.assertHealth("nvme0n1", func(assert *assert.Assertions, spec *block.DiskHealthSpec) {
+		assert.Equal([]string{
+			"critical warning: reliability is degraded due to media errors",
+			"5 media errors",
+		}, spec.Warnings)
+	})
+
+	suite.Assert().Len(suite.events.diskHealthEvents(), 1)
+
+	// threshold is crossed on the next poll
+	suite.reader.set("/dev/sda", &smart.Health{
+		Protocol:       smart.ProtocolATA,
+		Temperature:    75,
+		PowerOnHours:   25631,
+		PendingSectors: 8,
+	})
+
+	suite.reader.set("/dev/nvme0n1", &smart.Health{
+		Protocol:       smart.ProtocolNVMe,
+		Temperature:    45,
+		AvailableSpare: 100,
+		PercentageUsed: 3,
+	})
+
+	suite.assertHealth("sda", func(assert *assert.Assertions, spec *block.DiskHealthSpec) {
+		assert.False(spec.Healthy)
+		assert.Equal([]string{"8 pending sectors", "temperature 75°C"}, spec.Warnings)
+	})
+
+	suite.assertHealth("nvme0n1", func(assert *assert.Assertions, spec *block.DiskHealthSpec) {
+		assert.True(spec.Healthy)
+		assert.Empty(spec.Warnings)
+	})
+
+	suite.AssertWithin(5*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		events := suite.events.diskHealthEvents()
+
+		if !assert.Len(events, 3) {
+			return
+		}
+
+		for _, event := range events[1:] {
+			switch event.Disk {
+			case "sda":
+				assert.False(event.Healthy)
+				assert.Equal([]string{"8 pending sectors", "temperature 75°C"}, event.Warnings)
+			case "nvme0n1":
+				assert.True(event.Healthy)
+				assert.Empty(event.Warnings)
+			default:
+				assert.Fail("unexpected event", event.Disk)
+			}
+		}
+	}))
+
+	// disk is gone
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), block.NewDisk(block.NamespaceName, "nvme0n1").Metadata()))
+
+	suite.AssertWithin(5*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		_, err := ctest.Get[*block.DiskHealth](suite, block.NewDiskHealth(block.NamespaceName, "nvme0n1").Metadata())
+		assert.True(state.IsNotFoundError(err))
+	}))
+}
+
+func TestDiskHealthSuite(t *testing.T) {
+	s := &DiskHealthSuite{
+		events: &mockEventsStream{},
+		reader: &mockHealthReader{
+			health: map[string]*smart.Health{},
+		},
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&blockctrl.DiskHealthController{
+				V1Alpha1Events: s.events,
+				Interval:       100 * time.Millisecond,
+				Thresholds:     smart.DefaultThresholds,
+				ReadHealth:     s.reader.read,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
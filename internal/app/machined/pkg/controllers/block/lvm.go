@@ -0,0 +1,191 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/lvm"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// DefaultLVMInterval is the default interval between LVM status refreshes.
+const DefaultLVMInterval = time.Minute
+
+// LVMController publishes VolumeGroup and LogicalVolume resources with the status of LVM volumes.
+//
+// The status is refreshed periodically (to track RAID synchronization) and on each change to the disks.
+type LVMController struct {
+	V1Alpha1Mode runtimetalos.Mode
+
+	Interval time.Duration
+
+	// ReadReport reads the LVM state, defaults to lvm.ReadReport.
+	ReadReport func(ctx context.Context) (*lvm.Report, error)
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *LVMController) Name() string {
+	return "block.LVMController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LVMController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.DiskType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LVMController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.VolumeGroupType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: block.LogicalVolumeType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *LVMController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// block devices are not managed by Talos in container mode
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	interval := ctrl.Interval
+	if interval == 0 {
+		interval = DefaultLVMInterval
+	}
+
+	if ctrl.ReadReport == nil {
+		ctrl.ReadReport = lvm.ReadReport
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		report, err := ctrl.ReadReport(ctx)
+		if err != nil {
+			// keep the last known state, LVM might be busy or not available at all
+			logger.Debug("failed to read LVM report", zap.Error(err))
+
+			continue
+		}
+
+		if err = ctrl.reconcile(ctx, r, report); err != nil {
+			return err
+		}
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *LVMController) reconcile(ctx context.Context, r controller.Runtime, report *lvm.Report) error {
+	touched := map[resource.Type]map[resource.ID]struct{}{
+		block.VolumeGroupType:   {},
+		block.LogicalVolumeType: {},
+	}
+
+	for _, vg := range report.VolumeGroups {
+		vg := vg
+
+		var pvs []string
+
+		for _, pv := range report.PhysicalVolumes {
+			if pv.VolumeGroup == vg.Name {
+				pvs = append(pvs, pv.Name)
+			}
+		}
+
+		sort.Strings(pvs)
+
+		if err := r.Modify(ctx, block.NewVolumeGroup(block.NamespaceName, vg.Name), func(res resource.Resource) error {
+			*res.(*block.VolumeGroup).TypedSpec() = block.VolumeGroupSpec{
+				UUID:            vg.UUID,
+				Size:            vg.Size,
+				PrettySize:      humanize.Bytes(vg.Size),
+				Free:            vg.Free,
+				PhysicalVolumes: pvs,
+				LogicalVolumes:  vg.LVCount,
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating volume group: %w", err)
+		}
+
+		touched[block.VolumeGroupType][vg.Name] = struct{}{}
+	}
+
+	for _, lv := range report.LogicalVolumes {
+		lv := lv
+		id := lv.VolumeGroup + "/" + lv.Name
+
+		if err := r.Modify(ctx, block.NewLogicalVolume(block.NamespaceName, id), func(res resource.Resource) error {
+			*res.(*block.LogicalVolume).TypedSpec() = block.LogicalVolumeSpec{
+				Name:         lv.Name,
+				VolumeGroup:  lv.VolumeGroup,
+				UUID:         lv.UUID,
+				DevPath:      lv.Path,
+				Type:         lv.SegmentType,
+				Size:         lv.Size,
+				PrettySize:   humanize.Bytes(lv.Size),
+				Active:       lv.Active,
+				HealthStatus: lv.HealthStatus,
+				SyncPercent:  lv.SyncPercent,
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating logical volume: %w", err)
+		}
+
+		touched[block.LogicalVolumeType][id] = struct{}{}
+	}
+
+	for resourceType, ids := range touched {
+		list, err := r.List(ctx, resource.NewMetadata(block.NamespaceName, resourceType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if _, ok := ids[res.Metadata().ID()]; ok {
+				continue
+			}
+
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up %s: %w", res.Metadata(), err)
+			}
+		}
+	}
+
+	return nil
+}
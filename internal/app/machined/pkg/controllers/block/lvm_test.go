@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	blockctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/talos-systems/talos/internal/pkg/lvm"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+type mockLVM struct {
+	mu     sync.Mutex
+	report *lvm.Report
+}
+
+func (m *mockLVM) set(report *lvm.Report) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.report = report
+}
+
+func (m *mockLVM) read(context.Context) (*lvm.Report, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.report, nil
+}
+
+type LVMSuite struct {
+	ctest.DefaultSuite
+
+	lvm *mockLVM
+}
+
+func (suite *LVMSuite) TestReconcile() {
+	suite.lvm.set(&lvm.Report{
+		PhysicalVolumes: []lvm.PhysicalVolume{
+			{Name: "/dev/sdc", VolumeGroup: "data", Size: 10733223936},
+			{Name: "/dev/sdb", VolumeGroup: "data", Size: 10733223936},
+			{Name: "/dev/sdd", Size: 10737418240},
+		},
+		VolumeGroups: []lvm.VolumeGroup{
+			{Name: "data", UUID: "3xGsl5-Ue8d-mXbO-P2ns-Xm3f-VCcD-zZ8rC4", Size: 21466447872, Free: 4294967296, PVCount: 2, LVCount: 2},
+		},
+		LogicalVolumes: []lvm.LogicalVolume{
+			{
+				Name: "mirror", VolumeGroup: "data", UUID: "fJ2R1w-9hd0-Vqaj-eg1Q-3M0l-ae3E-Ivsbh3", Path: "/dev/data/mirror",
+				Size: 4294967296, SegmentType: "raid1", Active: true, SyncPercent: "42.17",
+			},
+			{
+				Name: "scratch", VolumeGroup: "data", UUID: "0GU9Bx-4A0g-lbQT-2Kkg-Xg0e-F6yp-mt1Cia", Path: "/dev/data/scratch",
+				Size: 8589934592, SegmentType: "linear", Active: true,
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), block.NewDisk(block.NamespaceName, "sdb")))
+
+	suite.AssertWithin(5*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		vg, err := ctest.Get[*block.VolumeGroup](suite, block.NewVolumeGroup(block.NamespaceName, "data").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(block.VolumeGroupSpec{
+			UUID:            "3xGsl5-Ue8d-mXbO-P2ns-Xm3f-VCcD-zZ8rC4",
+			Size:            21466447872,
+			PrettySize:      "22 GB",
+			Free:            4294967296,
+			PhysicalVolumes: []string{"/dev/sdb", "/dev/sdc"},
+			LogicalVolumes:  2,
+		}, *vg.TypedSpec())
+
+		mirror, err := ctest.Get[*block.LogicalVolume](suite, block.NewLogicalVolume(block.NamespaceName, "data/mirror").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(block.LogicalVolumeSpec{
+			Name:        "mirror",
+			VolumeGroup: "data",
+			UUID:        "fJ2R1w-9hd0-Vqaj-eg1Q-3M0l-ae3E-Ivsbh3",
+			DevPath:     "/dev/data/mirror",
+			Type:        "raid1",
+			Size:        4294967296,
+			PrettySize:  "4.3 GB",
+			Active:      true,
+			SyncPercent: "42.17",
+		}, *mirror.TypedSpec())
+
+		_, err = ctest.Get[*block.LogicalVolume](suite, block.NewLogicalVolume(block.NamespaceName, "data/scratch").Metadata())
+		assert.NoError(err)
+	}))
+
+	// RAID sync progress is picked up on the next refresh, removed volumes are cleaned up
+	suite.lvm.set(&lvm.Report{
+		PhysicalVolumes: []lvm.PhysicalVolume{
+			{Name: "/dev/sdb", VolumeGroup: "data", Size: 10733223936},
+			{Name: "/dev/sdc", VolumeGroup: "data", Size: 10733223936},
+		},
+		VolumeGroups: []lvm.VolumeGroup{
+			{Name: "data", UUID: "3xGsl5-Ue8d-mXbO-P2ns-Xm3f-VCcD-zZ8rC4", Size: 21466447872, Free: 12884901888, PVCount: 2, LVCount: 1},
+		},
+		LogicalVolumes: []lvm.LogicalVolume{
+			{
+				Name: "mirror", VolumeGroup: "data", UUID: "fJ2R1w-9hd0-Vqaj-eg1Q-3M0l-ae3E-Ivsbh3", Path: "/dev/data/mirror",
+				Size: 4294967296, SegmentType: "raid1", Active: true, SyncPercent: "100.00",
+			},
+		},
+	})
+
+	suite.AssertWithin(5*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		mirror, err := ctest.Get[*block.LogicalVolume](suite, block.NewLogicalVolume(block.NamespaceName, "data/mirror").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal("100.00", mirror.TypedSpec().SyncPercent)
+
+		_, err = ctest.Get[*block.LogicalVolume](suite, block.NewLogicalVolume(block.NamespaceName, "data/scratch").Metadata())
+		assert.True(state.IsNotFoundError(err))
+	}))
+}
+
+func TestLVMSuite(t *testing.T) {
+	s := &LVMSuite{
+		lvm: &mockLVM{
+			report: &lvm.Report{},
+		},
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&blockctrl.LVMController{
+				Interval:   100 * time.Millisecond,
+				ReadReport: s.lvm.read,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
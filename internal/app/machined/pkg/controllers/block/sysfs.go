@@ -0,0 +1,295 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// sysfs always reports sizes and offsets in 512-byte sectors.
+const sectorSize = 512
+
+// skippedDevices are the prefixes of block devices which are not reported as disks.
+var skippedDevices = []string{"loop", "ram", "zram"}
+
+// inventory is the result of the block device scan.
+type inventory struct {
+	disks       map[string]block.DiskSpec
+	partitions  map[string]block.PartitionSpec
+	filesystems map[string]block.FilesystemSpec
+}
+
+// scanner reads block device information from sysfs and udev database.
+type scanner struct {
+	sysfsPath    string
+	udevDataPath string
+}
+
+func (s *scanner) scan() (*inventory, error) {
+	inv := &inventory{
+		disks:       map[string]block.DiskSpec{},
+		partitions:  map[string]block.PartitionSpec{},
+		filesystems: map[string]block.FilesystemSpec{},
+	}
+
+	entries, err := os.ReadDir(filepath.Join(s.sysfsPath, "block"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading block devices: %w", err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if isSkipped(name) {
+			continue
+		}
+
+		s.scanDisk(inv, name)
+	}
+
+	return inv, nil
+}
+
+//nolint:gocyclo
+func (s *scanner) scanDisk(inv *inventory, name string) {
+	sysPath := filepath.Join(s.sysfsPath, "block", name)
+
+	size := s.readUint(sysPath, "size") * sectorSize
+	if size == 0 {
+		// empty device, e.g. a CD-ROM drive without a disc
+		return
+	}
+
+	target, _ := os.Readlink(sysPath) //nolint:errcheck
+
+	props := s.udevProperties(sysPath)
+
+	spec := block.DiskSpec{
+		DevPath:    "/dev/" + name,
+		Size:       size,
+		PrettySize: humanize.Bytes(size),
+		ReadOnly:   s.read(sysPath, "ro") == "1",
+
+		Model:    s.read(sysPath, "device", "model"),
+		Serial:   firstNonEmpty(s.read(sysPath, "serial"), s.read(sysPath, "device", "serial"), props["ID_SERIAL_SHORT"]),
+		WWID:     firstNonEmpty(s.read(sysPath, "wwid"), s.read(sysPath, "device", "wwid"), props["ID_WWN"]),
+		UUID:     firstNonEmpty(s.read(sysPath, "uuid"), s.read(sysPath, "device", "uuid")),
+		Name:     s.read(sysPath, "device", "name"),
+		Modalias: s.read(sysPath, "device", "modalias"),
+		BusPath:  busPath(target, name),
+
+		Transport:  transport(target, props["ID_BUS"]),
+		Rotational: s.read(sysPath, "queue", "rotational") == "1",
+
+		PartitionTableType: props["ID_PART_TABLE_TYPE"],
+		PartitionTableUUID: props["ID_PART_TABLE_UUID"],
+	}
+
+	inv.disks[name] = spec
+
+	if fs, ok := filesystemSpec(spec.DevPath, props); ok {
+		inv.filesystems[name] = fs
+	}
+
+	entries, err := os.ReadDir(sysPath)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		partPath := filepath.Join(sysPath, entry.Name())
+
+		if _, err = os.Stat(filepath.Join(partPath, "partition")); err != nil {
+			continue
+		}
+
+		s.scanPartition(inv, name, entry.Name(), partPath)
+	}
+}
+
+func (s *scanner) scanPartition(inv *inventory, diskName, name, sysPath string) {
+	props := s.udevProperties(sysPath)
+	kernelProps := readProperties(filepath.Join(sysPath, "uevent"), "")
+
+	size := s.readUint(sysPath, "size") * sectorSize
+
+	spec := block.PartitionSpec{
+		DevPath:    "/dev/" + name,
+		Parent:     diskName,
+		Number:     uint32(s.readUint(sysPath, "partition")),
+		Start:      s.readUint(sysPath, "start") * sectorSize,
+		Size:       size,
+		PrettySize: humanize.Bytes(size),
+
+		PartitionUUID:  props["ID_PART_ENTRY_UUID"],
+		PartitionLabel: firstNonEmpty(kernelProps["PARTNAME"], unescape(props["ID_PART_ENTRY_NAME"])),
+		TypeUUID:       props["ID_PART_ENTRY_TYPE"],
+	}
+
+	inv.partitions[name] = spec
+
+	if fs, ok := filesystemSpec(spec.DevPath, props); ok {
+		inv.filesystems[name] = fs
+	}
+}
+
+// udevProperties returns properties of the block device from the udev database.
+func (s *scanner) udevProperties(sysPath string) map[string]string {
+	dev := s.read(sysPath, "dev")
+	if dev == "" {
+		return map[string]string{}
+	}
+
+	return readProperties(filepath.Join(s.udevDataPath, "b"+dev), "E:")
+}
+
+func (s *scanner) read(parts ...string) string {
+	data, err := os.ReadFile(filepath.Join(parts...))
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(data))
+}
+
+func (s *scanner) readUint(parts ...string) uint64 {
+	v, err := strconv.ParseUint(s.read(parts...), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
+func filesystemSpec(devPath string, props map[string]string) (block.FilesystemSpec, bool) {
+	if props["ID_FS_TYPE"] == "" {
+		return block.FilesystemSpec{}, false
+	}
+
+	return block.FilesystemSpec{
+		DevPath: devPath,
+		Type:    props["ID_FS_TYPE"],
+		UUID:    props["ID_FS_UUID"],
+		Label:   firstNonEmpty(unescape(props["ID_FS_LABEL_ENC"]), props["ID_FS_LABEL"]),
+		Version: props["ID_FS_VERSION"],
+	}, true
+}
+
+// readProperties reads KEY=VALUE lines with the given prefix.
+func readProperties(path, prefix string) map[string]string {
+	props := map[string]string{}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return props
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+
+		key, value, ok := strings.Cut(strings.TrimPrefix(line, prefix), "=")
+		if !ok {
+			continue
+		}
+
+		props[key] = value
+	}
+
+	return props
+}
+
+// busPath converts the sysfs link target to the bus path, e.g. /pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/.
+func busPath(target, name string) string {
+	if target == "" {
+		return ""
+	}
+
+	path := strings.TrimPrefix(target, "../devices")
+
+	return strings.TrimSuffix(path, filepath.Join("block", name))
+}
+
+// transport detects the disk transport from the sysfs device path, falling back to udev bus.
+func transport(target, udevBus string) string {
+	for _, t := range []struct {
+		pathElement string
+		transport   string
+	}{
+		{"/virtio", "virtio"},
+		{"/nvme/", "nvme"},
+		{"/usb", "usb"},
+		{"/mmc_host/", "mmc"},
+		{"/ata", "sata"},
+		{"/host", "scsi"},
+	} {
+		if strings.Contains(target, t.pathElement) {
+			return t.transport
+		}
+	}
+
+	return udevBus
+}
+
+// unescape decodes udev-encoded strings (e.g. 'EFI\x20System' to 'EFI System').
+func unescape(s string) string {
+	if !strings.Contains(s, `\x`) {
+		return s
+	}
+
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+3 < len(s) && s[i+1] == 'x' {
+			if b, err := strconv.ParseUint(s[i+2:i+4], 16, 8); err == nil {
+				sb.WriteByte(byte(b))
+
+				i += 3
+
+				continue
+			}
+		}
+
+		sb.WriteByte(s[i])
+	}
+
+	return sb.String()
+}
+
+func isSkipped(name string) bool {
+	for _, prefix := range skippedDevices {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
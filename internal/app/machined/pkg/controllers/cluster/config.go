@@ -79,6 +79,23 @@ func (ctrl *ConfigController) Run(ctx context.Context, r controller.Runtime, log
 						res.(*cluster.Config).TypedSpec().RegistryKubernetesEnabled = c.Cluster().Discovery().Registries().Kubernetes().Enabled()
 						res.(*cluster.Config).TypedSpec().RegistryServiceEnabled = c.Cluster().Discovery().Registries().Service().Enabled()
 
+						res.(*cluster.Config).TypedSpec().RegistryKubernetesEncryptionKeys = nil
+						res.(*cluster.Config).TypedSpec().RegistryKubernetesLegacyAnnotations = c.Cluster().Discovery().Registries().Kubernetes().Enabled() &&
+							c.Cluster().Discovery().Registries().Kubernetes().LegacyAnnotations()
+
+						if c.Cluster().Discovery().Registries().Kubernetes().Enabled() {
+							for _, secret := range append([]string{c.Cluster().Secret()}, c.Cluster().Discovery().Registries().Kubernetes().AcceptedSecrets()...) {
+								var key []byte
+
+								key, err = base64.StdEncoding.DecodeString(secret)
+								if err != nil {
+									return err
+								}
+
+								res.(*cluster.Config).TypedSpec().RegistryKubernetesEncryptionKeys = append(res.(*cluster.Config).TypedSpec().RegistryKubernetesEncryptionKeys, key)
+							}
+						}
+
 						if c.Cluster().Discovery().Registries().Service().Enabled() {
 							var u *url.URL
 
@@ -116,6 +133,8 @@ func (ctrl *ConfigController) Run(ctx context.Context, r controller.Runtime, log
 					} else {
 						res.(*cluster.Config).TypedSpec().RegistryKubernetesEnabled = false
 						res.(*cluster.Config).TypedSpec().RegistryServiceEnabled = false
+						res.(*cluster.Config).TypedSpec().RegistryKubernetesEncryptionKeys = nil
+						res.(*cluster.Config).TypedSpec().RegistryKubernetesLegacyAnnotations = false
 					}
 
 					return nil
@@ -59,6 +59,61 @@ func (suite *ConfigSuite) TestReconcileConfig() {
 				suite.Assert().Equal(
 					[]byte("\x90\x24\x2c\x2a\xbe\x01\xdb\xc5\x54\x97\xba\xb0\xd6\xc5\x64\x4c\x33\x45\xf5\xf1\x47\xfb\xe5\x62\x22\xe2\xac\xb2\xcf\x82\xea\x47"),
 					spec.ServiceEncryptionKey)
+				suite.Assert().Equal(
+					[][]byte{[]byte("\x90\x24\x2c\x2a\xbe\x01\xdb\xc5\x54\x97\xba\xb0\xd6\xc5\x64\x4c\x33\x45\xf5\xf1\x47\xfb\xe5\x62\x22\xe2\xac\xb2\xcf\x82\xea\x47")},
+					spec.RegistryKubernetesEncryptionKeys)
+
+				return nil
+			},
+		),
+	))
+}
+
+func (suite *ConfigSuite) TestReconcileConfigAcceptedSecrets() {
+	suite.Require().NoError(suite.runtime.RegisterController(&clusterctrl.ConfigController{}))
+
+	suite.startRuntime()
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ClusterID:     "cluster1",
+			ClusterSecret: "kCQsKr4B28VUl7qw1sVkTDNF9fFH++ViIuKsss+C6kc=",
+			ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+				DiscoveryEnabled: pointer.To(true),
+				DiscoveryRegistries: v1alpha1.DiscoveryRegistriesConfig{
+					RegistryKubernetes: v1alpha1.RegistryKubernetesConfig{
+						RegistryAcceptedSecrets:   []string{"AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="},
+						RegistryLegacyAnnotations: pointer.To(true),
+					},
+					RegistryService: v1alpha1.RegistryServiceConfig{
+						RegistryDisabled: pointer.To(true),
+					},
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	specMD := resource.NewMetadata(config.NamespaceName, cluster.ConfigType, cluster.ConfigID, resource.VersionUndefined)
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			specMD,
+			func(res resource.Resource) error {
+				spec := res.(*cluster.Config).TypedSpec()
+
+				suite.Assert().True(spec.RegistryKubernetesEnabled)
+				suite.Assert().False(spec.RegistryServiceEnabled)
+				suite.Assert().Nil(spec.ServiceEncryptionKey)
+				suite.Assert().True(spec.RegistryKubernetesLegacyAnnotations)
+				suite.Assert().Equal(
+					[][]byte{
+						[]byte("\x90\x24\x2c\x2a\xbe\x01\xdb\xc5\x54\x97\xba\xb0\xd6\xc5\x64\x4c\x33\x45\xf5\xf1\x47\xfb\xe5\x62\x22\xe2\xac\xb2\xcf\x82\xea\x47"),
+						[]byte("\x00\x01\x02\x03\x04\x05\x06\x07\x08\x09\x0a\x0b\x0c\x0d\x0e\x0f\x10\x11\x12\x13\x14\x15\x16\x17\x18\x19\x1a\x1b\x1c\x1d\x1e\x1f"),
+					},
+					spec.RegistryKubernetesEncryptionKeys)
 
 				return nil
 			},
@@ -102,6 +157,7 @@ func (suite *ConfigSuite) TestReconcileConfigCustom() {
 
 				suite.Assert().True(spec.DiscoveryEnabled)
 				suite.Assert().False(spec.RegistryKubernetesEnabled)
+				suite.Assert().Empty(spec.RegistryKubernetesEncryptionKeys)
 				suite.Assert().True(spec.RegistryServiceEnabled)
 				suite.Assert().Equal("[2001:470:6d:30e:565d:e162:e2a0:cf5a]:3456", spec.ServiceEndpoint)
 				suite.Assert().False(spec.ServiceEndpointInsecure)
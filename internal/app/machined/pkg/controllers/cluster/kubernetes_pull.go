@@ -132,9 +132,12 @@ func (ctrl *KubernetesPullController) Run(ctx context.Context, r controller.Runt
 		}
 
 		if kubernetesRegistry == nil {
-			kubernetesRegistry = registry.NewKubernetes(kubernetesClient)
+			kubernetesRegistry = registry.NewKubernetes(kubernetesClient, nil)
 		}
 
+		kubernetesRegistry.SetSecrets(discoveryConfig.(*cluster.Config).TypedSpec().RegistryKubernetesEncryptionKeys)
+		kubernetesRegistry.SetLegacyAnnotations(discoveryConfig.(*cluster.Config).TypedSpec().RegistryKubernetesLegacyAnnotations)
+
 		if notifyCh == nil {
 			var watchCtx context.Context
 			watchCtx, watchCtxCancel = context.WithCancel(ctx) //nolint:govet
@@ -145,7 +148,7 @@ func (ctrl *KubernetesPullController) Run(ctx context.Context, r controller.Runt
 			}
 		}
 
-		affiliateSpecs, err := kubernetesRegistry.List(nodename.(*k8s.Nodename).TypedSpec().Nodename)
+		affiliateSpecs, err := kubernetesRegistry.List(nodename.(*k8s.Nodename).TypedSpec().Nodename, logger)
 		if err != nil {
 			return fmt.Errorf("error listing affiliates: %w", err)
 		}
@@ -132,7 +132,7 @@ func (ctrl *KubernetesPushController) Run(ctx context.Context, r controller.Runt
 				}
 			}
 
-			if err = registry.NewKubernetes(ctrl.kubernetesClient).Push(ctx, affiliate.(*cluster.Affiliate)); err != nil {
+			if err = registry.NewKubernetes(ctrl.kubernetesClient, discoveryConfig.(*cluster.Config).TypedSpec().RegistryKubernetesEncryptionKeys).Push(ctx, affiliate.(*cluster.Affiliate)); err != nil {
 				// reset client connection
 				ctrl.kubernetesClient.Close() //nolint:errcheck
 				ctrl.kubernetesClient = nil
@@ -7,6 +7,7 @@ package config
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -159,6 +160,56 @@ func convertVolumes(volumes []talosconfig.VolumeMount) []k8s.ExtraVolume {
 	})
 }
 
+func convertResources(resources talosconfig.Resources) k8s.Resources {
+	return k8s.Resources{
+		Requests: resources.Requests(),
+		Limits:   resources.Limits(),
+	}
+}
+
+func convertOIDC(oidc talosconfig.OIDC) k8s.OIDC {
+	if !oidc.Enabled() {
+		return k8s.OIDC{}
+	}
+
+	return k8s.OIDC{
+		IssuerURL:         oidc.IssuerURL(),
+		ClientID:          oidc.ClientID(),
+		UsernameClaim:     oidc.UsernameClaim(),
+		UsernamePrefix:    oidc.UsernamePrefix(),
+		GroupsClaim:       oidc.GroupsClaim(),
+		GroupsPrefix:      oidc.GroupsPrefix(),
+		RequiredClaims:    oidc.RequiredClaims(),
+		SigningAlgorithms: oidc.SigningAlgorithms(),
+		CAEnabled:         oidc.CA() != nil,
+	}
+}
+
+// kmsVolumes mounts the directories with the KMS plugin sockets into the kube-apiserver pod.
+func kmsVolumes(providers []talosconfig.KMSProvider) []k8s.ExtraVolume {
+	var volumes []k8s.ExtraVolume
+
+	seen := map[string]struct{}{}
+
+	for _, kms := range providers {
+		dir := filepath.Dir(kms.Endpoint())
+
+		if _, exists := seen[dir]; exists {
+			continue
+		}
+
+		seen[dir] = struct{}{}
+
+		volumes = append(volumes, k8s.ExtraVolume{
+			Name:      fmt.Sprintf("kms-%d", len(volumes)),
+			HostPath:  dir,
+			MountPath: dir,
+		})
+	}
+
+	return volumes
+}
+
 func (ctrl *K8sControlPlaneController) manageAPIServerConfig(ctx context.Context, r controller.Runtime, logger *zap.Logger, cfgProvider talosconfig.Provider) error {
 	var cloudProvider string
 	if cfgProvider.Cluster().ExternalCloudProvider().Enabled() {
@@ -179,10 +230,19 @@ func (ctrl *K8sControlPlaneController) manageAPIServerConfig(ctx context.Context
 			LocalPort:                cfgProvider.Cluster().LocalAPIServerPort(),
 			ServiceCIDRs:             cfgProvider.Cluster().Network().ServiceCIDRs(),
 			ExtraArgs:                cfgProvider.Cluster().APIServer().ExtraArgs(),
-			ExtraVolumes:             convertVolumes(cfgProvider.Cluster().APIServer().ExtraVolumes()),
+			ExtraVolumes:             append(convertVolumes(cfgProvider.Cluster().APIServer().ExtraVolumes()), kmsVolumes(cfgProvider.Cluster().APIServer().KMSProviders())...),
 			EnvironmentVariables:     cfgProvider.Cluster().APIServer().Env(),
 			PodSecurityPolicyEnabled: !cfgProvider.Cluster().APIServer().DisablePodSecurityPolicy(),
 			AdvertisedAddress:        advertisedAddress,
+			Resources:                convertResources(cfgProvider.Cluster().APIServer().Resources()),
+			PriorityClassName:        cfgProvider.Cluster().APIServer().PriorityClassName(),
+			AuditLog: k8s.AuditLog{
+				MaxAge:     cfgProvider.Cluster().APIServer().AuditLog().MaxAge(),
+				MaxBackups: cfgProvider.Cluster().APIServer().AuditLog().MaxBackups(),
+				MaxSize:    cfgProvider.Cluster().APIServer().AuditLog().MaxSize(),
+			},
+			OIDC:       convertOIDC(cfgProvider.Cluster().APIServer().OIDC()),
+			KMSEnabled: len(cfgProvider.Cluster().APIServer().KMSProviders()) > 0,
 		}
 
 		return nil
@@ -236,6 +296,8 @@ func (ctrl *K8sControlPlaneController) manageControllerManagerConfig(ctx context
 			ExtraArgs:            cfgProvider.Cluster().ControllerManager().ExtraArgs(),
 			ExtraVolumes:         convertVolumes(cfgProvider.Cluster().ControllerManager().ExtraVolumes()),
 			EnvironmentVariables: cfgProvider.Cluster().ControllerManager().Env(),
+			Resources:            convertResources(cfgProvider.Cluster().ControllerManager().Resources()),
+			PriorityClassName:    cfgProvider.Cluster().ControllerManager().PriorityClassName(),
 		}
 
 		return nil
@@ -250,6 +312,8 @@ func (ctrl *K8sControlPlaneController) manageSchedulerConfig(ctx context.Context
 			ExtraArgs:            cfgProvider.Cluster().Scheduler().ExtraArgs(),
 			ExtraVolumes:         convertVolumes(cfgProvider.Cluster().Scheduler().ExtraVolumes()),
 			EnvironmentVariables: cfgProvider.Cluster().Scheduler().Env(),
+			Resources:            convertResources(cfgProvider.Cluster().Scheduler().Resources()),
+			PriorityClassName:    cfgProvider.Cluster().Scheduler().PriorityClassName(),
 		}
 
 		return nil
@@ -306,6 +370,8 @@ func (ctrl *K8sControlPlaneController) manageManifestsConfig(ctx context.Context
 			PodSecurityPolicyEnabled: !cfgProvider.Cluster().APIServer().DisablePodSecurityPolicy(),
 
 			TalosAPIServiceEnabled: cfgProvider.Machine().Features().KubernetesTalosAPIAccess().Enabled(),
+
+			Prune: cfgProvider.Cluster().BootstrapManifestsPrune(),
 		}
 
 		return nil
@@ -321,6 +387,7 @@ func (ctrl *K8sControlPlaneController) manageExtraManifestsConfig(ctx context.Co
 				Name:     url,
 				URL:      url,
 				Priority: "05", // push CNI to the top
+				Prune:    cfgProvider.Cluster().ExtraManifestsPrune(),
 			})
 		}
 
@@ -329,6 +396,7 @@ func (ctrl *K8sControlPlaneController) manageExtraManifestsConfig(ctx context.Co
 				Name:     url,
 				URL:      url,
 				Priority: "30", // after default manifests
+				Prune:    cfgProvider.Cluster().ExtraManifestsPrune(),
 			})
 		}
 
@@ -338,6 +406,7 @@ func (ctrl *K8sControlPlaneController) manageExtraManifestsConfig(ctx context.Co
 				URL:          url,
 				Priority:     "99", // make sure extra manifests come last, when PSP is already created
 				ExtraHeaders: cfgProvider.Cluster().ExtraManifestHeaderMap(),
+				Prune:        cfgProvider.Cluster().ExtraManifestsPrune(),
 			})
 		}
 
@@ -346,6 +415,7 @@ func (ctrl *K8sControlPlaneController) manageExtraManifestsConfig(ctx context.Co
 				Name:           manifest.Name(),
 				Priority:       "99", // make sure extra manifests come last, when PSP is already created
 				InlineManifest: manifest.Contents(),
+				Prune:          manifest.Prune(),
 			})
 		}
 
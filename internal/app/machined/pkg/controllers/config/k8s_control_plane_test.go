@@ -266,6 +266,69 @@ func (suite *K8sControlPlaneSuite) TestReconcileExtraVolumes() {
 	)
 }
 
+func (suite *K8sControlPlaneSuite) TestReconcileKMSProviders() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+				APIServerConfig: &v1alpha1.APIServerConfig{
+					ExtraVolumesConfig: []v1alpha1.VolumeMountConfig{
+						{
+							VolumeHostPath:  "/var/lib",
+							VolumeMountPath: "/var/foo/",
+						},
+					},
+					KMSProvidersConfig: []*v1alpha1.KMSProviderConfig{
+						{
+							KMSName:     "vault",
+							KMSEndpoint: "/var/run/kmsplugin/vault.sock",
+						},
+						{
+							KMSName:     "aws",
+							KMSEndpoint: "/var/run/kmsplugin/aws.sock",
+						},
+						{
+							KMSName:     "gcp",
+							KMSEndpoint: "/var/run/gcp/kms.sock",
+						},
+					},
+				},
+			},
+		},
+	)
+
+	apiServerCfg := suite.setupMachine(cfg)
+	suite.Assert().Equal(
+		[]k8s.ExtraVolume{
+			{
+				Name:      "var-foo",
+				HostPath:  "/var/lib",
+				MountPath: "/var/foo/",
+			},
+			{
+				Name:      "kms-0",
+				HostPath:  "/var/run/kmsplugin",
+				MountPath: "/var/run/kmsplugin",
+			},
+			{
+				Name:      "kms-1",
+				HostPath:  "/var/run/gcp",
+				MountPath: "/var/run/gcp",
+			},
+		}, apiServerCfg.ExtraVolumes,
+	)
+	suite.Assert().True(apiServerCfg.KMSEnabled)
+}
+
 func (suite *K8sControlPlaneSuite) TestReconcileEnvironment() {
 	u, err := url.Parse("https://foo:6443")
 	suite.Require().NoError(err)
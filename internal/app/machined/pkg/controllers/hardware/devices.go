@@ -0,0 +1,218 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/pci"
+	"github.com/talos-systems/talos/internal/pkg/uevent"
+	"github.com/talos-systems/talos/internal/pkg/usb"
+	"github.com/talos-systems/talos/pkg/machinery/resources/hardware"
+)
+
+// PCIDevicesController populates PCIDevice resources from sysfs.
+//
+// The controller rescans PCI devices on each pci subsystem uevent (hotplug, driver bind/unbind).
+type PCIDevicesController struct {
+	V1Alpha1Mode runtimetalos.Mode
+
+	DevicesPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *PCIDevicesController) Name() string {
+	return "hardware.PCIDevicesController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *PCIDevicesController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *PCIDevicesController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: hardware.PCIDeviceType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *PCIDevicesController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// host devices are not visible in container mode
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	watcher, err := uevent.NewWatcher(r, uevent.GroupKernel, "pci")
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		devices, err := pci.List(ctrl.DevicesPath)
+		if err != nil {
+			return err
+		}
+
+		touched := map[resource.ID]struct{}{}
+
+		for _, device := range devices {
+			device := device
+
+			if err = r.Modify(ctx, hardware.NewPCIDeviceInfo(device.Address), func(res resource.Resource) error {
+				*res.(*hardware.PCIDevice).TypedSpec() = hardware.PCIDeviceSpec{
+					Class:      device.Class,
+					Subclass:   device.Subclass,
+					Vendor:     device.Vendor,
+					Product:    device.Product,
+					ClassID:    fmt.Sprintf("0x%02x", device.ClassID()),
+					SubclassID: fmt.Sprintf("0x%02x", device.SubclassID()),
+					VendorID:   fmt.Sprintf("0x%04x", device.VendorID),
+					ProductID:  fmt.Sprintf("0x%04x", device.ProductID),
+					Driver:     device.Driver,
+					IOMMUGroup: device.IOMMUGroup,
+				}
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating PCI device: %w", err)
+			}
+
+			touched[device.Address] = struct{}{}
+		}
+
+		if err = cleanupDevices(ctx, r, hardware.PCIDeviceType, touched); err != nil {
+			return err
+		}
+	}
+}
+
+// USBDevicesController populates USBDevice resources from sysfs.
+//
+// The controller rescans USB devices on each usb subsystem uevent (hotplug, driver bind/unbind).
+type USBDevicesController struct {
+	V1Alpha1Mode runtimetalos.Mode
+
+	DevicesPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *USBDevicesController) Name() string {
+	return "hardware.USBDevicesController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *USBDevicesController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *USBDevicesController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: hardware.USBDeviceType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *USBDevicesController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// host devices are not visible in container mode
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	watcher, err := uevent.NewWatcher(r, uevent.GroupKernel, "usb")
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		devices, err := usb.List(ctrl.DevicesPath)
+		if err != nil {
+			return err
+		}
+
+		touched := map[resource.ID]struct{}{}
+
+		for _, device := range devices {
+			device := device
+
+			if err = r.Modify(ctx, hardware.NewUSBDeviceInfo(device.Name), func(res resource.Resource) error {
+				*res.(*hardware.USBDevice).TypedSpec() = hardware.USBDeviceSpec{
+					BusNum:    device.BusNum,
+					DevNum:    device.DevNum,
+					Vendor:    device.Vendor,
+					Product:   device.Product,
+					Serial:    device.Serial,
+					VendorID:  fmt.Sprintf("0x%04x", device.VendorID),
+					ProductID: fmt.Sprintf("0x%04x", device.ProductID),
+					Class:     device.Class,
+					ClassID:   fmt.Sprintf("0x%02x", device.ClassCode),
+					Speed:     device.Speed,
+					Version:   device.Version,
+					Drivers:   device.Drivers,
+				}
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating USB device: %w", err)
+			}
+
+			touched[device.Name] = struct{}{}
+		}
+
+		if err = cleanupDevices(ctx, r, hardware.USBDeviceType, touched); err != nil {
+			return err
+		}
+	}
+}
+
+// cleanupDevices removes resources of devices which are gone.
+func cleanupDevices(ctx context.Context, r controller.Runtime, resourceType resource.Type, touched map[resource.ID]struct{}) error {
+	list, err := r.List(ctx, resource.NewMetadata(hardware.NamespaceName, resourceType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for _, res := range list.Items {
+		if _, ok := touched[res.Metadata().ID()]; ok {
+			continue
+		}
+
+		if err = r.Destroy(ctx, res.Metadata()); err != nil {
+			return fmt.Errorf("error cleaning up %s: %w", res.Metadata(), err)
+		}
+	}
+
+	return nil
+}
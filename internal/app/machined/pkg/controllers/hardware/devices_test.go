@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	hardwarectrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/hardware"
+	"github.com/talos-systems/talos/pkg/machinery/resources/hardware"
+)
+
+type DevicesSuite struct {
+	ctest.DefaultSuite
+}
+
+func writeSysfs(t *testing.T, devicesPath string, files map[string]string) {
+	for path, contents := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(devicesPath, path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(devicesPath, path), []byte(contents+"\n"), 0o644))
+	}
+}
+
+func (suite *DevicesSuite) TestPCIDevices() {
+	devicesPath := suite.T().TempDir()
+
+	writeSysfs(suite.T(), devicesPath, map[string]string{
+		"0000:03:00.0/vendor": "0x8086",
+		"0000:03:00.0/device": "0x1533",
+		"0000:03:00.0/class":  "0x020000",
+	})
+
+	suite.Require().NoError(os.Symlink("../../../bus/pci/drivers/igb", filepath.Join(devicesPath, "0000:03:00.0", "driver")))
+	suite.Require().NoError(os.Symlink("../../../kernel/iommu_groups/15", filepath.Join(devicesPath, "0000:03:00.0", "iommu_group")))
+
+	suite.Require().NoError(suite.Runtime().RegisterController(&hardwarectrl.PCIDevicesController{
+		DevicesPath: devicesPath,
+	}))
+
+	suite.AssertWithin(5*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		device, err := ctest.Get[*hardware.PCIDevice](suite, hardware.NewPCIDeviceInfo("0000:03:00.0").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(hardware.PCIDeviceSpec{
+			Class:      "Network controller",
+			Subclass:   "Ethernet controller",
+			Vendor:     "Intel Corporation",
+			Product:    "I210 Gigabit Network Connection",
+			ClassID:    "0x02",
+			SubclassID: "0x00",
+			VendorID:   "0x8086",
+			ProductID:  "0x1533",
+			Driver:     "igb",
+			IOMMUGroup: "15",
+		}, *device.TypedSpec())
+	}))
+}
+
+func (suite *DevicesSuite) TestUSBDevices() {
+	devicesPath := suite.T().TempDir()
+
+	writeSysfs(suite.T(), devicesPath, map[string]string{
+		"1-1/idVendor":            "046d",
+		"1-1/idProduct":           "c52b",
+		"1-1/bDeviceClass":        "00",
+		"1-1/busnum":              "1",
+		"1-1/devnum":              "2",
+		"1-1/manufacturer":        "Logitech",
+		"1-1/product":             "USB Receiver",
+		"1-1/speed":               "12",
+		"1-1/version":             " 2.00",
+		"1-1:1.0/bInterfaceClass": "03",
+	})
+
+	suite.Require().NoError(os.Symlink("../../../../../../bus/usb/drivers/usbhid", filepath.Join(devicesPath, "1-1:1.0", "driver")))
+
+	suite.Require().NoError(suite.Runtime().RegisterController(&hardwarectrl.USBDevicesController{
+		DevicesPath: devicesPath,
+	}))
+
+	suite.AssertWithin(5*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		device, err := ctest.Get[*hardware.USBDevice](suite, hardware.NewUSBDeviceInfo("1-1").Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(hardware.USBDeviceSpec{
+			BusNum:    1,
+			DevNum:    2,
+			Vendor:    "Logitech, Inc.",
+			Product:   "Unifying Receiver",
+			VendorID:  "0x046d",
+			ProductID: "0xc52b",
+			Class:     "Human Interface Device",
+			ClassID:   "0x03",
+			Speed:     "12",
+			Version:   "2.00",
+			Drivers:   []string{"usbhid"},
+		}, *device.TypedSpec())
+	}))
+}
+
+func TestDevicesSuite(t *testing.T) {
+	suite.Run(t, new(DevicesSuite))
+}
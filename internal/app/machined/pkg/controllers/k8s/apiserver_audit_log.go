@@ -0,0 +1,145 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s/internal/auditlog"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+)
+
+// APIServerAuditLogController forwards kube-apiserver audit log to the machine logs.
+//
+// Audit events end up in the `kube-apiserver-audit` log, and they are sent to the logging destinations.
+type APIServerAuditLogController struct {
+	LoggingManager runtime.LoggingManager
+	// AuditLogPath is the path to the kube-apiserver audit log file, defaults to the path used in the static pod.
+	AuditLogPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *APIServerAuditLogController) Name() string {
+	return "k8s.APIServerAuditLogController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *APIServerAuditLogController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        pointer.To(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *APIServerAuditLogController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *APIServerAuditLogController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.AuditLogPath == "" {
+		ctrl.AuditLogPath = filepath.Join(constants.KubernetesAuditLogDir, "kube-apiserver.log")
+	}
+
+	var followerCtxCancel context.CancelFunc
+
+	followerErrCh := make(chan error, 1)
+
+	stopFollower := func() {
+		if followerCtxCancel != nil {
+			followerCtxCancel()
+
+			<-followerErrCh
+
+			followerCtxCancel = nil
+		}
+	}
+
+	startFollower := func(w io.WriteCloser) {
+		follower := &auditlog.Follower{
+			Path: ctrl.AuditLogPath,
+		}
+
+		var followerCtx context.Context
+
+		followerCtx, followerCtxCancel = context.WithCancel(ctx)
+
+		go func() {
+			err := follower.Run(followerCtx, w)
+
+			w.Close() //nolint:errcheck
+
+			followerErrCh <- err
+		}()
+	}
+
+	defer stopFollower()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case err := <-followerErrCh:
+			if followerCtxCancel != nil {
+				followerCtxCancel()
+			}
+
+			followerCtxCancel = nil
+
+			if err != nil && !errors.Is(err, context.Canceled) {
+				return fmt.Errorf("error following audit log: %w", err)
+			}
+		}
+
+		machineType, err := safe.ReaderGet[*config.MachineType](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineTypeType, config.MachineTypeID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine type: %w", err)
+		}
+
+		if !machineType.MachineType().IsControlPlane() {
+			stopFollower()
+
+			continue
+		}
+
+		if followerCtxCancel != nil {
+			continue
+		}
+
+		w, err := ctrl.LoggingManager.ServiceLog(constants.KubernetesAuditLogID).Writer()
+		if err != nil {
+			return fmt.Errorf("error opening audit log writer: %w", err)
+		}
+
+		logger.Debug("following kube-apiserver audit log", zap.String("path", ctrl.AuditLogPath))
+
+		startFollower(w)
+	}
+}
@@ -252,6 +252,52 @@ func volumes(volumes []k8s.ExtraVolume) []v1.Volume {
 	})
 }
 
+// resourceRequirements builds the container resources from the configuration applying default requests.
+func resourceRequirements(cfg k8s.Resources, defaultCPU, defaultMemory string) (v1.ResourceRequirements, error) {
+	requests := v1.ResourceList{
+		v1.ResourceCPU:    apiresource.MustParse(defaultCPU),
+		v1.ResourceMemory: apiresource.MustParse(defaultMemory),
+	}
+
+	var limits v1.ResourceList
+
+	for name, value := range cfg.Limits {
+		quantity, err := apiresource.ParseQuantity(value)
+		if err != nil {
+			return v1.ResourceRequirements{}, fmt.Errorf("error parsing %s limit: %w", name, err)
+		}
+
+		if limits == nil {
+			limits = v1.ResourceList{}
+		}
+
+		limits[v1.ResourceName(name)] = quantity
+
+		// lower the default request so that it doesn't exceed the limit
+		if request, ok := requests[v1.ResourceName(name)]; ok && request.Cmp(quantity) > 0 {
+			requests[v1.ResourceName(name)] = quantity
+		}
+	}
+
+	for name, value := range cfg.Requests {
+		quantity, err := apiresource.ParseQuantity(value)
+		if err != nil {
+			return v1.ResourceRequirements{}, fmt.Errorf("error parsing %s request: %w", name, err)
+		}
+
+		if limit, ok := limits[v1.ResourceName(name)]; ok && quantity.Cmp(limit) > 0 {
+			return v1.ResourceRequirements{}, fmt.Errorf("%s request %s exceeds the limit %s", name, value, limit.String())
+		}
+
+		requests[v1.ResourceName(name)] = quantity
+	}
+
+	return v1.ResourceRequirements{
+		Requests: requests,
+		Limits:   limits,
+	}, nil
+}
+
 func envVars(environment map[string]string) []v1.EnvVar {
 	if len(environment) == 0 {
 		return nil
@@ -269,6 +315,48 @@ func envVars(environment map[string]string) []v1.EnvVar {
 	})
 }
 
+// oidcArgs returns kube-apiserver arguments for the OIDC authentication.
+func oidcArgs(oidc k8s.OIDC) argsbuilder.Args {
+	if !oidc.Enabled() {
+		return nil
+	}
+
+	args := argsbuilder.Args{
+		"oidc-issuer-url": oidc.IssuerURL,
+		"oidc-client-id":  oidc.ClientID,
+	}
+
+	for _, arg := range []struct {
+		name  string
+		value string
+	}{
+		{"oidc-username-claim", oidc.UsernameClaim},
+		{"oidc-username-prefix", oidc.UsernamePrefix},
+		{"oidc-groups-claim", oidc.GroupsClaim},
+		{"oidc-groups-prefix", oidc.GroupsPrefix},
+		{"oidc-signing-algs", strings.Join(oidc.SigningAlgorithms, ",")},
+	} {
+		if arg.value != "" {
+			args[arg.name] = arg.value
+		}
+	}
+
+	if len(oidc.RequiredClaims) > 0 {
+		claims := maps.Keys(oidc.RequiredClaims)
+		sort.Strings(claims)
+
+		args["oidc-required-claim"] = strings.Join(slices.Map(claims, func(claim string) string {
+			return claim + "=" + oidc.RequiredClaims[claim]
+		}), ",")
+	}
+
+	if oidc.CAEnabled {
+		args["oidc-ca-file"] = filepath.Join(constants.KubernetesAPIServerSecretsDir, "oidc-ca.crt")
+	}
+
+	return args
+}
+
 func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context, r controller.Runtime, logger *zap.Logger,
 	configResource resource.Resource, secretsVersion, configVersion string,
 ) (string, error) {
@@ -308,9 +396,9 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		"encryption-provider-config":       filepath.Join(constants.KubernetesAPIServerSecretsDir, "encryptionconfig.yaml"),
 		"audit-policy-file":                filepath.Join(constants.KubernetesAPIServerConfigDir, "auditpolicy.yaml"),
 		"audit-log-path":                   filepath.Join(constants.KubernetesAuditLogDir, "kube-apiserver.log"),
-		"audit-log-maxage":                 "30",
-		"audit-log-maxbackup":              "10",
-		"audit-log-maxsize":                "100",
+		"audit-log-maxage":                 strconv.Itoa(cfg.AuditLog.MaxAge),
+		"audit-log-maxbackup":              strconv.Itoa(cfg.AuditLog.MaxBackups),
+		"audit-log-maxsize":                strconv.Itoa(cfg.AuditLog.MaxSize),
 		"profiling":                        "false",
 		"etcd-cafile":                      filepath.Join(constants.KubernetesAPIServerSecretsDir, "etcd-client-ca.crt"),
 		"etcd-certfile":                    filepath.Join(constants.KubernetesAPIServerSecretsDir, "etcd-client.crt"),
@@ -336,6 +424,17 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		builder.Set("cloud-provider", cfg.CloudProvider)
 	}
 
+	if cfg.KMSEnabled {
+		// KMS v2 plugin API is not enabled by default before Kubernetes 1.27
+		builder.Set("feature-gates", "KMSv2=true")
+	}
+
+	apiServerOIDCArgs := oidcArgs(cfg.OIDC)
+
+	for arg, value := range apiServerOIDCArgs {
+		builder.Set(arg, value)
+	}
+
 	mergePolicies := argsbuilder.MergePolicies{
 		"enable-admission-plugins": argsbuilder.MergeAdditive,
 		"feature-gates":            argsbuilder.MergeAdditive,
@@ -359,12 +458,22 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		"tls-private-key-file":             argsbuilder.MergeDenied,
 	}
 
+	// OIDC is configured via the machine config, so the extra args can't override it
+	for arg := range apiServerOIDCArgs {
+		mergePolicies[arg] = argsbuilder.MergeDenied
+	}
+
 	if err := builder.Merge(cfg.ExtraArgs, argsbuilder.WithMergePolicies(mergePolicies)); err != nil {
 		return "", err
 	}
 
 	args = append(args, builder.Args()...)
 
+	resources, err := resourceRequirements(cfg.Resources, "200m", "512Mi")
+	if err != nil {
+		return "", err
+	}
+
 	return k8s.APIServerID, r.Modify(ctx, k8s.NewStaticPod(k8s.NamespaceName, k8s.APIServerID), func(r resource.Resource) error {
 		return k8sadapter.StaticPod(r.(*k8s.StaticPod)).SetPod(&v1.Pod{
 			TypeMeta: metav1.TypeMeta{
@@ -385,7 +494,7 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 				},
 			},
 			Spec: v1.PodSpec{
-				PriorityClassName: "system-cluster-critical",
+				PriorityClassName: cfg.PriorityClassName,
 				Containers: []v1.Container{
 					{
 						Name:    k8s.APIServerID,
@@ -420,12 +529,7 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 								ReadOnly:  false,
 							},
 						}, volumeMounts(cfg.ExtraVolumes)...),
-						Resources: v1.ResourceRequirements{
-							Requests: v1.ResourceList{
-								v1.ResourceCPU:    apiresource.MustParse("200m"),
-								v1.ResourceMemory: apiresource.MustParse("512Mi"),
-							},
-						},
+						Resources: resources,
 						SecurityContext: &v1.SecurityContext{
 							AllowPrivilegeEscalation: pointer.To(false),
 							Capabilities: &v1.Capabilities{
@@ -505,7 +609,7 @@ func (ctrl *ControlPlaneStaticPodController) manageControllerManager(ctx context
 		"authentication-kubeconfig":        filepath.Join(constants.KubernetesControllerManagerSecretsDir, "kubeconfig"),
 		"authorization-kubeconfig":         filepath.Join(constants.KubernetesControllerManagerSecretsDir, "kubeconfig"),
 		"leader-elect":                     "true",
-		"root-ca-file":                     filepath.Join(constants.KubernetesControllerManagerSecretsDir, "ca.crt"),
+		"root-ca-file":                     filepath.Join(constants.KubernetesControllerManagerSecretsDir, "root-ca.crt"),
 		"service-account-private-key-file": filepath.Join(constants.KubernetesControllerManagerSecretsDir, "service-account.key"),
 		"profiling":                        "false",
 		"tls-min-version":                  "VersionTLS13",
@@ -533,6 +637,11 @@ func (ctrl *ControlPlaneStaticPodController) manageControllerManager(ctx context
 
 	args = append(args, builder.Args()...)
 
+	resources, err := resourceRequirements(cfg.Resources, "50m", "256Mi")
+	if err != nil {
+		return "", err
+	}
+
 	//nolint:dupl
 	return k8s.ControllerManagerID, r.Modify(ctx, k8s.NewStaticPod(k8s.NamespaceName, k8s.ControllerManagerID), func(r resource.Resource) error {
 		return k8sadapter.StaticPod(r.(*k8s.StaticPod)).SetPod(&v1.Pod{
@@ -553,7 +662,7 @@ func (ctrl *ControlPlaneStaticPodController) manageControllerManager(ctx context
 				},
 			},
 			Spec: v1.PodSpec{
-				PriorityClassName: "system-cluster-critical",
+				PriorityClassName: cfg.PriorityClassName,
 				Containers: []v1.Container{
 					{
 						Name:    k8s.ControllerManagerID,
@@ -579,12 +688,7 @@ func (ctrl *ControlPlaneStaticPodController) manageControllerManager(ctx context
 							InitialDelaySeconds: 15,
 							TimeoutSeconds:      15,
 						},
-						Resources: v1.ResourceRequirements{
-							Requests: v1.ResourceList{
-								v1.ResourceCPU:    apiresource.MustParse("50m"),
-								v1.ResourceMemory: apiresource.MustParse("256Mi"),
-							},
-						},
+						Resources: resources,
 						SecurityContext: &v1.SecurityContext{
 							AllowPrivilegeEscalation: pointer.To(false),
 							Capabilities: &v1.Capabilities{
@@ -653,6 +757,11 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 
 	args = append(args, builder.Args()...)
 
+	resources, err := resourceRequirements(cfg.Resources, "10m", "64Mi")
+	if err != nil {
+		return "", err
+	}
+
 	//nolint:dupl
 	return k8s.SchedulerID, r.Modify(ctx, k8s.NewStaticPod(k8s.NamespaceName, k8s.SchedulerID), func(r resource.Resource) error {
 		return k8sadapter.StaticPod(r.(*k8s.StaticPod)).SetPod(&v1.Pod{
@@ -673,7 +782,7 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 				},
 			},
 			Spec: v1.PodSpec{
-				PriorityClassName: "system-cluster-critical",
+				PriorityClassName: cfg.PriorityClassName,
 				Containers: []v1.Container{
 					{
 						Name:    k8s.SchedulerID,
@@ -699,12 +808,7 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 							InitialDelaySeconds: 15,
 							TimeoutSeconds:      15,
 						},
-						Resources: v1.ResourceRequirements{
-							Requests: v1.ResourceList{
-								v1.ResourceCPU:    apiresource.MustParse("10m"),
-								v1.ResourceMemory: apiresource.MustParse("64Mi"),
-							},
-						},
+						Resources: resources,
 						SecurityContext: &v1.SecurityContext{
 							AllowPrivilegeEscalation: pointer.To(false),
 							Capabilities: &v1.Capabilities{
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"sync"
@@ -500,6 +501,173 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileEnvironmentVariables() {
 	}
 }
 
+func (suite *ControlPlaneStaticPodSuite) TestReconcileResources() {
+	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, secretStatus))
+
+	tests := []struct {
+		resources        k8s.Resources
+		expectedRequests map[string]string
+		expectedLimits   map[string]string
+	}{
+		{
+			expectedRequests: map[string]string{
+				"cpu":    "200m",
+				"memory": "512Mi",
+			},
+		},
+		{
+			resources: k8s.Resources{
+				Requests: map[string]string{
+					"cpu": "1",
+				},
+				Limits: map[string]string{
+					"cpu":    "2",
+					"memory": "2Gi",
+				},
+			},
+			expectedRequests: map[string]string{
+				"cpu":    "1",
+				"memory": "512Mi",
+			},
+			expectedLimits: map[string]string{
+				"cpu":    "2",
+				"memory": "2Gi",
+			},
+		},
+		{
+			resources: k8s.Resources{
+				Limits: map[string]string{
+					"memory": "256Mi",
+				},
+			},
+			expectedRequests: map[string]string{
+				"cpu":    "200m",
+				"memory": "256Mi",
+			},
+			expectedLimits: map[string]string{
+				"memory": "256Mi",
+			},
+		},
+	}
+
+	resourceList := func(list v1.ResourceList) map[string]string {
+		if list == nil {
+			return nil
+		}
+
+		result := map[string]string{}
+
+		for name, quantity := range list {
+			result[string(name)] = quantity.String()
+		}
+
+		return result
+	}
+
+	for _, test := range tests {
+		configAPIServer := k8s.NewAPIServerConfig()
+
+		*configAPIServer.TypedSpec() = k8s.APIServerConfigSpec{
+			Resources: test.resources,
+		}
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, configAPIServer))
+
+		suite.Assert().NoError(
+			retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+				func() error {
+					return suite.assertControlPlaneStaticPods(
+						[]string{
+							"kube-apiserver",
+						},
+					)
+				},
+			),
+		)
+
+		r, err := suite.state.Get(
+			suite.ctx,
+			resource.NewMetadata(k8s.NamespaceName, k8s.StaticPodType, "kube-apiserver", resource.VersionUndefined),
+		)
+		suite.Require().NoError(err)
+
+		apiServerPod, err := k8sadapter.StaticPod(r.(*k8s.StaticPod)).Pod()
+		suite.Require().NoError(err)
+
+		suite.Require().NotEmpty(apiServerPod.Spec.Containers)
+
+		suite.Assert().Equal(test.expectedRequests, resourceList(apiServerPod.Spec.Containers[0].Resources.Requests))
+		suite.Assert().Equal(test.expectedLimits, resourceList(apiServerPod.Spec.Containers[0].Resources.Limits))
+
+		suite.Require().NoError(suite.state.Destroy(suite.ctx, configAPIServer.Metadata()))
+
+		suite.Assert().NoError(
+			retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+				func() error {
+					list, err := suite.state.List(
+						suite.ctx,
+						resource.NewMetadata(k8s.NamespaceName, k8s.StaticPodType, "", resource.VersionUndefined),
+					)
+					if err != nil {
+						return err
+					}
+
+					if len(list.Items) > 0 {
+						return retry.ExpectedErrorf("expected no pods, got %d", len(list.Items))
+					}
+
+					return nil
+				},
+			),
+		)
+	}
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcilePriorityClassName() {
+	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, secretStatus))
+
+	configAPIServer := k8s.NewAPIServerConfig()
+
+	*configAPIServer.TypedSpec() = k8s.APIServerConfigSpec{
+		PriorityClassName: "system-node-critical",
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configAPIServer))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertControlPlaneStaticPods(
+					[]string{
+						"kube-apiserver",
+					},
+				)
+			},
+		),
+	)
+
+	r, err := suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(k8s.NamespaceName, k8s.StaticPodType, "kube-apiserver", resource.VersionUndefined),
+	)
+	suite.Require().NoError(err)
+
+	apiServerPod, err := k8sadapter.StaticPod(r.(*k8s.StaticPod)).Pod()
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("system-node-critical", apiServerPod.Spec.PriorityClassName)
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, configAPIServer.Metadata()))
+}
+
 func (suite *ControlPlaneStaticPodSuite) TestReconcileAdvertisedAddressArg() {
 	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
@@ -570,6 +738,167 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileAdvertisedAddressArg() {
 	suite.Require().NoError(suite.state.Destroy(suite.ctx, configAPIServer.Metadata()))
 }
 
+func (suite *ControlPlaneStaticPodSuite) TestReconcileAuditLogArgs() {
+	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, secretStatus))
+
+	configAPIServer := k8s.NewAPIServerConfig()
+
+	*configAPIServer.TypedSpec() = k8s.APIServerConfigSpec{
+		AuditLog: k8s.AuditLog{
+			MaxAge:     7,
+			MaxBackups: 0,
+			MaxSize:    50,
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configAPIServer))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertControlPlaneStaticPods(
+					[]string{
+						"kube-apiserver",
+					},
+				)
+			},
+		),
+	)
+
+	r, err := suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(k8s.NamespaceName, k8s.StaticPodType, "kube-apiserver", resource.VersionUndefined),
+	)
+	suite.Require().NoError(err)
+
+	apiServerPod, err := k8sadapter.StaticPod(r.(*k8s.StaticPod)).Pod()
+	suite.Require().NoError(err)
+
+	suite.Require().NotEmpty(apiServerPod.Spec.Containers)
+
+	suite.Assert().Contains(apiServerPod.Spec.Containers[0].Command, "--audit-log-maxage=7")
+	suite.Assert().Contains(apiServerPod.Spec.Containers[0].Command, "--audit-log-maxbackup=0")
+	suite.Assert().Contains(apiServerPod.Spec.Containers[0].Command, "--audit-log-maxsize=50")
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, configAPIServer.Metadata()))
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileOIDCArgs() {
+	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, secretStatus))
+
+	configAPIServer := k8s.NewAPIServerConfig()
+
+	*configAPIServer.TypedSpec() = k8s.APIServerConfigSpec{
+		OIDC: k8s.OIDC{
+			IssuerURL:     "https://accounts.example.com",
+			ClientID:      "kubernetes",
+			UsernameClaim: "email",
+			GroupsPrefix:  "oidc:",
+			RequiredClaims: map[string]string{
+				"hd":  "example.com",
+				"aud": "kubernetes",
+			},
+			SigningAlgorithms: []string{"RS256", "ES256"},
+			CAEnabled:         true,
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configAPIServer))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertControlPlaneStaticPods(
+					[]string{
+						"kube-apiserver",
+					},
+				)
+			},
+		),
+	)
+
+	r, err := suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(k8s.NamespaceName, k8s.StaticPodType, "kube-apiserver", resource.VersionUndefined),
+	)
+	suite.Require().NoError(err)
+
+	apiServerPod, err := k8sadapter.StaticPod(r.(*k8s.StaticPod)).Pod()
+	suite.Require().NoError(err)
+
+	suite.Require().NotEmpty(apiServerPod.Spec.Containers)
+
+	command := apiServerPod.Spec.Containers[0].Command
+
+	suite.Assert().Contains(command, "--oidc-issuer-url=https://accounts.example.com")
+	suite.Assert().Contains(command, "--oidc-client-id=kubernetes")
+	suite.Assert().Contains(command, "--oidc-username-claim=email")
+	suite.Assert().Contains(command, "--oidc-groups-prefix=oidc:")
+	suite.Assert().Contains(command, "--oidc-required-claim=aud=kubernetes,hd=example.com")
+	suite.Assert().Contains(command, "--oidc-signing-algs=RS256,ES256")
+	suite.Assert().Contains(command, "--oidc-ca-file="+filepath.Join(constants.KubernetesAPIServerSecretsDir, "oidc-ca.crt"))
+
+	for _, arg := range command {
+		suite.Assert().False(strings.HasPrefix(arg, "--oidc-groups-claim="), "unexpected argument %q", arg)
+	}
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, configAPIServer.Metadata()))
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileKMSFeatureGate() {
+	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, secretStatus))
+
+	configAPIServer := k8s.NewAPIServerConfig()
+
+	*configAPIServer.TypedSpec() = k8s.APIServerConfigSpec{
+		ExtraArgs: map[string]string{
+			"feature-gates": "APIServerTracing=true",
+		},
+		KMSEnabled: true,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configAPIServer))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertControlPlaneStaticPods(
+					[]string{
+						"kube-apiserver",
+					},
+				)
+			},
+		),
+	)
+
+	r, err := suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(k8s.NamespaceName, k8s.StaticPodType, "kube-apiserver", resource.VersionUndefined),
+	)
+	suite.Require().NoError(err)
+
+	apiServerPod, err := k8sadapter.StaticPod(r.(*k8s.StaticPod)).Pod()
+	suite.Require().NoError(err)
+
+	suite.Require().NotEmpty(apiServerPod.Spec.Containers)
+
+	suite.Assert().Contains(apiServerPod.Spec.Containers[0].Command, "--feature-gates=KMSv2=true,APIServerTracing=true")
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, configAPIServer.Metadata()))
+}
+
 func (suite *ControlPlaneStaticPodSuite) TestControlPlaneStaticPodsExceptScheduler() {
 	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
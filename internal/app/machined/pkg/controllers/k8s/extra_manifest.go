@@ -20,7 +20,6 @@ import (
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 
-	k8sadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/k8s"
 	"github.com/talos-systems/talos/pkg/httpdefaults"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
@@ -210,7 +209,7 @@ func (ctrl *ExtraManifestController) processURL(ctx context.Context, r controlle
 
 	if err = r.Modify(ctx, k8s.NewManifest(k8s.ControlPlaneNamespaceName, id),
 		func(r resource.Resource) error {
-			return k8sadapter.Manifest(r.(*k8s.Manifest)).SetYAML(contents)
+			return setManifestYAML(r.(*k8s.Manifest), contents, manifest.Prune)
 		}); err != nil {
 		err = fmt.Errorf("error updating manifests: %w", err)
 
@@ -225,7 +224,7 @@ func (ctrl *ExtraManifestController) processInline(ctx context.Context, r contro
 		ctx,
 		k8s.NewManifest(k8s.ControlPlaneNamespaceName, id),
 		func(r resource.Resource) error {
-			return k8sadapter.Manifest(r.(*k8s.Manifest)).SetYAML([]byte(manifest.InlineManifest))
+			return setManifestYAML(r.(*k8s.Manifest), []byte(manifest.InlineManifest), manifest.Prune)
 		},
 	)
 	if err != nil {
@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package auditlog implements following kube-apiserver audit log files.
+package auditlog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// DefaultPollInterval is the default interval to check the audit log file for updates.
+const DefaultPollInterval = time.Second
+
+// Follower follows the audit log file across the rotations.
+//
+// kube-apiserver rotates the audit log by renaming the current file and creating a new one,
+// so the follower drains the renamed file before switching to the new one.
+type Follower struct {
+	// Path is the path to the current audit log file.
+	Path string
+	// PollInterval is the interval to check the file for updates.
+	PollInterval time.Duration
+}
+
+type followedFile struct {
+	f      *os.File
+	info   fs.FileInfo
+	r      *bufio.Reader
+	offset int64
+	// partial line which was not terminated yet
+	partial []byte
+}
+
+// Run copies complete lines of the audit log to the writer until the context is canceled.
+//
+// The contents of the file which exists when the follower is started are skipped,
+// as they were already processed before (e.g. before the reboot).
+//
+//nolint:gocyclo,cyclop
+func (follower *Follower) Run(ctx context.Context, w io.Writer) error {
+	pollInterval := follower.PollInterval
+	if pollInterval == 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var current *followedFile
+
+	defer func() {
+		if current != nil {
+			current.f.Close() //nolint:errcheck
+		}
+	}()
+
+	skipExisting := true
+
+	for {
+		if current == nil {
+			var err error
+
+			current, err = openFollowedFile(follower.Path, skipExisting)
+			if err != nil {
+				return err
+			}
+		}
+
+		skipExisting = false
+
+		if current != nil {
+			if err := current.copyLines(w); err != nil {
+				return err
+			}
+
+			info, err := os.Stat(follower.Path)
+
+			switch {
+			case err == nil && !os.SameFile(info, current.info):
+				// file was rotated, drain the old one, and switch to the new one
+				if err = current.copyLines(w); err != nil {
+					return err
+				}
+
+				current.f.Close() //nolint:errcheck
+				current = nil
+
+				continue
+			case err == nil && info.Size() < current.offset:
+				// file was truncated, start over
+				if err = current.rewind(); err != nil {
+					return err
+				}
+
+				continue
+			case err != nil && !errors.Is(err, fs.ErrNotExist):
+				return fmt.Errorf("error checking audit log file: %w", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func openFollowedFile(path string, skipExisting bool) (*followedFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error opening audit log file: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error checking audit log file: %w", err)
+	}
+
+	file := &followedFile{
+		f:    f,
+		info: info,
+		r:    bufio.NewReader(f),
+	}
+
+	if skipExisting {
+		if file.offset, err = f.Seek(0, io.SeekEnd); err != nil {
+			f.Close() //nolint:errcheck
+
+			return nil, fmt.Errorf("error seeking audit log file: %w", err)
+		}
+
+		file.r.Reset(f)
+	}
+
+	return file, nil
+}
+
+func (file *followedFile) rewind() error {
+	if _, err := file.f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("error seeking audit log file: %w", err)
+	}
+
+	file.r.Reset(file.f)
+	file.offset = 0
+	file.partial = nil
+
+	return nil
+}
+
+// copyLines copies all complete lines available in the file to the writer.
+func (file *followedFile) copyLines(w io.Writer) error {
+	for {
+		chunk, err := file.r.ReadBytes('\n')
+		file.offset += int64(len(chunk))
+
+		if len(chunk) > 0 {
+			file.partial = append(file.partial, chunk...)
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return fmt.Errorf("error reading audit log file: %w", err)
+		}
+
+		line := file.partial
+		file.partial = nil
+
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		if _, err = w.Write(line); err != nil {
+			return fmt.Errorf("error writing audit log: %w", err)
+		}
+	}
+}
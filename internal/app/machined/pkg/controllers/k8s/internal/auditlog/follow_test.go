@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auditlog_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s/internal/auditlog"
+)
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+func appendFile(t *testing.T, path, contents string) {
+	t.Helper()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+
+	_, err = f.WriteString(contents)
+	require.NoError(t, err)
+
+	require.NoError(t, f.Close())
+}
+
+func TestFollower(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "kube-apiserver.log")
+
+	appendFile(t, path, "{\"old\":1}\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	follower := &auditlog.Follower{
+		Path:         path,
+		PollInterval: 10 * time.Millisecond,
+	}
+
+	var out syncBuffer
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- follower.Run(ctx, &out)
+	}()
+
+	// wait for the follower to open the file
+	time.Sleep(100 * time.Millisecond)
+
+	appendFile(t, path, "{\"a\":1}\n{\"b\"")
+
+	assert.Eventually(t, func() bool {
+		return out.String() == "{\"a\":1}\n"
+	}, time.Second, 10*time.Millisecond)
+
+	appendFile(t, path, ":2}\n")
+
+	// rotate the file
+	require.NoError(t, os.Rename(path, filepath.Join(dir, "kube-apiserver-2022-10-19T00-00-00.000.log")))
+
+	appendFile(t, path, "{\"c\":3}\n")
+
+	assert.Eventually(t, func() bool {
+		return out.String() == "{\"a\":1}\n{\"b\":2}\n{\"c\":3}\n"
+	}, time.Second, 10*time.Millisecond)
+
+	// truncate the file
+	require.NoError(t, os.Truncate(path, 0))
+
+	// wait for the follower to notice the truncation
+	time.Sleep(100 * time.Millisecond)
+
+	appendFile(t, path, "{\"d\":4}\n")
+
+	assert.Eventually(t, func() bool {
+		return out.String() == "{\"a\":1}\n{\"b\":2}\n{\"c\":3}\n{\"d\":4}\n"
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+
+	require.NoError(t, <-errCh)
+}
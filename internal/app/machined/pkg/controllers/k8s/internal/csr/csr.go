@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package csr implements validation of kubelet serving certificate signing requests.
+package csr
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/siderolabs/gen/slices"
+	certificatesv1 "k8s.io/api/certificates/v1"
+)
+
+const (
+	nodeUserPrefix = "system:node:"
+	nodesGroup     = "system:nodes"
+)
+
+// Node describes a cluster node which is allowed to request a kubelet serving certificate.
+type Node struct {
+	// Name is the Kubernetes node name.
+	Name string
+	// DNSNames is the list of DNS names of the node.
+	DNSNames []string
+	// Addresses is the list of node addresses.
+	Addresses []netip.Addr
+}
+
+func (node Node) hasDNSName(name string) bool {
+	return slices.Contains(node.DNSNames, func(n string) bool { return strings.EqualFold(n, name) })
+}
+
+func (node Node) hasAddress(addr netip.Addr) bool {
+	return slices.Contains(node.Addresses, func(a netip.Addr) bool { return a == addr.Unmap() })
+}
+
+// Validate checks the kubelet serving CSR against the list of known nodes.
+//
+// The CSR should be approved only if it was submitted by the node itself, and all DNS names and IP addresses
+// of the request belong to the node. Otherwise the CSR is left pending with the reason, so that it can be handled
+// by another approver (or approved later when the node information is updated).
+//
+//nolint:gocyclo,cyclop
+func Validate(csr *certificatesv1.CertificateSigningRequest, nodes []Node) (approve bool, reason string) {
+	if csr.Spec.SignerName != certificatesv1.KubeletServingSignerName {
+		return false, fmt.Sprintf("unsupported signer %q", csr.Spec.SignerName)
+	}
+
+	if !strings.HasPrefix(csr.Spec.Username, nodeUserPrefix) {
+		return false, fmt.Sprintf("username %q is not a node", csr.Spec.Username)
+	}
+
+	nodeName := strings.TrimPrefix(csr.Spec.Username, nodeUserPrefix)
+
+	if !slices.Contains(csr.Spec.Groups, func(group string) bool { return group == nodesGroup }) {
+		return false, fmt.Sprintf("user is not in the %q group", nodesGroup)
+	}
+
+	for _, usage := range csr.Spec.Usages {
+		switch usage { //nolint:exhaustive
+		case certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageServerAuth:
+		default:
+			return false, fmt.Sprintf("usage %q is not allowed", usage)
+		}
+	}
+
+	if !slices.Contains(csr.Spec.Usages, func(usage certificatesv1.KeyUsage) bool { return usage == certificatesv1.UsageServerAuth }) {
+		return false, fmt.Sprintf("usage %q is required", certificatesv1.UsageServerAuth)
+	}
+
+	request, err := parseRequest(csr.Spec.Request)
+	if err != nil {
+		return false, err.Error()
+	}
+
+	if request.Subject.CommonName != csr.Spec.Username {
+		return false, fmt.Sprintf("common name %q doesn't match the username", request.Subject.CommonName)
+	}
+
+	if len(request.Subject.Organization) != 1 || request.Subject.Organization[0] != nodesGroup {
+		return false, fmt.Sprintf("organization %q is not allowed", request.Subject.Organization)
+	}
+
+	if len(request.EmailAddresses) > 0 || len(request.URIs) > 0 {
+		return false, "email and URI SANs are not allowed"
+	}
+
+	if len(request.DNSNames) == 0 && len(request.IPAddresses) == 0 {
+		return false, "at least one DNS or IP SAN is required"
+	}
+
+	idx := slices.IndexFunc(nodes, func(node Node) bool { return node.Name == nodeName })
+	if idx == -1 {
+		return false, fmt.Sprintf("node %q is not known", nodeName)
+	}
+
+	node := nodes[idx]
+
+	for _, name := range request.DNSNames {
+		if !node.hasDNSName(name) {
+			return false, fmt.Sprintf("DNS name %q doesn't belong to node %q", name, nodeName)
+		}
+	}
+
+	for _, ip := range request.IPAddresses {
+		addr, ok := netip.AddrFromSlice(ip)
+		if !ok || !node.hasAddress(addr) {
+			return false, fmt.Sprintf("IP address %q doesn't belong to node %q", ip, nodeName)
+		}
+	}
+
+	return true, fmt.Sprintf("SANs match node %q", nodeName)
+}
+
+func parseRequest(data []byte) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("PEM block type must be CERTIFICATE REQUEST")
+	}
+
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate request: %w", err)
+	}
+
+	return request, nil
+}
@@ -0,0 +1,247 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package csr_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	certificatesv1 "k8s.io/api/certificates/v1"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s/internal/csr"
+)
+
+func generateRequest(t *testing.T, template *x509.CertificateRequest) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+}
+
+//nolint:maintidx
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	nodes := []csr.Node{
+		{
+			Name:      "worker-1",
+			DNSNames:  []string{"worker-1", "worker-1.example.com"},
+			Addresses: []netip.Addr{netip.MustParseAddr("172.20.0.5"), netip.MustParseAddr("fd00::5")},
+		},
+		{
+			Name:      "worker-2",
+			DNSNames:  []string{"worker-2"},
+			Addresses: []netip.Addr{netip.MustParseAddr("172.20.0.6")},
+		},
+	}
+
+	validSubject := pkix.Name{
+		CommonName:   "system:node:worker-1",
+		Organization: []string{"system:nodes"},
+	}
+
+	validUsages := []certificatesv1.KeyUsage{
+		certificatesv1.UsageDigitalSignature,
+		certificatesv1.UsageKeyEncipherment,
+		certificatesv1.UsageServerAuth,
+	}
+
+	for _, test := range []struct {
+		name string
+
+		username string
+		groups   []string
+		usages   []certificatesv1.KeyUsage
+		template *x509.CertificateRequest
+		nodes    []csr.Node
+
+		expected       bool
+		expectedReason string
+	}{
+		{
+			name: "valid",
+			template: &x509.CertificateRequest{
+				Subject:     validSubject,
+				DNSNames:    []string{"worker-1"},
+				IPAddresses: []net.IP{net.ParseIP("172.20.0.5"), net.ParseIP("fd00::5")},
+			},
+			expected:       true,
+			expectedReason: `SANs match node "worker-1"`,
+		},
+		{
+			name: "valid FQDN",
+			template: &x509.CertificateRequest{
+				Subject:  validSubject,
+				DNSNames: []string{"WORKER-1.example.com"},
+			},
+			expected:       true,
+			expectedReason: `SANs match node "worker-1"`,
+		},
+		{
+			name: "unknown node",
+			template: &x509.CertificateRequest{
+				Subject:  validSubject,
+				DNSNames: []string{"worker-1"},
+			},
+			nodes:          nodes[1:],
+			expected:       false,
+			expectedReason: `node "worker-1" is not known`,
+		},
+		{
+			name: "node name is not a hostname",
+			template: &x509.CertificateRequest{
+				Subject:  validSubject,
+				DNSNames: []string{"worker-1"},
+			},
+			nodes:          []csr.Node{{Name: "worker-1.example.com", DNSNames: []string{"worker-1", "worker-1.example.com"}}},
+			expected:       false,
+			expectedReason: `node "worker-1" is not known`,
+		},
+		{
+			name:     "foreign IP",
+			username: "system:node:worker-2",
+			template: &x509.CertificateRequest{
+				Subject: pkix.Name{
+					CommonName:   "system:node:worker-2",
+					Organization: []string{"system:nodes"},
+				},
+				DNSNames:    []string{"worker-2"},
+				IPAddresses: []net.IP{net.ParseIP("172.20.0.5")},
+			},
+			expected:       false,
+			expectedReason: `IP address "172.20.0.5" doesn't belong to node "worker-2"`,
+		},
+		{
+			name: "foreign DNS name",
+			template: &x509.CertificateRequest{
+				Subject:  validSubject,
+				DNSNames: []string{"worker-2"},
+			},
+			expected:       false,
+			expectedReason: `DNS name "worker-2" doesn't belong to node "worker-1"`,
+		},
+		{
+			name:     "not a node",
+			username: "admin",
+			template: &x509.CertificateRequest{
+				Subject:  validSubject,
+				DNSNames: []string{"worker-1"},
+			},
+			expected:       false,
+			expectedReason: `username "admin" is not a node`,
+		},
+		{
+			name:   "missing group",
+			groups: []string{"system:authenticated"},
+			template: &x509.CertificateRequest{
+				Subject:  validSubject,
+				DNSNames: []string{"worker-1"},
+			},
+			expected:       false,
+			expectedReason: `user is not in the "system:nodes" group`,
+		},
+		{
+			name:   "client auth usage",
+			usages: append(append([]certificatesv1.KeyUsage(nil), validUsages...), certificatesv1.UsageClientAuth),
+			template: &x509.CertificateRequest{
+				Subject:  validSubject,
+				DNSNames: []string{"worker-1"},
+			},
+			expected:       false,
+			expectedReason: `usage "client auth" is not allowed`,
+		},
+		{
+			name:   "missing server auth usage",
+			usages: []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature},
+			template: &x509.CertificateRequest{
+				Subject:  validSubject,
+				DNSNames: []string{"worker-1"},
+			},
+			expected:       false,
+			expectedReason: `usage "server auth" is required`,
+		},
+		{
+			name: "common name mismatch",
+			template: &x509.CertificateRequest{
+				Subject: pkix.Name{
+					CommonName:   "system:node:worker-2",
+					Organization: []string{"system:nodes"},
+				},
+				DNSNames: []string{"worker-1"},
+			},
+			expected:       false,
+			expectedReason: `common name "system:node:worker-2" doesn't match the username`,
+		},
+		{
+			name: "email SAN",
+			template: &x509.CertificateRequest{
+				Subject:        validSubject,
+				DNSNames:       []string{"worker-1"},
+				EmailAddresses: []string{"root@example.com"},
+			},
+			expected:       false,
+			expectedReason: "email and URI SANs are not allowed",
+		},
+		{
+			name: "no SANs",
+			template: &x509.CertificateRequest{
+				Subject: validSubject,
+			},
+			expected:       false,
+			expectedReason: "at least one DNS or IP SAN is required",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &certificatesv1.CertificateSigningRequest{
+				Spec: certificatesv1.CertificateSigningRequestSpec{
+					Request:    generateRequest(t, test.template),
+					SignerName: certificatesv1.KubeletServingSignerName,
+					Username:   "system:node:worker-1",
+					Groups:     []string{"system:nodes", "system:authenticated"},
+					Usages:     validUsages,
+				},
+			}
+
+			if test.username != "" {
+				request.Spec.Username = test.username
+			}
+
+			if test.groups != nil {
+				request.Spec.Groups = test.groups
+			}
+
+			if test.usages != nil {
+				request.Spec.Usages = test.usages
+			}
+
+			testNodes := test.nodes
+			if testNodes == nil {
+				testNodes = nodes
+			}
+
+			approve, reason := csr.Validate(request, testNodes)
+			assert.Equal(t, test.expected, approve)
+			assert.Equal(t, test.expectedReason, reason)
+		})
+	}
+}
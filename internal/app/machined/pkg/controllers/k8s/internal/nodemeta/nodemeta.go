@@ -0,0 +1,161 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package nodemeta implements reconciling the Node labels, annotations and taints managed by Talos.
+//
+// Talos keeps track of the keys it has set in the Node annotations, so that only these keys
+// are removed when they are dropped from the machine configuration.
+package nodemeta
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/siderolabs/gen/maps"
+	"github.com/siderolabs/gen/slices"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// ApplyLabelsAndAnnotations updates the labels and annotations of the node to match the desired ones.
+func ApplyLabelsAndAnnotations(node *v1.Node, labels, annotations map[string]string) {
+	// ownership annotations can't be overridden
+	annotations = maps.Filter(annotations, func(key, _ string) bool {
+		return !isOwnershipAnnotation(key)
+	})
+
+	var ownedLabels, ownedAnnotations []string
+
+	node.Labels, ownedLabels = reconcile(node.Labels, OwnedKeys(node, constants.AnnotationOwnedLabels), labels)
+	node.Annotations, ownedAnnotations = reconcile(node.Annotations, OwnedKeys(node, constants.AnnotationOwnedAnnotations), annotations)
+
+	setOwnedKeys(node, constants.AnnotationOwnedLabels, ownedLabels)
+	setOwnedKeys(node, constants.AnnotationOwnedAnnotations, ownedAnnotations)
+}
+
+// ApplyTaints updates the taints of the node to match the desired ones.
+//
+// Existing taints with the keys owned by Talos are replaced with the desired taints.
+// Taints not owned by Talos are kept, unless a desired taint has the same key and effect
+// (as the taint with the same key and effect can't be set twice).
+func ApplyTaints(node *v1.Node, taints []v1.Taint) {
+	owned := slices.ToSet(OwnedKeys(node, constants.AnnotationOwnedTaints))
+
+	var result []v1.Taint
+
+	for _, taint := range node.Spec.Taints {
+		taint := taint
+
+		if !containsTaint(taints, &taint) {
+			if _, ok := owned[taint.Key]; ok {
+				continue
+			}
+
+			if slices.Contains(taints, func(t v1.Taint) bool { return t.MatchTaint(&taint) }) {
+				continue
+			}
+		}
+
+		result = append(result, taint)
+	}
+
+	for _, taint := range taints {
+		taint := taint
+
+		if !containsTaint(result, &taint) {
+			result = append(result, taint)
+		}
+	}
+
+	node.Spec.Taints = result
+
+	ownedTaints := make(map[string]struct{}, len(taints))
+
+	for _, taint := range taints {
+		ownedTaints[taint.Key] = struct{}{}
+	}
+
+	setOwnedKeys(node, constants.AnnotationOwnedTaints, sortedKeys(ownedTaints))
+}
+
+// OwnedKeys returns the list of keys owned by Talos from the ownership annotation.
+func OwnedKeys(node *v1.Node, annotation string) []string {
+	data, ok := node.Annotations[annotation]
+	if !ok {
+		return nil
+	}
+
+	var keys []string
+
+	// the annotation might be corrupted, in that case nothing is owned
+	if err := json.Unmarshal([]byte(data), &keys); err != nil {
+		return nil
+	}
+
+	return keys
+}
+
+func setOwnedKeys(node *v1.Node, annotation string, keys []string) {
+	if len(keys) == 0 {
+		delete(node.Annotations, annotation)
+
+		return
+	}
+
+	data, err := json.Marshal(keys)
+	if err != nil {
+		// can't happen for a list of strings
+		panic(err)
+	}
+
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+
+	node.Annotations[annotation] = string(data)
+}
+
+// reconcile removes owned keys which are not desired anymore, and sets the desired keys.
+//
+// It returns the updated map and the new list of owned keys.
+func reconcile(current map[string]string, owned []string, desired map[string]string) (map[string]string, []string) {
+	for _, key := range owned {
+		if _, ok := desired[key]; !ok {
+			delete(current, key)
+		}
+	}
+
+	if len(desired) > 0 && current == nil {
+		current = make(map[string]string, len(desired))
+	}
+
+	for key, value := range desired {
+		current[key] = value
+	}
+
+	return current, sortedKeys(desired)
+}
+
+func containsTaint(taints []v1.Taint, taint *v1.Taint) bool {
+	return slices.Contains(taints, func(t v1.Taint) bool {
+		return t.MatchTaint(taint) && t.Value == taint.Value
+	})
+}
+
+func isOwnershipAnnotation(key string) bool {
+	switch key {
+	case constants.AnnotationOwnedLabels, constants.AnnotationOwnedAnnotations, constants.AnnotationOwnedTaints:
+		return true
+	default:
+		return false
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := maps.Keys(m)
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,270 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nodemeta_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s/internal/nodemeta"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+func TestApplyLabelsAndAnnotations(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		labels      map[string]string
+		annotations map[string]string
+
+		desiredLabels      map[string]string
+		desiredAnnotations map[string]string
+
+		expectedLabels      map[string]string
+		expectedAnnotations map[string]string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "take ownership",
+			labels: map[string]string{
+				"kubernetes.io/hostname": "worker-1",
+				"zone":                   "a",
+			},
+			desiredLabels: map[string]string{
+				"zone": "b",
+				"pool": "gpu",
+			},
+			desiredAnnotations: map[string]string{
+				"example.com/owner": "team-a",
+			},
+			expectedLabels: map[string]string{
+				"kubernetes.io/hostname": "worker-1",
+				"zone":                   "b",
+				"pool":                   "gpu",
+			},
+			expectedAnnotations: map[string]string{
+				"example.com/owner":                  "team-a",
+				constants.AnnotationOwnedLabels:      `["pool","zone"]`,
+				constants.AnnotationOwnedAnnotations: `["example.com/owner"]`,
+			},
+		},
+		{
+			name: "remove owned",
+			labels: map[string]string{
+				"kubernetes.io/hostname": "worker-1",
+				"zone":                   "b",
+				"pool":                   "gpu",
+			},
+			annotations: map[string]string{
+				"example.com/owner":                  "team-a",
+				"node.alpha.kubernetes.io/ttl":       "0",
+				constants.AnnotationOwnedLabels:      `["pool","zone"]`,
+				constants.AnnotationOwnedAnnotations: `["example.com/owner"]`,
+			},
+			desiredLabels: map[string]string{
+				"zone": "b",
+			},
+			expectedLabels: map[string]string{
+				"kubernetes.io/hostname": "worker-1",
+				"zone":                   "b",
+			},
+			expectedAnnotations: map[string]string{
+				"node.alpha.kubernetes.io/ttl":  "0",
+				constants.AnnotationOwnedLabels: `["zone"]`,
+			},
+		},
+		{
+			name: "ownership annotations are protected",
+			annotations: map[string]string{
+				constants.AnnotationOwnedLabels: `["zone"]`,
+			},
+			desiredAnnotations: map[string]string{
+				constants.AnnotationOwnedLabels: `["kubernetes.io/hostname"]`,
+			},
+			expectedAnnotations: map[string]string{},
+		},
+		{
+			name: "corrupted ownership annotation",
+			labels: map[string]string{
+				"zone": "a",
+			},
+			annotations: map[string]string{
+				constants.AnnotationOwnedLabels: `zone`,
+			},
+			expectedLabels: map[string]string{
+				"zone": "a",
+			},
+			expectedAnnotations: map[string]string{},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      test.labels,
+					Annotations: test.annotations,
+				},
+			}
+
+			nodemeta.ApplyLabelsAndAnnotations(node, test.desiredLabels, test.desiredAnnotations)
+
+			assert.Equal(t, test.expectedLabels, node.Labels)
+			assert.Equal(t, test.expectedAnnotations, node.Annotations)
+		})
+	}
+}
+
+func TestApplyTaints(t *testing.T) {
+	t.Parallel()
+
+	unowned := v1.Taint{Key: "node.kubernetes.io/unschedulable", Effect: v1.TaintEffectNoSchedule}
+
+	for _, test := range []struct {
+		name string
+
+		taints      []v1.Taint
+		annotations map[string]string
+
+		desiredTaints []v1.Taint
+
+		expectedTaints      []v1.Taint
+		expectedAnnotations map[string]string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:   "add",
+			taints: []v1.Taint{unowned},
+			desiredTaints: []v1.Taint{
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+			},
+			expectedTaints: []v1.Taint{
+				unowned,
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+			},
+			expectedAnnotations: map[string]string{
+				constants.AnnotationOwnedTaints: `["gpu"]`,
+			},
+		},
+		{
+			name: "replace",
+			taints: []v1.Taint{
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+				unowned,
+			},
+			annotations: map[string]string{
+				constants.AnnotationOwnedTaints: `["gpu"]`,
+			},
+			desiredTaints: []v1.Taint{
+				{Key: "gpu", Value: "false", Effect: v1.TaintEffectNoExecute},
+			},
+			expectedTaints: []v1.Taint{
+				unowned,
+				{Key: "gpu", Value: "false", Effect: v1.TaintEffectNoExecute},
+			},
+			expectedAnnotations: map[string]string{
+				constants.AnnotationOwnedTaints: `["gpu"]`,
+			},
+		},
+		{
+			name: "keep unowned key",
+			taints: []v1.Taint{
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoExecute},
+				unowned,
+			},
+			desiredTaints: []v1.Taint{
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+			},
+			expectedTaints: []v1.Taint{
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoExecute},
+				unowned,
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+			},
+			expectedAnnotations: map[string]string{
+				constants.AnnotationOwnedTaints: `["gpu"]`,
+			},
+		},
+		{
+			name: "override unowned key and effect",
+			taints: []v1.Taint{
+				{Key: "gpu", Value: "false", Effect: v1.TaintEffectNoSchedule},
+				unowned,
+			},
+			desiredTaints: []v1.Taint{
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+			},
+			expectedTaints: []v1.Taint{
+				unowned,
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+			},
+			expectedAnnotations: map[string]string{
+				constants.AnnotationOwnedTaints: `["gpu"]`,
+			},
+		},
+		{
+			name: "remove owned",
+			taints: []v1.Taint{
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+				unowned,
+			},
+			annotations: map[string]string{
+				constants.AnnotationOwnedTaints: `["gpu"]`,
+			},
+			expectedTaints:      []v1.Taint{unowned},
+			expectedAnnotations: map[string]string{},
+		},
+		{
+			name: "no changes",
+			taints: []v1.Taint{
+				unowned,
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+			},
+			annotations: map[string]string{
+				constants.AnnotationOwnedTaints: `["gpu"]`,
+			},
+			desiredTaints: []v1.Taint{
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+			},
+			expectedTaints: []v1.Taint{
+				unowned,
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+			},
+			expectedAnnotations: map[string]string{
+				constants.AnnotationOwnedTaints: `["gpu"]`,
+			},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: test.annotations,
+				},
+				Spec: v1.NodeSpec{
+					Taints: test.taints,
+				},
+			}
+
+			nodemeta.ApplyTaints(node, test.desiredTaints)
+
+			assert.Equal(t, test.expectedTaints, node.Spec.Taints)
+			assert.Equal(t, test.expectedAnnotations, node.Annotations)
+		})
+	}
+}
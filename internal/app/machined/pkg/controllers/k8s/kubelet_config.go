@@ -137,11 +137,13 @@ func modifyKubeletConfig(cfgProvider talosconfig.Provider, staticPodListURL stri
 		kubeletConfig.ExtraArgs = cfgProvider.Machine().Kubelet().ExtraArgs()
 		kubeletConfig.ExtraMounts = cfgProvider.Machine().Kubelet().ExtraMounts()
 		kubeletConfig.ExtraConfig = cfgProvider.Machine().Kubelet().ExtraConfig()
+		kubeletConfig.CredentialProviderConfig = cfgProvider.Machine().Kubelet().CredentialProviderConfig()
 		kubeletConfig.CloudProviderExternal = cfgProvider.Cluster().ExternalCloudProvider().Enabled()
 		kubeletConfig.DefaultRuntimeSeccompEnabled = cfgProvider.Machine().Kubelet().DefaultRuntimeSeccompProfileEnabled()
 		kubeletConfig.SkipNodeRegistration = cfgProvider.Machine().Kubelet().SkipNodeRegistration()
 		kubeletConfig.StaticPodListURL = staticPodListURL
 		kubeletConfig.DisableManifestsDirectory = cfgProvider.Machine().Kubelet().DisableManifestsDirectory()
+		kubeletConfig.RegisterWithTaints = nodeTaints(cfgProvider.Machine().NodeTaints())
 
 		return nil
 	}
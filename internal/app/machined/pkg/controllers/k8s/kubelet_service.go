@@ -31,6 +31,7 @@ import (
 	runtimetalos "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/services"
+	"github.com/talos-systems/talos/pkg/kubeconfig"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/files"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
@@ -197,7 +198,12 @@ func (ctrl *KubeletServiceController) Run(ctx context.Context, r controller.Runt
 			}
 		}
 
-		err = updateKubeconfig(secretSpec.Endpoint)
+		// refresh the client certificate if it was issued by a CA which is no longer the cluster CA
+		if err = ctrl.refreshKubeletClientCert(secretSpec.CA.Crt); err != nil {
+			return err
+		}
+
+		err = updateKubeconfig(secretSpec.Endpoint, kubeconfig.CABundle(secretSpec.CA, secretSpec.AcceptedCAs))
 		if err != nil {
 			return err
 		}
@@ -209,6 +215,8 @@ func (ctrl *KubeletServiceController) Run(ctx context.Context, r controller.Runt
 }
 
 func (ctrl *KubeletServiceController) writePKI(secretSpec *secrets.KubeletSpec) error {
+	caBundle := kubeconfig.CABundle(secretSpec.CA, secretSpec.AcceptedCAs)
+
 	cfg := struct {
 		Server               string
 		CACert               string
@@ -216,7 +224,7 @@ func (ctrl *KubeletServiceController) writePKI(secretSpec *secrets.KubeletSpec)
 		BootstrapTokenSecret string
 	}{
 		Server:               secretSpec.Endpoint.String(),
-		CACert:               base64.StdEncoding.EncodeToString(secretSpec.CA.Crt),
+		CACert:               base64.StdEncoding.EncodeToString(caBundle),
 		BootstrapTokenID:     secretSpec.BootstrapTokenID,
 		BootstrapTokenSecret: secretSpec.BootstrapTokenSecret,
 	}
@@ -237,7 +245,7 @@ func (ctrl *KubeletServiceController) writePKI(secretSpec *secrets.KubeletSpec)
 		return err
 	}
 
-	if err := os.WriteFile(constants.KubernetesCACert, secretSpec.CA.Crt, 0o400); err != nil {
+	if err := os.WriteFile(constants.KubernetesCACert, caBundle, 0o400); err != nil {
 		return err
 	}
 
@@ -268,6 +276,37 @@ func (ctrl *KubeletServiceController) writeConfig(cfgSpec *k8s.KubeletSpecSpec)
 		return fmt.Errorf("error converting kubelet configuration from unstructured: %w", err)
 	}
 
+	buf, err := encodeKubeletConfig(&kubeletConfiguration)
+	if err != nil {
+		return err
+	}
+
+	if err = os.WriteFile("/etc/kubernetes/kubelet.yaml", buf, 0o600); err != nil {
+		return err
+	}
+
+	if len(cfgSpec.CredentialProviderConfig) == 0 {
+		if err = os.Remove(constants.KubeletCredentialProviderConfig); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	var credentialProviderConfig kubeletconfig.CredentialProviderConfig
+
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(cfgSpec.CredentialProviderConfig, &credentialProviderConfig); err != nil {
+		return fmt.Errorf("error converting credential provider configuration from unstructured: %w", err)
+	}
+
+	if buf, err = encodeKubeletConfig(&credentialProviderConfig); err != nil {
+		return err
+	}
+
+	return os.WriteFile(constants.KubeletCredentialProviderConfig, buf, 0o600)
+}
+
+func encodeKubeletConfig(obj runtime.Object) ([]byte, error) {
 	serializer := json.NewSerializerWithOptions(
 		json.DefaultMetaFactory,
 		nil,
@@ -281,15 +320,15 @@ func (ctrl *KubeletServiceController) writeConfig(cfgSpec *k8s.KubeletSpecSpec)
 
 	var buf bytes.Buffer
 
-	if err := serializer.Encode(&kubeletConfiguration, &buf); err != nil {
-		return err
+	if err := serializer.Encode(obj, &buf); err != nil {
+		return nil, err
 	}
 
-	return os.WriteFile("/etc/kubernetes/kubelet.yaml", buf.Bytes(), 0o600)
+	return buf.Bytes(), nil
 }
 
-// updateKubeconfig updates the kubeconfig of kubelet with the given endpoint if it exists.
-func updateKubeconfig(newEndpoint *url.URL) error {
+// updateKubeconfig updates the kubeconfig of kubelet with the given endpoint and CA bundle if it exists.
+func updateKubeconfig(newEndpoint *url.URL, caBundle []byte) error {
 	config, err := clientcmd.LoadFromFile(constants.KubeletKubeconfig)
 	if errors.Is(err, os.ErrNotExist) {
 		return nil
@@ -300,11 +339,12 @@ func updateKubeconfig(newEndpoint *url.URL) error {
 	}
 
 	cluster := config.Clusters[config.Contexts[config.CurrentContext].Cluster]
-	if cluster.Server == newEndpoint.String() {
+	if cluster.Server == newEndpoint.String() && bytes.Equal(cluster.CertificateAuthorityData, caBundle) {
 		return nil
 	}
 
 	cluster.Server = newEndpoint.String()
+	cluster.CertificateAuthorityData = caBundle
 
 	return clientcmd.WriteToFile(*config, constants.KubeletKubeconfig)
 }
@@ -346,8 +386,62 @@ func (ctrl *KubeletServiceController) refreshKubeletCerts(hostname string) error
 	return err
 }
 
+// refreshKubeletClientCert checks if the existing kubelet client certificate is signed by the cluster CA.
+// If it's not (e.g. after the CA rotation), the client certificate and kubelet's kubeconfig are removed,
+// so that kubelet goes through the bootstrap process on next start.
+func (ctrl *KubeletServiceController) refreshKubeletClientCert(caPEM []byte) error {
+	cert, err := readCertificate(filepath.Join(constants.KubeletPKIDir, "kubelet-client-current.pem"))
+	if err != nil {
+		return err
+	}
+
+	if cert == nil {
+		return nil
+	}
+
+	block, _ := pem.Decode(caPEM)
+	if block == nil {
+		return fmt.Errorf("failed to decode cluster CA")
+	}
+
+	ca, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse cluster CA: %w", err)
+	}
+
+	if cert.CheckSignatureFrom(ca) == nil {
+		// certificate is signed by the current CA, no need to refresh
+		return nil
+	}
+
+	// remove the client certificates, kubelet-client-current.pem is a symlink
+	matches, err := filepath.Glob(filepath.Join(constants.KubeletPKIDir, "kubelet-client*"))
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		if err = os.Remove(match); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	// clear the kubelet kubeconfig
+	err = os.Remove(constants.KubeletKubeconfig)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
 func (ctrl *KubeletServiceController) readKubeletCertificate() (*x509.Certificate, error) {
-	raw, err := os.ReadFile(filepath.Join(constants.KubeletPKIDir, "kubelet.crt"))
+	return readCertificate(filepath.Join(constants.KubeletPKIDir, "kubelet.crt"))
+}
+
+// readCertificate reads the first non-CA certificate from the PEM file.
+func readCertificate(path string) (*x509.Certificate, error) {
+	raw, err := os.ReadFile(path)
 	if errors.Is(err, os.ErrNotExist) {
 		return nil, nil
 	}
@@ -0,0 +1,397 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/slices"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	certificateslisters "k8s.io/client-go/listers/certificates/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s/internal/csr"
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
+)
+
+const kubeletServingCSRApproverComponent = "talos-kubelet-serving-csr-approver"
+
+// KubeletServingCSRApproverController approves kubelet serving certificate signing requests.
+//
+// The controller runs on control plane nodes when kubelet serving certificate rotation is enabled,
+// and approves CSRs only if the requested SANs match the cluster members.
+// CSRs which don't match are left pending, so that they can be handled by another approver.
+type KubeletServingCSRApproverController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *KubeletServingCSRApproverController) Name() string {
+	return "k8s.KubeletServingCSRApproverController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *KubeletServingCSRApproverController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.KubernetesType,
+			ID:        pointer.To(secrets.KubernetesID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.KubeletConfigType,
+			ID:        pointer.To(k8s.KubeletID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodenameType,
+			ID:        pointer.To(k8s.NodenameID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NodeAddressType,
+			ID:        pointer.To(network.NodeAddressCurrentID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.MemberType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *KubeletServingCSRApproverController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *KubeletServingCSRApproverController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		approver   *csrApprover
+		kubeconfig string
+	)
+
+	defer func() {
+		if approver != nil {
+			approver.Close()
+		}
+	}()
+
+	for {
+		var notifyCh <-chan struct{}
+
+		if approver != nil {
+			notifyCh = approver.notifyCh
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-notifyCh:
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		// secrets.Kubernetes is only available on control plane nodes
+		secretsResource, err := r.Get(ctx, resource.NewMetadata(secrets.NamespaceName, secrets.KubernetesType, secrets.KubernetesID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting kubernetes secrets: %w", err)
+		}
+
+		kubeletConfig, err := r.Get(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.KubeletConfigType, k8s.KubeletID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting kubelet config: %w", err)
+		}
+
+		if cfg == nil || !cfg.(*config.MachineConfig).Config().Cluster().KubeletServingCertificateApprover() ||
+			secretsResource == nil || kubeletConfig == nil || !serverCertificateRotationEnabled(kubeletConfig.(*k8s.KubeletConfig).TypedSpec()) {
+			if approver != nil {
+				approver.Close()
+				approver = nil
+			}
+
+			continue
+		}
+
+		// re-create the approver if the kubeconfig changes (e.g. Kubernetes CA rotation)
+		if approver != nil && kubeconfig != secretsResource.(*secrets.Kubernetes).TypedSpec().LocalhostAdminKubeconfig {
+			approver.Close()
+			approver = nil
+		}
+
+		if approver == nil {
+			kubeconfig = secretsResource.(*secrets.Kubernetes).TypedSpec().LocalhostAdminKubeconfig
+
+			approver, err = newCSRApprover(ctx, kubeconfig, logger)
+			if err != nil {
+				return err
+			}
+		}
+
+		nodes, err := ctrl.buildNodes(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		if err = approver.reconcile(ctx, nodes); err != nil {
+			return err
+		}
+	}
+}
+
+// buildNodes returns the list of nodes from the cluster members and the local node.
+func (ctrl *KubeletServingCSRApproverController) buildNodes(ctx context.Context, r controller.Runtime) ([]csr.Node, error) {
+	members, err := r.List(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.MemberType, "", resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("error listing cluster members: %w", err)
+	}
+
+	nodes := make([]csr.Node, 0, len(members.Items)+1)
+
+	for _, res := range members.Items {
+		member := res.(*cluster.Member).TypedSpec()
+
+		// members are keyed by the Kubernetes node name
+		nodeName := res.Metadata().ID()
+
+		dnsNames := []string{nodeName}
+
+		if member.Hostname != "" && member.Hostname != nodeName {
+			dnsNames = append(dnsNames, member.Hostname)
+		}
+
+		nodes = append(nodes, csr.Node{
+			Name:      nodeName,
+			DNSNames:  dnsNames,
+			Addresses: append([]netip.Addr(nil), member.Addresses...),
+		})
+	}
+
+	nodename, err := r.Get(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodenameType, k8s.NodenameID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nodes, nil
+		}
+
+		return nil, fmt.Errorf("error getting nodename: %w", err)
+	}
+
+	addresses, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressType, network.NodeAddressCurrentID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nodes, nil
+		}
+
+		return nil, fmt.Errorf("error getting node addresses: %w", err)
+	}
+
+	localName := nodename.(*k8s.Nodename).TypedSpec().Nodename
+
+	// the local node is a member as well if the discovery is enabled
+	if slices.Contains(nodes, func(node csr.Node) bool { return node.Name == localName }) {
+		return nodes, nil
+	}
+
+	return append(nodes, csr.Node{
+		Name:      localName,
+		DNSNames:  []string{localName},
+		Addresses: addresses.(*network.NodeAddress).TypedSpec().IPs(),
+	}), nil
+}
+
+// serverCertificateRotationEnabled checks whether kubelet requests serving certificates via CSRs.
+func serverCertificateRotationEnabled(spec *k8s.KubeletConfigSpec) bool {
+	if spec.ExtraArgs["rotate-server-certificates"] == "true" {
+		return true
+	}
+
+	enabled, ok := spec.ExtraConfig["serverTLSBootstrap"].(bool)
+
+	return ok && enabled
+}
+
+// csrApprover watches and approves kubelet serving CSRs.
+type csrApprover struct {
+	client      *kubernetes.Client
+	broadcaster record.EventBroadcaster
+	recorder    record.EventRecorder
+	lister      certificateslisters.CertificateSigningRequestLister
+	notifyCh    chan struct{}
+	cancel      context.CancelFunc
+	shutdown    func()
+	logger      *zap.Logger
+}
+
+func newCSRApprover(ctx context.Context, kubeconfig string, logger *zap.Logger) (*csrApprover, error) {
+	client, err := newClientFromKubeconfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	approver := &csrApprover{
+		client:      client,
+		broadcaster: record.NewBroadcaster(),
+		notifyCh:    make(chan struct{}, 1),
+		logger:      logger,
+	}
+
+	approver.broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: client.CoreV1().Events("")})
+	approver.recorder = approver.broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: kubeletServingCSRApproverComponent})
+
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(client.Clientset, 30*time.Second,
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("spec.signerName", certificatesv1.KubeletServingSignerName).String()
+		}),
+	)
+
+	notify := func(_ interface{}) {
+		select {
+		case approver.notifyCh <- struct{}{}:
+		default:
+		}
+	}
+
+	csrs := informerFactory.Certificates().V1().CertificateSigningRequests()
+
+	if err = csrs.Informer().SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		logger.Error("kubelet serving CSR watch error", zap.Error(err))
+	}); err != nil {
+		approver.Close()
+
+		return nil, fmt.Errorf("failed to set watch error handler: %w", err)
+	}
+
+	if _, err = csrs.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    notify,
+		UpdateFunc: func(_, _ interface{}) { notify(nil) },
+	}); err != nil {
+		approver.Close()
+
+		return nil, fmt.Errorf("failed to add event handler: %w", err)
+	}
+
+	approver.lister = csrs.Lister()
+
+	var watchCtx context.Context
+
+	watchCtx, approver.cancel = context.WithCancel(ctx)
+	approver.shutdown = informerFactory.Shutdown
+
+	informerFactory.Start(watchCtx.Done())
+
+	return approver, nil
+}
+
+// Close stops the watch and releases the resources.
+func (approver *csrApprover) Close() {
+	if approver.cancel != nil {
+		approver.cancel()
+	}
+
+	if approver.shutdown != nil {
+		approver.shutdown()
+	}
+
+	approver.broadcaster.Shutdown()
+	approver.client.Close() //nolint:errcheck
+}
+
+func (approver *csrApprover) reconcile(ctx context.Context, nodes []csr.Node) error {
+	requests, err := approver.lister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("error listing CSRs: %w", err)
+	}
+
+	for _, request := range requests {
+		if isFinished(request) {
+			continue
+		}
+
+		approve, reason := csr.Validate(request, nodes)
+
+		logger := approver.logger.With(zap.String("csr", request.Name), zap.String("username", request.Spec.Username), zap.String("reason", reason))
+
+		// CSRs are never denied, as the denial is final, and the CSR might be approved by another approver
+		if !approve {
+			logger.Debug("kubelet serving CSR left pending")
+
+			continue
+		}
+
+		condition := certificatesv1.CertificateSigningRequestCondition{
+			Type:    certificatesv1.CertificateApproved,
+			Status:  corev1.ConditionTrue,
+			Reason:  "TalosApprove",
+			Message: "approved by Talos: " + reason,
+		}
+
+		request = request.DeepCopy()
+		request.Status.Conditions = append(request.Status.Conditions, condition)
+
+		// the update is conditional on the resource version, so a decision made by another approver is never overridden
+		if _, err = approver.client.CertificatesV1().CertificateSigningRequests().UpdateApproval(ctx, request.Name, request, metav1.UpdateOptions{}); err != nil {
+			// other control plane nodes (or other approvers) might have already processed the CSR
+			if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return fmt.Errorf("error updating CSR %q approval: %w", request.Name, err)
+		}
+
+		logger.Info("approved kubelet serving CSR")
+		approver.recorder.Event(request, corev1.EventTypeNormal, condition.Reason, condition.Message)
+	}
+
+	return nil
+}
+
+func isFinished(request *certificatesv1.CertificateSigningRequest) bool {
+	for _, condition := range request.Status.Conditions {
+		switch condition.Type { //nolint:exhaustive
+		case certificatesv1.CertificateApproved, certificatesv1.CertificateDenied, certificatesv1.CertificateFailed:
+			return true
+		}
+	}
+
+	return false
+}
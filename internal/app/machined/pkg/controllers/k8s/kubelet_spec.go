@@ -18,11 +18,13 @@ import (
 	"github.com/siderolabs/gen/slices"
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubeletconfig "k8s.io/kubelet/config/v1beta1"
 
 	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/containers/image/credentialprovider"
 	"github.com/talos-systems/talos/pkg/argsbuilder"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/kubelet"
@@ -153,14 +155,28 @@ func (ctrl *KubeletSpecController) Run(ctx context.Context, r controller.Runtime
 			args["node-ip"] = strings.Join(nodeIPsString, ",")
 		}
 
+		var credentialProviderConfig map[string]interface{}
+
+		if len(cfgSpec.CredentialProviderConfig) > 0 {
+			credentialProviderConfig, err = newCredentialProviderConfig(cfgSpec.CredentialProviderConfig)
+			if err != nil {
+				return err
+			}
+
+			args["image-credential-provider-config"] = constants.KubeletCredentialProviderConfig
+			args["image-credential-provider-bin-dir"] = constants.KubeletCredentialProviderBinDir
+		}
+
 		if err = args.Merge(extraArgs, argsbuilder.WithMergePolicies(
 			argsbuilder.MergePolicies{
-				"bootstrap-kubeconfig":       argsbuilder.MergeDenied,
-				"kubeconfig":                 argsbuilder.MergeDenied,
-				"container-runtime":          argsbuilder.MergeDenied,
-				"container-runtime-endpoint": argsbuilder.MergeDenied,
-				"config":                     argsbuilder.MergeDenied,
-				"cert-dir":                   argsbuilder.MergeDenied,
+				"bootstrap-kubeconfig":              argsbuilder.MergeDenied,
+				"kubeconfig":                        argsbuilder.MergeDenied,
+				"container-runtime":                 argsbuilder.MergeDenied,
+				"container-runtime-endpoint":        argsbuilder.MergeDenied,
+				"config":                            argsbuilder.MergeDenied,
+				"cert-dir":                          argsbuilder.MergeDenied,
+				"image-credential-provider-config":  argsbuilder.MergeDenied,
+				"image-credential-provider-bin-dir": argsbuilder.MergeDenied,
 			},
 		)); err != nil {
 			return fmt.Errorf("error merging arguments: %w", err)
@@ -194,6 +210,7 @@ func (ctrl *KubeletSpecController) Run(ctx context.Context, r controller.Runtime
 				kubeletSpec.Args = args.Args()
 				kubeletSpec.Config = unstructuredConfig
 				kubeletSpec.ExpectedNodename = expectedNodename
+				kubeletSpec.CredentialProviderConfig = credentialProviderConfig
 
 				return nil
 			},
@@ -203,6 +220,20 @@ func (ctrl *KubeletSpecController) Run(ctx context.Context, r controller.Runtime
 	}
 }
 
+func newCredentialProviderConfig(cfg map[string]interface{}) (map[string]interface{}, error) {
+	providerConfig, err := credentialprovider.ParseConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing credential provider configuration: %w", err)
+	}
+
+	unstructuredConfig, err := runtime.DefaultUnstructuredConverter.ToUnstructured(providerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error converting credential provider configuration to unstructured: %w", err)
+	}
+
+	return unstructuredConfig, nil
+}
+
 func prepareExtraConfig(extraConfig map[string]interface{}) (*kubeletconfig.KubeletConfiguration, error) {
 	// check for fields that can't be overridden via extraConfig
 	var multiErr *multierror.Error
@@ -306,6 +337,16 @@ func NewKubeletConfiguration(cfgSpec *k8s.KubeletConfigSpec) (*kubeletconfig.Kub
 		config.ClusterDNS = cfgSpec.ClusterDNS
 	}
 
+	// taints from the machine config are set when the node is registered,
+	// as NodeRestriction doesn't allow the kubelet to modify taints of the existing node
+	config.RegisterWithTaints = append(config.RegisterWithTaints, slices.Map(cfgSpec.RegisterWithTaints, func(taint k8s.Taint) v1.Taint {
+		return v1.Taint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: v1.TaintEffect(taint.Effect),
+		}
+	})...)
+
 	if config.SerializeImagePulls == nil {
 		config.SerializeImagePulls = pointer.To(false)
 	}
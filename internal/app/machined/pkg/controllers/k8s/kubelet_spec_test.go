@@ -332,6 +332,89 @@ func (suite *KubeletSpecSuite) TestReconcileWithSkipNodeRegistration() {
 	)
 }
 
+func (suite *KubeletSpecSuite) TestReconcileWithCredentialProvider() {
+	cfg := k8s.NewKubeletConfig(k8s.NamespaceName, k8s.KubeletID)
+	cfg.TypedSpec().Image = "kubelet:v2.0.0"
+	cfg.TypedSpec().ClusterDNS = []string{"10.96.0.11"}
+	cfg.TypedSpec().ClusterDomain = "some.local"
+	cfg.TypedSpec().SkipNodeRegistration = true
+	cfg.TypedSpec().CredentialProviderConfig = map[string]interface{}{
+		"providers": []interface{}{
+			map[string]interface{}{
+				"name":                 "ecr-credential-provider",
+				"apiVersion":           "credentialprovider.kubelet.k8s.io/v1beta1",
+				"matchImages":          []interface{}{"*.dkr.ecr.*.amazonaws.com"},
+				"defaultCacheDuration": "12h",
+			},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	nodename := k8s.NewNodename(k8s.NamespaceName, k8s.NodenameID)
+	nodename.TypedSpec().Nodename = "foo.com"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodename))
+
+	nodeIP := k8s.NewNodeIP(k8s.NamespaceName, k8s.KubeletID)
+	nodeIP.TypedSpec().Addresses = []netip.Addr{netip.MustParseAddr("172.20.0.3")}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodeIP))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				kubeletSpec, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						k8s.NamespaceName,
+						k8s.KubeletSpecType,
+						k8s.KubeletID,
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
+				spec := kubeletSpec.(*k8s.KubeletSpec).TypedSpec()
+
+				suite.Assert().Equal([]string{
+					"--cert-dir=/var/lib/kubelet/pki",
+					"--config=/etc/kubernetes/kubelet.yaml",
+					"--container-runtime=remote",
+					"--container-runtime-endpoint=unix:///run/containerd/containerd.sock",
+					"--hostname-override=foo.com",
+					"--image-credential-provider-bin-dir=/usr/local/lib/kubelet/credentialproviders",
+					"--image-credential-provider-config=/etc/kubernetes/kubelet-credentialproviderconfig.yaml",
+					"--node-ip=172.20.0.3",
+				}, spec.Args)
+
+				var credentialProviderConfig kubeletconfig.CredentialProviderConfig
+
+				if err := k8sruntime.DefaultUnstructuredConverter.FromUnstructured(
+					spec.CredentialProviderConfig,
+					&credentialProviderConfig,
+				); err != nil {
+					return err
+				}
+
+				suite.Assert().Equal("kubelet.config.k8s.io/v1beta1", credentialProviderConfig.APIVersion)
+				suite.Assert().Equal("CredentialProviderConfig", credentialProviderConfig.Kind)
+				suite.Require().Len(credentialProviderConfig.Providers, 1)
+				suite.Assert().Equal("ecr-credential-provider", credentialProviderConfig.Providers[0].Name)
+				suite.Assert().Equal(12*time.Hour, credentialProviderConfig.Providers[0].DefaultCacheDuration.Duration)
+
+				return nil
+			},
+		),
+	)
+}
+
 func (suite *KubeletSpecSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
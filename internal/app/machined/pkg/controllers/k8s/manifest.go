@@ -110,7 +110,7 @@ func (ctrl *ManifestController) Run(ctx context.Context, r controller.Runtime, l
 
 			if err = r.Modify(ctx, k8s.NewManifest(k8s.ControlPlaneNamespaceName, renderedManifest.name),
 				func(r resource.Resource) error {
-					return k8sadapter.Manifest(r.(*k8s.Manifest)).SetYAML(renderedManifest.data)
+					return setManifestYAML(r.(*k8s.Manifest), renderedManifest.data, config.Prune)
 				}); err != nil {
 				return fmt.Errorf("error updating manifests: %w", err)
 			}
@@ -144,6 +144,19 @@ func (ctrl *ManifestController) Run(ctx context.Context, r controller.Runtime, l
 	}
 }
 
+// setManifestYAML parses the manifest and labels the objects with the inventory label if the manifest is pruned.
+func setManifestYAML(manifest *k8s.Manifest, data []byte, prune bool) error {
+	if err := k8sadapter.Manifest(manifest).SetYAML(data); err != nil {
+		return err
+	}
+
+	if prune {
+		k8sadapter.Manifest(manifest).SetInventoryLabel()
+	}
+
+	return nil
+}
+
 type renderedManifest struct {
 	name string
 	data []byte
@@ -6,6 +6,7 @@ package k8s
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 
@@ -22,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	memory "k8s.io/client-go/discovery/cached"
 	"k8s.io/client-go/dynamic"
@@ -155,6 +157,8 @@ func (ctrl *ManifestApplyController) Run(ctx context.Context, r controller.Runti
 				return fmt.Errorf("error building dynamic client: %w", err)
 			}
 
+			// objects are never pruned here: each control plane node applies the manifests from its own
+			// machine config which might be outdated, so pruning is done by `talosctl upgrade-k8s`
 			if err = etcd.WithLock(ctx, constants.EtcdTalosManifestApplyMutex, logger, func() error {
 				return ctrl.apply(ctx, logger, mapper, dyn, manifests)
 			}); err != nil {
@@ -251,9 +255,13 @@ func (ctrl *ManifestApplyController) apply(ctx context.Context, logger *zap.Logg
 			dr = dyn.Resource(mapping.Resource)
 		}
 
-		_, err = dr.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		existing, err := dr.Get(ctx, obj.GetName(), metav1.GetOptions{})
 		if err == nil {
-			// already exists
+			// already exists, only keep the inventory label in sync
+			if err = syncInventoryLabel(ctx, dr, existing, obj); err != nil {
+				return fmt.Errorf("error updating inventory label of %s: %w", objName, err)
+			}
+
 			continue
 		}
 
@@ -287,6 +295,40 @@ func (ctrl *ManifestApplyController) apply(ctx context.Context, logger *zap.Logg
 	return multiErr.ErrorOrNil()
 }
 
+// syncInventoryLabel adds or removes the inventory label on the existing object to match the manifest.
+func syncInventoryLabel(ctx context.Context, dr dynamic.ResourceInterface, existing, obj *unstructured.Unstructured) error {
+	inInventory := func(o *unstructured.Unstructured) bool {
+		return o.GetLabels()[constants.KubernetesManifestInventoryLabel] == constants.KubernetesManifestInventory
+	}
+
+	if inInventory(existing) == inInventory(obj) {
+		return nil
+	}
+
+	var value interface{}
+
+	if inInventory(obj) {
+		value = constants.KubernetesManifestInventory
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{
+				constants.KubernetesManifestInventoryLabel: value,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = dr.Patch(ctx, existing.GetName(), types.MergePatchType, patch, metav1.PatchOptions{
+		FieldManager: "talos",
+	})
+
+	return err
+}
+
 func isNamespace(gvk schema.GroupVersionKind) bool {
 	return gvk.Kind == "Namespace" && gvk.Version == "v1"
 }
@@ -0,0 +1,284 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s/internal/nodemeta"
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
+)
+
+// NodeMetadataApplyController applies the Node labels, annotations and taints from the machine config.
+//
+// The controller uses kubelet credentials for labels and annotations, so it only updates the Node object of this machine.
+// The NodeRestriction admission plugin forbids the kubelet to modify taints of the registered node, so taints are updated
+// with the admin credentials on control plane nodes, while on worker nodes taints are only set on registration.
+type NodeMetadataApplyController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeMetadataApplyController) Name() string {
+	return "k8s.NodeMetadataApplyController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeMetadataApplyController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodeMetadataConfigType,
+			ID:        pointer.To(k8s.NodeMetadataConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodenameType,
+			ID:        pointer.To(k8s.NodenameID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.KubernetesType,
+			ID:        pointer.To(secrets.KubernetesID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeMetadataApplyController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *NodeMetadataApplyController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		client, adminClient *kubernetes.Client
+		adminKubeconfig     string
+		warnedTaints        string
+	)
+
+	defer func() {
+		if client != nil {
+			client.Close() //nolint:errcheck
+		}
+
+		if adminClient != nil {
+			adminClient.Close() //nolint:errcheck
+		}
+	}()
+
+	// the labels might be changed outside of Talos, so re-apply them periodically
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+
+	var retryCh <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		case <-retryCh:
+		}
+
+		retryCh = nil
+
+		cfg, err := safe.ReaderGet[*k8s.NodeMetadataConfig](ctx, r, resource.NewMetadata(k8s.NamespaceName, k8s.NodeMetadataConfigType, k8s.NodeMetadataConfigID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting node metadata config: %w", err)
+		}
+
+		nodename, err := safe.ReaderGet[*k8s.Nodename](ctx, r, resource.NewMetadata(k8s.NamespaceName, k8s.NodenameType, k8s.NodenameID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting nodename: %w", err)
+		}
+
+		// secrets.Kubernetes is only available on control plane nodes
+		kubeSecrets, err := safe.ReaderGet[*secrets.Kubernetes](ctx, r, resource.NewMetadata(secrets.NamespaceName, secrets.KubernetesType, secrets.KubernetesID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting kubernetes secrets: %w", err)
+		}
+
+		// re-create the admin client if the kubeconfig changes (e.g. Kubernetes CA rotation)
+		if adminClient != nil && (kubeSecrets == nil || kubeSecrets.TypedSpec().LocalhostAdminKubeconfig != adminKubeconfig) {
+			adminClient.Close() //nolint:errcheck
+			adminClient = nil
+		}
+
+		if adminClient == nil && kubeSecrets != nil {
+			adminKubeconfig = kubeSecrets.TypedSpec().LocalhostAdminKubeconfig
+
+			adminClient, err = newClientFromKubeconfig(adminKubeconfig)
+			if err != nil {
+				return err
+			}
+		}
+
+		if client == nil {
+			logger.Debug("waiting for kubelet client config", zap.String("file", constants.KubeletKubeconfig))
+
+			if err = conditions.WaitForKubeconfigReady(constants.KubeletKubeconfig).Wait(ctx); err != nil {
+				return err
+			}
+
+			client, err = kubernetes.NewClientFromKubeletKubeconfig()
+			if err != nil {
+				return fmt.Errorf("error building Kubernetes client: %w", err)
+			}
+		}
+
+		taintsInSync, err := ctrl.apply(ctx, client, adminClient, nodename.TypedSpec().Nodename, cfg.TypedSpec())
+		if err != nil {
+			// the node might be not registered yet, or the API server is not available
+			logger.Warn("failed to apply node metadata, will retry", zap.Error(err))
+
+			retryCh = time.After(30 * time.Second)
+
+			continue
+		}
+
+		// warn once for each set of the desired taints which can't be applied
+		if desired := fmt.Sprint(cfg.TypedSpec().Taints); !taintsInSync && desired != warnedTaints {
+			logger.Warn("node taints can't be updated after the node is registered, as the NodeRestriction admission plugin forbids the kubelet to modify them",
+				zap.String("node", nodename.TypedSpec().Nodename))
+
+			warnedTaints = desired
+		} else if taintsInSync {
+			warnedTaints = ""
+		}
+	}
+}
+
+// apply updates the node metadata.
+//
+// If the admin client is not available, taints are updated with the kubelet client, and the returned flag reports whether
+// the update was allowed.
+func (ctrl *NodeMetadataApplyController) apply(ctx context.Context, client, adminClient *kubernetes.Client, nodename string, spec *k8s.NodeMetadataConfigSpec) (bool, error) {
+	node, err := client.CoreV1().Nodes().Get(ctx, nodename, metav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("error getting node %q: %w", nodename, err)
+	}
+
+	if err = patchNode(ctx, client, node, func(node *corev1.Node) {
+		nodemeta.ApplyLabelsAndAnnotations(node, spec.Labels, spec.Annotations)
+	}); err != nil {
+		return false, err
+	}
+
+	taints := make([]corev1.Taint, 0, len(spec.Taints))
+
+	for _, taint := range spec.Taints {
+		taints = append(taints, corev1.Taint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: corev1.TaintEffect(taint.Effect),
+		})
+	}
+
+	// the kubelet sets the taints on registration, and the NodeRestriction admission plugin forbids it to modify them afterwards,
+	// but if the taints are already in sync, the patch only updates the ownership annotation, which is allowed
+	taintsClient := adminClient
+	if taintsClient == nil {
+		taintsClient = client
+	}
+
+	if err = patchNode(ctx, taintsClient, node, func(node *corev1.Node) {
+		nodemeta.ApplyTaints(node, taints)
+	}); err != nil {
+		if adminClient == nil && apierrors.IsForbidden(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// patchNode applies the changes made by the mutator to the node using strategic merge patch.
+//
+// The node object is updated in place, so that the next patch is built on top of the previous one.
+func patchNode(ctx context.Context, client *kubernetes.Client, node *corev1.Node, mutator func(*corev1.Node)) error {
+	oldData, err := json.Marshal(node)
+	if err != nil {
+		return fmt.Errorf("failed to marshal unmodified node %q into JSON: %w", node.Name, err)
+	}
+
+	modified := node.DeepCopy()
+	mutator(modified)
+
+	newData, err := json.Marshal(modified)
+	if err != nil {
+		return fmt.Errorf("failed to marshal modified node %q into JSON: %w", node.Name, err)
+	}
+
+	patchBytes, err := strategicpatch.CreateTwoWayMergePatch(oldData, newData, corev1.Node{})
+	if err != nil {
+		return fmt.Errorf("failed to create two way merge patch: %w", err)
+	}
+
+	if string(patchBytes) == "{}" {
+		return nil
+	}
+
+	patched, err := client.CoreV1().Nodes().Patch(ctx, node.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("error patching node %q: %w", node.Name, err)
+	}
+
+	*node = *patched
+
+	return nil
+}
+
+// newClientFromKubeconfig builds a Kubernetes client from the kubeconfig contents.
+func newClientFromKubeconfig(kubeconfig string) (*kubernetes.Client, error) {
+	restConfig, err := clientcmd.BuildConfigFromKubeconfigGetter("", func() (*clientcmdapi.Config, error) {
+		return clientcmd.Load([]byte(kubeconfig))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	return client, nil
+}
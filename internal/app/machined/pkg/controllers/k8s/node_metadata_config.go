@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/maps"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/labels"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+// NodeMetadataConfigController builds the Node labels, annotations and taints from the machine config.
+type NodeMetadataConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeMetadataConfigController) Name() string {
+	return "k8s.NodeMetadataConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeMetadataConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeMetadataConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: k8s.NodeMetadataConfigType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NodeMetadataConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		machineConfig := cfg.Config().Machine()
+
+		if err = safe.WriterModify(ctx, r, k8s.NewNodeMetadataConfig(k8s.NamespaceName, k8s.NodeMetadataConfigID), func(res *k8s.NodeMetadataConfig) error {
+			spec := res.TypedSpec()
+
+			spec.Labels = machineConfig.NodeLabels()
+			spec.Annotations = machineConfig.NodeAnnotations()
+			spec.Taints = nodeTaints(machineConfig.NodeTaints())
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying NodeMetadataConfig resource: %w", err)
+		}
+	}
+}
+
+// nodeTaints converts the taints in the `key: value:effect` format to the list of taints sorted by key.
+//
+// Invalid taints are skipped, as they are rejected by the machine config validation.
+func nodeTaints(taints map[string]string) []k8s.Taint {
+	keys := maps.Keys(taints)
+	sort.Strings(keys)
+
+	var result []k8s.Taint
+
+	for _, key := range keys {
+		value, effect, err := labels.ParseTaint(taints[key])
+		if err != nil {
+			continue
+		}
+
+		result = append(result, k8s.Taint{
+			Key:    key,
+			Value:  value,
+			Effect: effect,
+		})
+	}
+
+	return result
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 
+	"github.com/talos-systems/talos/pkg/kubeconfig"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
@@ -129,6 +130,20 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 			return fmt.Errorf("error parsing service account key: %w", err)
 		}
 
+		// service account tokens signed by the accepted keys are still valid
+		serviceAccountPublicKeys := append([]byte(nil), serviceAccountKey.GetPublicKeyPEM()...)
+
+		for i, acceptedKey := range rootK8sSecrets.AcceptedServiceAccountKeys {
+			key, err := acceptedKey.GetKey()
+			if err != nil {
+				return fmt.Errorf("error parsing accepted service account key %d: %w", i, err)
+			}
+
+			serviceAccountPublicKeys = append(serviceAccountPublicKeys, key.GetPublicKeyPEM()...)
+		}
+
+		caBundle := kubeconfig.CABundle(rootK8sSecrets.CA, rootK8sSecrets.AcceptedCAs)
+
 		type secret struct {
 			getter       func() *x509.PEMEncodedCertificateAndKey
 			certFilename string
@@ -164,7 +179,7 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 						keyFilename:  "etcd-client.key",
 					},
 					{
-						getter:       func() *x509.PEMEncodedCertificateAndKey { return rootK8sSecrets.CA },
+						getter:       func() *x509.PEMEncodedCertificateAndKey { return &x509.PEMEncodedCertificateAndKey{Crt: caBundle} },
 						certFilename: "ca.crt",
 					},
 					{
@@ -180,7 +195,7 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 					{
 						getter: func() *x509.PEMEncodedCertificateAndKey {
 							return &x509.PEMEncodedCertificateAndKey{
-								Crt: serviceAccountKey.GetPublicKeyPEM(),
+								Crt: serviceAccountPublicKeys,
 								Key: serviceAccountKey.GetPrivateKeyPEM(),
 							}
 						},
@@ -196,6 +211,11 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 						certFilename: "front-proxy-client.crt",
 						keyFilename:  "front-proxy-client.key",
 					},
+					{
+						// optional, only present if OIDC is configured with a custom CA
+						getter:       func() *x509.PEMEncodedCertificateAndKey { return rootK8sSecrets.OIDCCA },
+						certFilename: "oidc-ca.crt",
+					},
 				},
 				templates: []template{
 					{
@@ -215,6 +235,10 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 						certFilename: "ca.crt",
 						keyFilename:  "ca.key",
 					},
+					{
+						getter:       func() *x509.PEMEncodedCertificateAndKey { return &x509.PEMEncodedCertificateAndKey{Crt: caBundle} },
+						certFilename: "root-ca.crt",
+					},
 					{
 						getter: func() *x509.PEMEncodedCertificateAndKey {
 							return &x509.PEMEncodedCertificateAndKey{
@@ -251,6 +275,9 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 
 			for _, secret := range pod.secrets {
 				certAndKey := secret.getter()
+				if certAndKey == nil {
+					continue
+				}
 
 				if secret.certFilename != "" {
 					if err = os.WriteFile(filepath.Join(pod.directory, secret.certFilename), certAndKey.Crt, 0o400); err != nil {
@@ -6,12 +6,22 @@ package k8s
 
 // kube-apiserver configuration:
 
-var kubeSystemEncryptionConfigTemplate = []byte(`apiVersion: v1
+// KMS providers are listed first (so that the first one is used for encryption),
+// except for decrypt-only providers which go after the local keys.
+var kubeSystemEncryptionConfigTemplate = []byte(`{{- define "kms" }}
+  - kms:
+      apiVersion: v2
+      name: {{ .Name }}
+      endpoint: unix://{{ .Endpoint }}
+      timeout: {{ .Timeout }}
+{{- end -}}
+apiVersion: v1
 kind: EncryptionConfig
 resources:
 - resources:
   - secrets
   providers:
+  {{- range .Root.KMSProviders }}{{ if not .DecryptOnly }}{{ template "kms" . }}{{ end }}{{ end }}
   {{if .Root.SecretboxEncryptionSecret}}
   - secretbox:
       keys:
@@ -24,6 +34,7 @@ resources:
       - name: key1
         secret: {{ .Root.AESCBCEncryptionSecret }}
   {{end}}
+  {{- range .Root.KMSProviders }}{{ if .DecryptOnly }}{{ template "kms" . }}{{ end }}{{ end }}
   - identity: {}
 `)
 
@@ -20,6 +20,7 @@ import (
 	"go4.org/netipx"
 	"golang.org/x/sys/unix"
 
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/internal/ndp"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/watch"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
@@ -232,8 +233,13 @@ func (ctrl *AddressSpecController) syncAddress(ctx context.Context, r controller
 		logger.Info("assigned address", zap.Stringer("address", address.TypedSpec().Address), zap.String("link", address.TypedSpec().LinkName))
 
 		if address.TypedSpec().AnnounceWithARP {
-			if err := ctrl.gratuitousARP(logger, linkIndex, address.TypedSpec().Address.Addr()); err != nil {
-				logger.Warn("failure sending gratuitous ARP", zap.Stringer("address", address.TypedSpec().Address), zap.String("link", address.TypedSpec().LinkName), zap.Error(err))
+			// IPv4 addresses are announced with gratuitous ARP, IPv6 addresses with unsolicited neighbor advertisement
+			if address.TypedSpec().Address.Addr().Is4() {
+				if err := ctrl.gratuitousARP(logger, linkIndex, address.TypedSpec().Address.Addr()); err != nil {
+					logger.Warn("failure sending gratuitous ARP", zap.Stringer("address", address.TypedSpec().Address), zap.String("link", address.TypedSpec().LinkName), zap.Error(err))
+				}
+			} else if err := ctrl.unsolicitedNA(logger, linkIndex, address.TypedSpec().Address.Addr()); err != nil {
+				logger.Warn("failure sending unsolicited neighbor advertisement", zap.Stringer("address", address.TypedSpec().Address), zap.String("link", address.TypedSpec().LinkName), zap.Error(err))
 			}
 		}
 	}
@@ -279,6 +285,30 @@ func (ctrl *AddressSpecController) gratuitousARP(logger *zap.Logger, linkIndex u
 	return nil
 }
 
+func (ctrl *AddressSpecController) unsolicitedNA(logger *zap.Logger, linkIndex uint32, ip netip.Addr) error {
+	if !ip.Is6() {
+		return nil
+	}
+
+	iface, err := net.InterfaceByIndex(int(linkIndex))
+	if err != nil {
+		return err
+	}
+
+	if len(iface.HardwareAddr) != 6 {
+		// not ethernet
+		return nil
+	}
+
+	if err = ndp.SendUnsolicitedNA(iface, ip); err != nil {
+		return err
+	}
+
+	logger.Info("sent unsolicited neighbor advertisement", zap.Stringer("address", ip), zap.String("link", iface.Name))
+
+	return nil
+}
+
 func broadcastAddr(addr netip.Prefix) net.IP {
 	if !addr.Addr().Is4() {
 		return nil
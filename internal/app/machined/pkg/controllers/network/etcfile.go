@@ -14,6 +14,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/slices"
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 
@@ -169,8 +170,20 @@ func (ctrl *EtcFileController) renderResolvConf(resolverStatus *network.Resolver
 		disableSearchDomain = cfgProvider.Machine().Network().DisableSearchDomain()
 	}
 
+	var searchDomains []string
+
 	if !disableSearchDomain && hostnameStatus != nil && hostnameStatus.Domainname != "" {
-		fmt.Fprintf(&buf, "\nsearch %s\n", hostnameStatus.Domainname)
+		searchDomains = append(searchDomains, hostnameStatus.Domainname)
+	}
+
+	for _, domain := range resolverStatus.SearchDomains {
+		if !slices.Contains(searchDomains, func(s string) bool { return s == domain }) {
+			searchDomains = append(searchDomains, domain)
+		}
+	}
+
+	if len(searchDomains) > 0 {
+		fmt.Fprintf(&buf, "\nsearch %s\n", strings.Join(searchDomains, " "))
 	}
 
 	return buf.Bytes()
@@ -258,6 +258,16 @@ func (suite *EtcFileConfigSuite) TestNoSearchDomain() {
 	)
 }
 
+func (suite *EtcFileConfigSuite) TestSearchDomains() {
+	suite.resolverStatus.TypedSpec().SearchDomains = []string{"example.com", "example.org"}
+
+	suite.testFiles(
+		[]resource.Resource{suite.defaultAddress, suite.hostnameStatus, suite.resolverStatus},
+		"nameserver 1.1.1.1\nnameserver 2.2.2.2\nnameserver 3.3.3.3\n\nsearch example.com example.org\n",
+		"127.0.0.1   localhost\n33.11.22.44 foo.example.com foo\n::1         localhost ip6-localhost ip6-loopback\nff02::1     ip6-allnodes\nff02::2     ip6-allrouters\n",
+	)
+}
+
 func (suite *EtcFileConfigSuite) TestNoDomainname() {
 	suite.hostnameStatus.TypedSpec().Domainname = ""
 
@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// TTL is the time-to-live advertised in the transmitted LLDP frames.
+const TTL = 120 * time.Second
+
+// Decode LLDP frame payload (without Ethernet header) into the neighbor information.
+//
+// Decode returns neighbor TTL as advertised by the neighbor, zero TTL means that
+// the neighbor is shutting down and should be removed.
+func Decode(payload []byte) (network.LLDPNeighborSpec, time.Duration, error) {
+	packet := gopacket.NewPacket(payload, layers.LayerTypeLinkLayerDiscovery, gopacket.Default)
+
+	if errLayer := packet.ErrorLayer(); errLayer != nil {
+		return network.LLDPNeighborSpec{}, 0, fmt.Errorf("error decoding LLDP frame: %w", errLayer.Error())
+	}
+
+	discovery, ok := packet.Layer(layers.LayerTypeLinkLayerDiscovery).(*layers.LinkLayerDiscovery)
+	if !ok {
+		return network.LLDPNeighborSpec{}, 0, errors.New("no LLDP layer in the frame")
+	}
+
+	spec := network.LLDPNeighborSpec{
+		ChassisID: chassisID(discovery.ChassisID),
+		PortID:    portID(discovery.PortID),
+	}
+
+	if info, ok := packet.Layer(layers.LayerTypeLinkLayerDiscoveryInfo).(*layers.LinkLayerDiscoveryInfo); ok {
+		spec.PortDescription = info.PortDescription
+		spec.SystemName = info.SysName
+		spec.SystemDescription = info.SysDescription
+
+		if info8021, err := info.Decode8021(); err == nil {
+			spec.VLAN = info8021.PVID
+		}
+
+		switch info.MgmtAddress.Subtype { //nolint:exhaustive
+		case layers.IANAAddressFamilyIPV4, layers.IANAAddressFamilyIPV6:
+			if addr, ok := netip.AddrFromSlice(info.MgmtAddress.Address); ok {
+				spec.ManagementAddress = addr.Unmap()
+			}
+		}
+	}
+
+	return spec, time.Duration(discovery.TTL) * time.Second, nil
+}
+
+// Encode LLDP frame payload advertising the local link.
+func Encode(hardwareAddr net.HardwareAddr, linkName, systemName string, ttl time.Duration) ([]byte, error) {
+	discovery := &layers.LinkLayerDiscovery{
+		ChassisID: layers.LLDPChassisID{
+			Subtype: layers.LLDPChassisIDSubTypeMACAddr,
+			ID:      hardwareAddr,
+		},
+		PortID: layers.LLDPPortID{
+			Subtype: layers.LLDPPortIDSubtypeIfaceName,
+			ID:      []byte(linkName),
+		},
+		TTL: uint16(ttl / time.Second),
+	}
+
+	if systemName != "" {
+		discovery.Values = append(discovery.Values, layers.LinkLayerDiscoveryValue{
+			Type:   layers.LLDPTLVSysName,
+			Length: uint16(len(systemName)),
+			Value:  []byte(systemName),
+		})
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+
+	if err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{}, discovery); err != nil {
+		return nil, fmt.Errorf("error encoding LLDP frame: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func chassisID(id layers.LLDPChassisID) string {
+	switch id.Subtype { //nolint:exhaustive
+	case layers.LLDPChassisIDSubTypeMACAddr:
+		return net.HardwareAddr(id.ID).String()
+	case layers.LLDPChassisIDSubTypeNetworkAddr:
+		return networkAddr(id.ID)
+	default:
+		return string(id.ID)
+	}
+}
+
+func portID(id layers.LLDPPortID) string {
+	switch id.Subtype { //nolint:exhaustive
+	case layers.LLDPPortIDSubtypeMACAddr:
+		return net.HardwareAddr(id.ID).String()
+	case layers.LLDPPortIDSubtypeNetworkAddr:
+		return networkAddr(id.ID)
+	default:
+		return string(id.ID)
+	}
+}
+
+// networkAddr decodes network address in LLDP format: IANA address family followed by the address.
+func networkAddr(b []byte) string {
+	if len(b) > 1 {
+		if addr, ok := netip.AddrFromSlice(b[1:]); ok {
+			return addr.String()
+		}
+	}
+
+	return fmt.Sprintf("%x", b)
+}
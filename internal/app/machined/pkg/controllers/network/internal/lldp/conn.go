@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/mdlayher/packet"
+	"golang.org/x/sys/unix"
+)
+
+// EtherType of the LLDP frames.
+const EtherType = 0x88cc
+
+// MulticastAddr is the nearest bridge multicast address LLDP frames are sent to.
+var MulticastAddr = net.HardwareAddr{0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e}
+
+// Conn is a connection sending and receiving LLDP frames on a link.
+type Conn interface {
+	// Read LLDP frame payload (without Ethernet header).
+	Read(b []byte) (int, error)
+	// Write LLDP frame payload to the LLDP multicast address.
+	Write(b []byte) error
+	Close() error
+}
+
+// ListenFunc opens a Conn on the link.
+type ListenFunc func(linkName string) (Conn, error)
+
+// Listen opens a packet socket for LLDP frames on the link.
+func Listen(linkName string) (Conn, error) {
+	iface, err := net.InterfaceByName(linkName)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up link %q: %w", linkName, err)
+	}
+
+	conn, err := packet.Listen(iface, packet.Datagram, EtherType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error opening packet socket: %w", err)
+	}
+
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	mreq := unix.PacketMreq{
+		Ifindex: int32(iface.Index),
+		Type:    unix.PACKET_MR_MULTICAST,
+		Alen:    uint16(len(MulticastAddr)),
+	}
+
+	copy(mreq.Address[:], MulticastAddr)
+
+	var sockErr error
+
+	if err = rawConn.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptPacketMreq(int(fd), unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, &mreq)
+	}); err == nil {
+		err = sockErr
+	}
+
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error joining LLDP multicast group: %w", err)
+	}
+
+	return &packetConn{conn: conn}, nil
+}
+
+type packetConn struct {
+	conn *packet.Conn
+}
+
+func (c *packetConn) Read(b []byte) (int, error) {
+	n, _, err := c.conn.ReadFrom(b)
+
+	return n, err
+}
+
+func (c *packetConn) Write(b []byte) error {
+	_, err := c.conn.WriteTo(b, &packet.Addr{HardwareAddr: MulticastAddr})
+
+	return err
+}
+
+func (c *packetConn) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,253 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lldp implements LLDP neighbor discovery.
+package lldp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+const (
+	// TransmitInterval is the interval between transmitted LLDP frames.
+	TransmitInterval = 30 * time.Second
+
+	// RetryInterval is the interval between attempts to re-open the connection.
+	RetryInterval = 10 * time.Second
+)
+
+// Spec describes LLDP settings of the link.
+type Spec struct {
+	HardwareAddr string
+	SystemName   string
+	Transmit     bool
+}
+
+// Runner describes a state of LLDP discovery on a link.
+type Runner struct {
+	LinkName string
+	Spec     Spec
+	Listen   ListenFunc
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	neighbor *network.LLDPNeighborSpec
+}
+
+// Notification of a neighbor change.
+type Notification struct {
+	LinkName string
+	// Neighbor is nil if the neighbor is gone.
+	Neighbor *network.LLDPNeighborSpec
+}
+
+// Start a runner with a given context.
+func (runner *Runner) Start(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) {
+	runner.wg.Add(1)
+
+	ctx, runner.cancel = context.WithCancel(ctx)
+
+	go func() {
+		defer runner.wg.Done()
+
+		runner.run(ctx, notifyCh, logger)
+	}()
+}
+
+// Stop a runner.
+func (runner *Runner) Stop() {
+	runner.cancel()
+
+	runner.wg.Wait()
+}
+
+func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) {
+	logger = logger.With(zap.String("link", runner.LinkName))
+
+	for {
+		err := runner.serve(ctx, notifyCh, logger)
+		if ctx.Err() != nil {
+			return
+		}
+
+		logger.Warn("LLDP discovery failed", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(RetryInterval):
+		}
+	}
+}
+
+//nolint:gocyclo
+func (runner *Runner) serve(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) error {
+	listen := runner.Listen
+	if listen == nil {
+		listen = Listen
+	}
+
+	conn, err := listen(runner.LinkName)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	frameCh := make(chan []byte)
+	errCh := make(chan error, 1)
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		errCh <- runner.read(ctx, conn, frameCh)
+	}()
+
+	defer func() {
+		cancel()
+
+		conn.Close() //nolint:errcheck
+
+		wg.Wait()
+	}()
+
+	var transmitCh <-chan time.Time
+
+	if runner.Spec.Transmit {
+		if err = runner.transmit(conn); err != nil {
+			logger.Warn("failed to transmit LLDP frame", zap.Error(err))
+		}
+
+		ticker := time.NewTicker(TransmitInterval)
+		defer ticker.Stop()
+
+		transmitCh = ticker.C
+	}
+
+	var (
+		expireTimer *time.Timer
+		expireCh    <-chan time.Time
+	)
+
+	defer func() {
+		if expireTimer != nil {
+			expireTimer.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err = <-errCh:
+			return err
+		case <-transmitCh:
+			if err = runner.transmit(conn); err != nil {
+				logger.Warn("failed to transmit LLDP frame", zap.Error(err))
+			}
+		case <-expireCh:
+			expireTimer, expireCh = nil, nil
+
+			if !runner.notify(ctx, notifyCh, nil) {
+				return nil
+			}
+		case payload := <-frameCh:
+			neighbor, ttl, err := Decode(payload)
+			if err != nil {
+				logger.Debug("ignoring invalid LLDP frame", zap.Error(err))
+
+				continue
+			}
+
+			if expireTimer != nil {
+				expireTimer.Stop()
+
+				expireTimer, expireCh = nil, nil
+			}
+
+			if ttl == 0 {
+				// neighbor is shutting down
+				if !runner.notify(ctx, notifyCh, nil) {
+					return nil
+				}
+
+				continue
+			}
+
+			expireTimer = time.NewTimer(ttl)
+			expireCh = expireTimer.C
+
+			if !runner.notify(ctx, notifyCh, &neighbor) {
+				return nil
+			}
+		}
+	}
+}
+
+func (runner *Runner) read(ctx context.Context, conn Conn, frameCh chan<- []byte) error {
+	buf := make([]byte, 1500)
+
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return fmt.Errorf("error reading LLDP frame: %w", err)
+		}
+
+		select {
+		case frameCh <- append([]byte(nil), buf[:n]...):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (runner *Runner) transmit(conn Conn) error {
+	hardwareAddr, err := net.ParseMAC(runner.Spec.HardwareAddr)
+	if err != nil {
+		return err
+	}
+
+	payload, err := Encode(hardwareAddr, runner.LinkName, runner.Spec.SystemName, TTL)
+	if err != nil {
+		return err
+	}
+
+	return conn.Write(payload)
+}
+
+// notify sends a notification if the neighbor has changed.
+func (runner *Runner) notify(ctx context.Context, notifyCh chan<- Notification, neighbor *network.LLDPNeighborSpec) bool {
+	switch {
+	case runner.neighbor == nil && neighbor == nil:
+		return true
+	case runner.neighbor != nil && neighbor != nil && *runner.neighbor == *neighbor:
+		return true
+	}
+
+	select {
+	case notifyCh <- Notification{
+		LinkName: runner.LinkName,
+		Neighbor: neighbor,
+	}:
+	case <-ctx.Done():
+		return false
+	}
+
+	runner.neighbor = neighbor
+
+	return true
+}
@@ -0,0 +1,216 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp_test
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+func tlv(typ layers.LLDPTLVType, value []byte) layers.LinkLayerDiscoveryValue {
+	return layers.LinkLayerDiscoveryValue{
+		Type:   typ,
+		Length: uint16(len(value)),
+		Value:  value,
+	}
+}
+
+// switchFrame builds a frame as sent by a typical ToR switch.
+func switchFrame(t *testing.T, ttl uint16) []byte {
+	t.Helper()
+
+	pvid := []byte{0x00, 0x80, 0xc2, layers.LLDP8021SubtypePortVLANID, 0, 0}
+	binary.BigEndian.PutUint16(pvid[4:], 100)
+
+	// address string length, address subtype, address, interface subtype, interface number, OID length
+	mgmtAddr := []byte{5, byte(layers.IANAAddressFamilyIPV4), 10, 5, 0, 1, byte(layers.LLDPInterfaceSubtypeifIndex), 0, 0, 0, 1, 0}
+
+	discovery := &layers.LinkLayerDiscovery{
+		ChassisID: layers.LLDPChassisID{
+			Subtype: layers.LLDPChassisIDSubTypeMACAddr,
+			ID:      []byte{0x00, 0x1c, 0x73, 0xaa, 0xbb, 0xcc},
+		},
+		PortID: layers.LLDPPortID{
+			Subtype: layers.LLDPPortIDSubtypeIfaceName,
+			ID:      []byte("Ethernet12"),
+		},
+		TTL: ttl,
+		Values: []layers.LinkLayerDiscoveryValue{
+			tlv(layers.LLDPTLVPortDescription, []byte("rack4-node2")),
+			tlv(layers.LLDPTLVSysName, []byte("tor-4a")),
+			tlv(layers.LLDPTLVSysDescription, []byte("Arista Networks EOS")),
+			tlv(layers.LLDPTLVMgmtAddress, mgmtAddr),
+			tlv(layers.LLDPTLVOrgSpecific, pvid),
+		},
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{}, discovery))
+
+	return buf.Bytes()
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	spec, ttl, err := lldp.Decode(switchFrame(t, 120))
+	require.NoError(t, err)
+
+	assert.Equal(t, 120*time.Second, ttl)
+	assert.Equal(t, network.LLDPNeighborSpec{
+		ChassisID:         "00:1c:73:aa:bb:cc",
+		PortID:            "Ethernet12",
+		PortDescription:   "rack4-node2",
+		SystemName:        "tor-4a",
+		SystemDescription: "Arista Networks EOS",
+		VLAN:              100,
+		ManagementAddress: netip.MustParseAddr("10.5.0.1"),
+	}, spec)
+
+	_, _, err = lldp.Decode([]byte{0x02, 0x07})
+	assert.Error(t, err)
+}
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	payload, err := lldp.Encode(net.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}, "eth0", "node1", lldp.TTL)
+	require.NoError(t, err)
+
+	spec, ttl, err := lldp.Decode(payload)
+	require.NoError(t, err)
+
+	assert.Equal(t, lldp.TTL, ttl)
+	assert.Equal(t, network.LLDPNeighborSpec{
+		ChassisID:  "52:54:00:12:34:56",
+		PortID:     "eth0",
+		SystemName: "node1",
+	}, spec)
+}
+
+type mockConn struct {
+	readCh  chan []byte
+	writeCh chan []byte
+	closed  chan struct{}
+}
+
+func newMockConn() *mockConn {
+	return &mockConn{
+		readCh:  make(chan []byte),
+		writeCh: make(chan []byte, 16),
+		closed:  make(chan struct{}),
+	}
+}
+
+func (c *mockConn) Read(b []byte) (int, error) {
+	select {
+	case frame := <-c.readCh:
+		return copy(b, frame), nil
+	case <-c.closed:
+		return 0, net.ErrClosed
+	}
+}
+
+func (c *mockConn) Write(b []byte) error {
+	c.writeCh <- append([]byte(nil), b...)
+
+	return nil
+}
+
+func (c *mockConn) Close() error {
+	close(c.closed)
+
+	return nil
+}
+
+func expectNotification(t *testing.T, notifyCh <-chan lldp.Notification) lldp.Notification {
+	t.Helper()
+
+	select {
+	case notification := <-notifyCh:
+		return notification
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no notification received")
+	}
+
+	panic("unreachable")
+}
+
+func TestRunner(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	conn := newMockConn()
+
+	runner := &lldp.Runner{
+		LinkName: "eth0",
+		Spec: lldp.Spec{
+			HardwareAddr: "52:54:00:12:34:56",
+			SystemName:   "node1",
+			Transmit:     true,
+		},
+		Listen: func(linkName string) (lldp.Conn, error) {
+			assert.Equal(t, "eth0", linkName)
+
+			return conn, nil
+		},
+	}
+
+	notifyCh := make(chan lldp.Notification)
+
+	runner.Start(ctx, notifyCh, zaptest.NewLogger(t))
+	t.Cleanup(runner.Stop)
+
+	// the frame is transmitted right away
+	select {
+	case payload := <-conn.writeCh:
+		spec, _, err := lldp.Decode(payload)
+		require.NoError(t, err)
+
+		assert.Equal(t, "eth0", spec.PortID)
+		assert.Equal(t, "node1", spec.SystemName)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no frame transmitted")
+	}
+
+	conn.readCh <- switchFrame(t, 1)
+
+	notification := expectNotification(t, notifyCh)
+	assert.Equal(t, "eth0", notification.LinkName)
+	require.NotNil(t, notification.Neighbor)
+	assert.Equal(t, "tor-4a", notification.Neighbor.SystemName)
+
+	// same neighbor doesn't trigger a notification, but the TTL expires
+	conn.readCh <- switchFrame(t, 1)
+
+	notification = expectNotification(t, notifyCh)
+	assert.Nil(t, notification.Neighbor)
+
+	conn.readCh <- switchFrame(t, 120)
+
+	notification = expectNotification(t, notifyCh)
+	require.NotNil(t, notification.Neighbor)
+
+	// zero TTL removes the neighbor
+	conn.readCh <- switchFrame(t, 0)
+
+	notification = expectNotification(t, notifyCh)
+	assert.Nil(t, notification.Neighbor)
+}
@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ndp implements sending unsolicited IPv6 neighbor advertisements (RFC 4861).
+package ndp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	// flagOverride is the 'O' flag of the neighbor advertisement.
+	flagOverride = 0x20
+
+	// optionTargetLinkLayerAddress is the 'Target Link-Layer Address' option type.
+	optionTargetLinkLayerAddress = 2
+
+	// hopLimit is the required hop limit for NDP messages.
+	hopLimit = 255
+)
+
+// allNodes is the link-local all-nodes multicast address.
+var allNodes = netip.MustParseAddr("ff02::1")
+
+// MarshalUnsolicitedNA builds an unsolicited neighbor advertisement for the target address.
+//
+// The advertisement has the override flag set, so that the neighbors update their caches
+// to point to the new link-layer address. The checksum is left to the kernel.
+func MarshalUnsolicitedNA(target netip.Addr, hwAddr net.HardwareAddr) ([]byte, error) {
+	if !target.Is6() || target.Is4In6() {
+		return nil, fmt.Errorf("target %s is not an IPv6 address", target)
+	}
+
+	if len(hwAddr) != 6 {
+		return nil, errors.New("link-layer address should be an Ethernet address")
+	}
+
+	// flags + reserved (4 bytes), target address (16 bytes), target link-layer address option (8 bytes)
+	body := make([]byte, 4+16+8)
+
+	body[0] = flagOverride
+
+	targetBytes := target.As16()
+	copy(body[4:20], targetBytes[:])
+
+	body[20] = optionTargetLinkLayerAddress
+	body[21] = 1 // length in units of 8 bytes
+	copy(body[22:], hwAddr)
+
+	msg := icmp.Message{
+		Type: ipv6.ICMPTypeNeighborAdvertisement,
+		Body: &icmp.RawBody{
+			Data: body,
+		},
+	}
+
+	return msg.Marshal(nil)
+}
+
+// SendUnsolicitedNA sends an unsolicited neighbor advertisement for the target address
+// to all nodes on the link.
+//
+// The target address should be already assigned to the interface.
+func SendUnsolicitedNA(iface *net.Interface, target netip.Addr) error {
+	payload, err := MarshalUnsolicitedNA(target, iface.HardwareAddr)
+	if err != nil {
+		return err
+	}
+
+	conn, err := icmp.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return fmt.Errorf("error opening ICMPv6 socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	pc := conn.IPv6PacketConn()
+
+	if err = pc.SetMulticastHopLimit(hopLimit); err != nil {
+		return fmt.Errorf("error setting hop limit: %w", err)
+	}
+
+	if err = pc.SetMulticastInterface(iface); err != nil {
+		return fmt.Errorf("error setting multicast interface: %w", err)
+	}
+
+	cm := &ipv6.ControlMessage{
+		HopLimit: hopLimit,
+		Src:      target.AsSlice(),
+		IfIndex:  iface.Index,
+	}
+
+	if _, err = pc.WriteTo(payload, cm, &net.IPAddr{IP: allNodes.AsSlice(), Zone: iface.Name}); err != nil {
+		return fmt.Errorf("error sending neighbor advertisement: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ndp_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv6"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/internal/ndp"
+)
+
+func TestMarshalUnsolicitedNA(t *testing.T) {
+	t.Parallel()
+
+	target := netip.MustParseAddr("fd00::100")
+	hwAddr := net.HardwareAddr{0x02, 0x42, 0xac, 0x11, 0x00, 0x02}
+
+	b, err := ndp.MarshalUnsolicitedNA(target, hwAddr)
+	require.NoError(t, err)
+
+	msg, err := icmp.ParseMessage(ipv6.ICMPTypeNeighborAdvertisement.Protocol(), b)
+	require.NoError(t, err)
+
+	assert.Equal(t, ipv6.ICMPTypeNeighborAdvertisement, msg.Type)
+	assert.Equal(t, 0, msg.Code)
+
+	body, ok := msg.Body.(*icmp.RawBody)
+	require.True(t, ok)
+
+	require.Len(t, body.Data, 28)
+
+	// override flag, not solicited, not router
+	assert.Equal(t, []byte{0x20, 0, 0, 0}, body.Data[:4])
+
+	parsedTarget, ok := netip.AddrFromSlice(body.Data[4:20])
+	require.True(t, ok)
+	assert.Equal(t, target, parsedTarget)
+
+	// target link-layer address option
+	assert.Equal(t, []byte{2, 1}, body.Data[20:22])
+	assert.Equal(t, hwAddr, net.HardwareAddr(body.Data[22:28]))
+}
+
+func TestMarshalUnsolicitedNAErrors(t *testing.T) {
+	t.Parallel()
+
+	hwAddr := net.HardwareAddr{0x02, 0x42, 0xac, 0x11, 0x00, 0x02}
+
+	_, err := ndp.MarshalUnsolicitedNA(netip.MustParseAddr("10.5.0.100"), hwAddr)
+	assert.Error(t, err)
+
+	_, err = ndp.MarshalUnsolicitedNA(netip.MustParseAddr("::ffff:10.5.0.100"), hwAddr)
+	assert.Error(t, err)
+
+	_, err = ndp.MarshalUnsolicitedNA(netip.MustParseAddr("fd00::100"), net.HardwareAddr{0x01})
+	assert.Error(t, err)
+}
@@ -0,0 +1,275 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package probe contains implementation of the network reachability probes.
+package probe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// Runner describes a state of running probe.
+type Runner struct {
+	ID   string
+	Spec network.ProbeSpecSpec
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Notification of a runner status.
+type Notification struct {
+	ID     string
+	Status network.ProbeStatusSpec
+}
+
+// Start a runner with a given context.
+func (runner *Runner) Start(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) {
+	runner.wg.Add(1)
+
+	ctx, runner.cancel = context.WithCancel(ctx)
+
+	go func() {
+		defer runner.wg.Done()
+
+		runner.run(ctx, notifyCh, logger)
+	}()
+}
+
+// Stop a runner.
+func (runner *Runner) Stop() {
+	runner.cancel()
+
+	runner.wg.Wait()
+}
+
+// run a probe.
+func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) {
+	logger = logger.With(zap.String("probe", runner.ID))
+
+	interval := runner.Spec.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var (
+		status              network.ProbeStatusSpec
+		consecutiveFailures int
+	)
+
+	for {
+		err := runner.probe(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		previous := status
+
+		if err == nil {
+			consecutiveFailures = 0
+
+			status = network.ProbeStatusSpec{
+				Success: true,
+			}
+		} else {
+			consecutiveFailures++
+
+			// once succeeded, probe is considered failed only after the failure threshold is reached
+			status = network.ProbeStatusSpec{
+				Success:   previous.Success && consecutiveFailures < runner.Spec.FailureThreshold,
+				LastError: err.Error(),
+			}
+		}
+
+		if status.Success != previous.Success {
+			if status.Success {
+				logger.Info("probe succeeded")
+			} else {
+				logger.Error("probe failed", zap.Error(err))
+			}
+		}
+
+		select {
+		case notifyCh <- Notification{
+			ID:     runner.ID,
+			Status: status,
+		}:
+		case <-ctx.Done():
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (runner *Runner) probe(ctx context.Context) error {
+	switch {
+	case runner.Spec.TCP.Endpoint != "":
+		return runner.probeTCP(ctx)
+	case runner.Spec.ICMP.Host != "":
+		return runner.probeICMP(ctx)
+	case runner.Spec.HTTP.URL != "":
+		return runner.probeHTTP(ctx)
+	default:
+		return errors.New("no probe type specified")
+	}
+}
+
+func (runner *Runner) probeTCP(ctx context.Context) error {
+	dialer := &net.Dialer{
+		Timeout: runner.Spec.TCP.Timeout,
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", runner.Spec.TCP.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+func (runner *Runner) probeHTTP(ctx context.Context) error {
+	client := &http.Client{
+		Timeout: runner.Spec.HTTP.Timeout,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, runner.Spec.HTTP.URL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected HTTP status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+var icmpSequence atomic.Uint32
+
+//nolint:gocyclo
+func (runner *Runner) probeICMP(ctx context.Context) error {
+	timeout := runner.Spec.ICMP.Timeout
+	if timeout <= 0 {
+		timeout = runner.Spec.Interval
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", runner.Spec.ICMP.Host)
+	if err != nil {
+		return err
+	}
+
+	if len(addrs) == 0 {
+		return fmt.Errorf("no addresses found for %q", runner.Spec.ICMP.Host)
+	}
+
+	addr := addrs[0].Unmap()
+
+	var (
+		listenNetwork, listenAddr string
+		requestType               icmp.Type
+		replyType                 icmp.Type
+		protocol                  int
+	)
+
+	if addr.Is4() {
+		listenNetwork, listenAddr = "ip4:icmp", "0.0.0.0"
+		requestType, replyType = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+		protocol = 1
+	} else {
+		listenNetwork, listenAddr = "ip6:ipv6-icmp", "::"
+		requestType, replyType = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+		protocol = 58
+	}
+
+	conn, err := icmp.ListenPacket(listenNetwork, listenAddr)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	deadline, _ := ctx.Deadline()
+
+	if err = conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+
+	id := os.Getpid() & 0xffff
+	seq := int(icmpSequence.Add(1) & 0xffff)
+
+	request, err := (&icmp.Message{
+		Type: requestType,
+		Body: &icmp.Echo{
+			ID:   id,
+			Seq:  seq,
+			Data: []byte("talos-probe"),
+		},
+	}).Marshal(nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = conn.WriteTo(request, &net.IPAddr{IP: addr.AsSlice()}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 1500)
+
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+
+		if peer.String() != addr.String() {
+			continue
+		}
+
+		reply, err := icmp.ParseMessage(protocol, buf[:n])
+		if err != nil {
+			continue
+		}
+
+		if reply.Type != replyType {
+			continue
+		}
+
+		if echo, ok := reply.Body.(*icmp.Echo); ok && echo.ID == id && echo.Seq == seq {
+			return nil
+		}
+	}
+}
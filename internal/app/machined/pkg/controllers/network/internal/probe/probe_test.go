@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package probe_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/internal/probe"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+func expectNotification(t *testing.T, notifyCh <-chan probe.Notification) probe.Notification {
+	t.Helper()
+
+	select {
+	case notification := <-notifyCh:
+		return notification
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no notification received")
+	}
+
+	panic("unreachable")
+}
+
+func TestTCP(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		listener.Close() //nolint:errcheck
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			conn.Close() //nolint:errcheck
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	runner := probe.Runner{
+		ID: "tcp",
+		Spec: network.ProbeSpecSpec{
+			Interval:         10 * time.Millisecond,
+			FailureThreshold: 3,
+			TCP: network.TCPProbeSpec{
+				Endpoint: listener.Addr().String(),
+				Timeout:  time.Second,
+			},
+		},
+	}
+
+	notifyCh := make(chan probe.Notification)
+
+	runner.Start(ctx, notifyCh, zaptest.NewLogger(t))
+	t.Cleanup(runner.Stop)
+
+	// probe succeeds
+	assert.Equal(t, probe.Notification{
+		ID:     "tcp",
+		Status: network.ProbeStatusSpec{Success: true},
+	}, expectNotification(t, notifyCh))
+
+	listener.Close() //nolint:errcheck
+
+	// probe fails, but the failure threshold is not reached yet
+	for i := 0; i < 2; i++ {
+		notification := expectNotification(t, notifyCh)
+
+		assert.True(t, notification.Status.Success)
+		assert.Contains(t, notification.Status.LastError, "connection refused")
+	}
+
+	// the failure threshold is reached
+	notification := expectNotification(t, notifyCh)
+	assert.False(t, notification.Status.Success)
+	assert.Contains(t, notification.Status.LastError, "connection refused")
+}
+
+func TestHTTP(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	for _, tt := range []struct {
+		path            string
+		expectedSuccess bool
+		expectedError   string
+	}{
+		{
+			path:            "/healthz",
+			expectedSuccess: true,
+		},
+		{
+			path:          "/missing",
+			expectedError: "unexpected HTTP status: 404 Not Found",
+		},
+	} {
+		runner := probe.Runner{
+			ID: "http",
+			Spec: network.ProbeSpecSpec{
+				Interval:         time.Second,
+				FailureThreshold: 1,
+				HTTP: network.HTTPProbeSpec{
+					URL:     server.URL + tt.path,
+					Timeout: time.Second,
+				},
+			},
+		}
+
+		notifyCh := make(chan probe.Notification)
+
+		runner.Start(ctx, notifyCh, zaptest.NewLogger(t))
+
+		notification := expectNotification(t, notifyCh)
+
+		runner.Stop()
+
+		assert.Equal(t, tt.expectedSuccess, notification.Status.Success)
+		assert.Equal(t, tt.expectedError, notification.Status.LastError)
+	}
+}
+
+func TestICMP(t *testing.T) {
+	t.Parallel()
+
+	if os.Getuid() != 0 {
+		t.Skip("can't run the test as non-root")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	runner := probe.Runner{
+		ID: "icmp",
+		Spec: network.ProbeSpecSpec{
+			Interval:         time.Second,
+			FailureThreshold: 1,
+			ICMP: network.ICMPProbeSpec{
+				Host:    "127.0.0.1",
+				Timeout: time.Second,
+			},
+		},
+	}
+
+	notifyCh := make(chan probe.Notification)
+
+	runner.Start(ctx, notifyCh, zaptest.NewLogger(t))
+	t.Cleanup(runner.Stop)
+
+	assert.Equal(t, probe.Notification{
+		ID:     "icmp",
+		Status: network.ProbeStatusSpec{Success: true},
+	}, expectNotification(t, notifyCh))
+}
@@ -333,6 +333,12 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 			linkMap[device.Interface()].MTU = uint32(device.MTU())
 		}
 
+		if device.IPv6() != nil {
+			if err := SetIPv6(linkMap[device.Interface()], device.IPv6()); err != nil {
+				logger.Error("error parsing IPv6 config", zap.Error(err))
+			}
+		}
+
 		if device.Bond() != nil {
 			if err := SetBondMaster(linkMap[device.Interface()], device.Bond()); err != nil {
 				logger.Error("error parsing bond config", zap.Error(err))
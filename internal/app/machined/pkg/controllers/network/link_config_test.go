@@ -223,6 +223,10 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 						{
 							DeviceInterface: "eth1",
 							DeviceMTU:       9001,
+							DeviceIPv6Config: &v1alpha1.DeviceIPv6Config{
+								IPv6Mode:        "slaac",
+								IPv6UseTempAddr: 2,
+							},
 						},
 						{
 							DeviceIgnore:    pointer.To(true),
@@ -324,8 +328,14 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 
 							if r.TypedSpec().Name == "eth0" {
 								suite.Assert().EqualValues(0, r.TypedSpec().MTU)
+								suite.Assert().Equal(network.IPv6Spec{}, r.TypedSpec().IPv6)
 							} else {
 								suite.Assert().EqualValues(9001, r.TypedSpec().MTU)
+								suite.Assert().Equal(network.IPv6Spec{
+									Mode:        nethelpers.IPv6ModeSLAAC,
+									AcceptRA:    true,
+									UseTempAddr: 2,
+								}, r.TypedSpec().IPv6)
 							}
 						case "eth0.24", "eth0.48":
 							suite.Assert().True(r.TypedSpec().Up)
@@ -6,8 +6,13 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -541,6 +546,13 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 			logger.Info("changed MTU for the link", zap.Uint32("mtu", link.TypedSpec().MTU))
 		}
 
+		// sync IPv6 settings if the mode is set in the spec
+		if link.TypedSpec().IPv6.Mode != nethelpers.IPv6ModeUnspec {
+			if err := syncIPv6Settings(logger, link.TypedSpec().Name, link.TypedSpec().IPv6); err != nil {
+				return fmt.Errorf("error syncing IPv6 settings for %q: %w", link.TypedSpec().Name, err)
+			}
+		}
+
 		// sync master index (for links which are bridge or bond slaves)
 		var masterIndex uint32
 
@@ -583,3 +595,63 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 
 	return nil
 }
+
+// ipv6ConfPath is the path to the per-link IPv6 sysctls.
+const ipv6ConfPath = "/proc/sys/net/ipv6/conf"
+
+// ipv6Settings builds the list of per-link IPv6 sysctls for the spec.
+//
+// accept_ra is set to 2 to accept router advertisements even if forwarding is enabled.
+func ipv6Settings(spec network.IPv6Spec) []ordered.Pair[string, string] {
+	if spec.Mode == nethelpers.IPv6ModeDisabled {
+		return []ordered.Pair[string, string]{
+			ordered.MakePair("disable_ipv6", "1"),
+		}
+	}
+
+	acceptRA, autoconf := "0", "0"
+
+	if spec.AcceptRA {
+		acceptRA = "2"
+	}
+
+	if spec.Mode == nethelpers.IPv6ModeSLAAC {
+		autoconf = "1"
+	}
+
+	return []ordered.Pair[string, string]{
+		ordered.MakePair("disable_ipv6", "0"),
+		ordered.MakePair("accept_ra", acceptRA),
+		ordered.MakePair("autoconf", autoconf),
+		ordered.MakePair("use_tempaddr", strconv.Itoa(spec.UseTempAddr)),
+	}
+}
+
+// syncIPv6Settings writes per-link IPv6 sysctls which differ from the spec.
+func syncIPv6Settings(logger *zap.Logger, linkName string, spec network.IPv6Spec) error {
+	for _, setting := range ipv6Settings(spec) {
+		path := filepath.Join(ipv6ConfPath, linkName, setting.F1)
+
+		current, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				// IPv6 is not available for the link
+				return nil
+			}
+
+			return err
+		}
+
+		if strings.TrimSpace(string(current)) == setting.F2 {
+			continue
+		}
+
+		if err = os.WriteFile(path, []byte(setting.F2), 0o644); err != nil {
+			return err
+		}
+
+		logger.Info("changed IPv6 setting for the link", zap.String("key", setting.F1), zap.String("value", setting.F2))
+	}
+
+	return nil
+}
@@ -11,6 +11,9 @@ import (
 	"log"
 	"math/rand"
 	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -216,6 +219,93 @@ func (suite *LinkSpecSuite) TestDummy() {
 	)
 }
 
+func (suite *LinkSpecSuite) TestIPv6() {
+	dummyInterface := suite.uniqueDummyInterface()
+
+	dummy := network.NewLinkSpec(network.NamespaceName, dummyInterface)
+	*dummy.TypedSpec() = network.LinkSpecSpec{
+		Name:    dummyInterface,
+		Type:    nethelpers.LinkEther,
+		Kind:    "dummy",
+		Up:      true,
+		Logical: true,
+		IPv6: network.IPv6Spec{
+			Mode:        nethelpers.IPv6ModeSLAAC,
+			UseTempAddr: 2,
+		},
+		ConfigLayer: network.ConfigDefault,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, dummy))
+
+	assertSysctls := func(expected map[string]string) error {
+		for key, value := range expected {
+			contents, err := os.ReadFile(filepath.Join("/proc/sys/net/ipv6/conf", dummyInterface, key))
+			if err != nil {
+				return retry.ExpectedError(err)
+			}
+
+			if strings.TrimSpace(string(contents)) != value {
+				return retry.ExpectedErrorf("unexpected %s value %q", key, strings.TrimSpace(string(contents)))
+			}
+		}
+
+		return nil
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return assertSysctls(map[string]string{
+					"disable_ipv6": "0",
+					"accept_ra":    "0",
+					"autoconf":     "1",
+					"use_tempaddr": "2",
+				})
+			},
+		),
+	)
+
+	_, err := suite.state.UpdateWithConflicts(suite.ctx, dummy.Metadata(), func(r resource.Resource) error {
+		r.(*network.LinkSpec).TypedSpec().IPv6 = network.IPv6Spec{
+			Mode: nethelpers.IPv6ModeDisabled,
+		}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return assertSysctls(map[string]string{
+					"disable_ipv6": "1",
+				})
+			},
+		),
+	)
+
+	// teardown the link
+	for {
+		ready, err := suite.state.Teardown(suite.ctx, dummy.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoInterface(dummyInterface)
+			},
+		),
+	)
+}
+
 //nolint:gocyclo
 func (suite *LinkSpecSuite) TestVLAN() {
 	dummyInterface := suite.uniqueDummyInterface()
@@ -0,0 +1,205 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// LLDPController runs LLDP discovery on physical links and publishes discovered neighbors as LLDPNeighbors.
+type LLDPController struct {
+	// Listen is used to open LLDP connections, defaults to lldp.Listen.
+	Listen lldp.ListenFunc
+
+	runners map[string]*lldp.Runner
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *LLDPController) Name() string {
+	return "network.LLDPController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LLDPController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostnameStatusType,
+			ID:        pointer.To(network.HostnameID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LLDPController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.LLDPNeighborType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *LLDPController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	notifyCh := make(chan lldp.Notification)
+
+	ctrl.runners = make(map[string]*lldp.Runner)
+
+	defer func() {
+		for _, runner := range ctrl.runners {
+			runner.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			if err := ctrl.reconcileRunners(ctx, r, notifyCh, logger); err != nil {
+				return err
+			}
+		case notification := <-notifyCh:
+			if _, ok := ctrl.runners[notification.LinkName]; !ok {
+				// link is no longer watched
+				continue
+			}
+
+			if notification.Neighbor == nil {
+				if err := r.Destroy(ctx, network.NewLLDPNeighbor(network.NamespaceName, notification.LinkName).Metadata()); err != nil && !state.IsNotFoundError(err) {
+					return fmt.Errorf("error destroying LLDP neighbor: %w", err)
+				}
+
+				continue
+			}
+
+			if err := r.Modify(ctx, network.NewLLDPNeighbor(network.NamespaceName, notification.LinkName), func(r resource.Resource) error {
+				*r.(*network.LLDPNeighbor).TypedSpec() = *notification.Neighbor
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying LLDP neighbor: %w", err)
+			}
+		}
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *LLDPController) reconcileRunners(ctx context.Context, r controller.Runtime, notifyCh chan<- lldp.Notification, logger *zap.Logger) error {
+	var transmit bool
+
+	cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+	} else {
+		transmit = cfg.(*config.MachineConfig).Config().Machine().Network().LLDP().Transmit()
+	}
+
+	var systemName string
+
+	hostname, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.HostnameStatusType, network.HostnameID, resource.VersionUndefined))
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting hostname: %w", err)
+		}
+	} else {
+		systemName = hostname.(*network.HostnameStatus).TypedSpec().FQDN()
+	}
+
+	links, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing links: %w", err)
+	}
+
+	shouldRun := make(map[string]lldp.Spec, len(links.Items))
+
+	for _, res := range links.Items {
+		link := res.(*network.LinkStatus).TypedSpec()
+
+		if !link.Physical() || !link.LinkState {
+			continue
+		}
+
+		shouldRun[res.Metadata().ID()] = lldp.Spec{
+			HardwareAddr: link.HardwareAddr.String(),
+			SystemName:   systemName,
+			Transmit:     transmit,
+		}
+	}
+
+	// stop runners which are no longer needed or which spec has changed
+	for linkName, runner := range ctrl.runners {
+		spec, exists := shouldRun[linkName]
+
+		if exists && spec == runner.Spec {
+			continue
+		}
+
+		runner.Stop()
+
+		delete(ctrl.runners, linkName)
+	}
+
+	// start missing runners
+	for linkName, spec := range shouldRun {
+		if _, running := ctrl.runners[linkName]; running {
+			continue
+		}
+
+		runner := &lldp.Runner{
+			LinkName: linkName,
+			Spec:     spec,
+			Listen:   ctrl.Listen,
+		}
+
+		runner.Start(ctx, notifyCh, logger)
+
+		ctrl.runners[linkName] = runner
+	}
+
+	// clean up neighbors of links which are not watched
+	neighbors, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.LLDPNeighborType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing LLDP neighbors: %w", err)
+	}
+
+	for _, res := range neighbors.Items {
+		if _, running := ctrl.runners[res.Metadata().ID()]; running {
+			continue
+		}
+
+		if err = r.Destroy(ctx, res.Metadata()); err != nil {
+			return fmt.Errorf("error destroying LLDP neighbor: %w", err)
+		}
+	}
+
+	return nil
+}
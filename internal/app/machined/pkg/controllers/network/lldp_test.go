@@ -0,0 +1,210 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type mockLLDPConn struct {
+	readCh  chan []byte
+	writeCh chan []byte
+	closed  chan struct{}
+}
+
+func (c *mockLLDPConn) Read(b []byte) (int, error) {
+	select {
+	case frame := <-c.readCh:
+		return copy(b, frame), nil
+	case <-c.closed:
+		return 0, net.ErrClosed
+	}
+}
+
+func (c *mockLLDPConn) Write(b []byte) error {
+	select {
+	case c.writeCh <- append([]byte(nil), b...):
+	default:
+	}
+
+	return nil
+}
+
+func (c *mockLLDPConn) Close() error {
+	close(c.closed)
+
+	return nil
+}
+
+type LLDPSuite struct {
+	ctest.DefaultSuite
+
+	mu    sync.Mutex
+	conns map[string]*mockLLDPConn
+}
+
+func (suite *LLDPSuite) listen(linkName string) (lldp.Conn, error) {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	conn := &mockLLDPConn{
+		readCh:  make(chan []byte),
+		writeCh: make(chan []byte, 16),
+		closed:  make(chan struct{}),
+	}
+
+	suite.conns[linkName] = conn
+
+	return conn, nil
+}
+
+func (suite *LLDPSuite) conn(linkName string) *mockLLDPConn {
+	var conn *mockLLDPConn
+
+	suite.Require().Eventually(func() bool {
+		suite.mu.Lock()
+		defer suite.mu.Unlock()
+
+		conn = suite.conns[linkName]
+
+		return conn != nil
+	}, 3*time.Second, 10*time.Millisecond)
+
+	return conn
+}
+
+func (suite *LLDPSuite) createLink(id string, kind string, up bool) *network.LinkStatus {
+	link := network.NewLinkStatus(network.NamespaceName, id)
+	link.TypedSpec().Type = nethelpers.LinkEther
+	link.TypedSpec().Kind = kind
+	link.TypedSpec().LinkState = up
+	link.TypedSpec().HardwareAddr = nethelpers.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), link))
+
+	return link
+}
+
+func (suite *LLDPSuite) TestDiscovery() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkLLDP: &v1alpha1.NetworkLLDP{
+					LLDPTransmit: pointer.To(true),
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	hostname := network.NewHostnameStatus(network.NamespaceName, network.HostnameID)
+	hostname.TypedSpec().Hostname = "node1"
+	hostname.TypedSpec().Domainname = "example.com"
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), hostname))
+
+	eth0 := suite.createLink("eth0", "", true)
+	suite.createLink("eth1", "", false)
+	suite.createLink("bond0", "bond", true)
+
+	conn := suite.conn("eth0")
+
+	select {
+	case payload := <-conn.writeCh:
+		spec, ttl, err := lldp.Decode(payload)
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal(lldp.TTL, ttl)
+		suite.Assert().Equal("52:54:00:12:34:56", spec.ChassisID)
+		suite.Assert().Equal("eth0", spec.PortID)
+		suite.Assert().Equal("node1.example.com", spec.SystemName)
+	case <-time.After(3 * time.Second):
+		suite.FailNow("no LLDP frame transmitted")
+	}
+
+	frame, err := lldp.Encode(net.HardwareAddr{0x00, 0x1c, 0x73, 0xaa, 0xbb, 0xcc}, "Ethernet12", "tor-4a", lldp.TTL)
+	suite.Require().NoError(err)
+
+	select {
+	case conn.readCh <- frame:
+	case <-time.After(3 * time.Second):
+		suite.FailNow("LLDP frame not received")
+	}
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		neighbor, err := ctest.Get[*network.LLDPNeighbor](suite, resource.NewMetadata(network.NamespaceName, network.LLDPNeighborType, "eth0", resource.VersionUndefined))
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(network.LLDPNeighborSpec{
+			ChassisID:  "00:1c:73:aa:bb:cc",
+			PortID:     "Ethernet12",
+			SystemName: "tor-4a",
+		}, *neighbor.TypedSpec())
+	}))
+
+	suite.mu.Lock()
+	suite.Assert().NotContains(suite.conns, "eth1")
+	suite.Assert().NotContains(suite.conns, "bond0")
+	suite.mu.Unlock()
+
+	// link goes down, neighbor should be removed
+	eth0.TypedSpec().LinkState = false
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), eth0))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		_, err := ctest.Get[*network.LLDPNeighbor](suite, resource.NewMetadata(network.NamespaceName, network.LLDPNeighborType, "eth0", resource.VersionUndefined))
+		assert.True(state.IsNotFoundError(err))
+	}))
+}
+
+func TestLLDPSuite(t *testing.T) {
+	s := &LLDPSuite{
+		conns: map[string]*mockLLDPConn{},
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.LLDPController{
+				Listen: s.listen,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
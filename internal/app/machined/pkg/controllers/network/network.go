@@ -141,3 +141,19 @@ func SetBridgeMaster(link *network.LinkSpecSpec, bridge talosconfig.Bridge) erro
 
 	return nil
 }
+
+// SetIPv6 sets the IPv6 settings spec.
+func SetIPv6(link *network.LinkSpecSpec, ipv6 talosconfig.DeviceIPv6) error {
+	mode, err := nethelpers.IPv6ModeString(ipv6.Mode())
+	if err != nil {
+		return err
+	}
+
+	link.IPv6 = network.IPv6Spec{
+		Mode:        mode,
+		AcceptRA:    ipv6.AcceptRA(),
+		UseTempAddr: ipv6.UseTempAddr(),
+	}
+
+	return nil
+}
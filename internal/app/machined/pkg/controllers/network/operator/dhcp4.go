@@ -6,6 +6,7 @@ package operator
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
@@ -34,6 +35,17 @@ type DHCP4 struct {
 	skipHostnameRequest bool
 	requestMTU          bool
 
+	clientIdentifier []byte
+	vendorClass      string
+	userClasses      []string
+	requestedOptions []dhcpv4.OptionCode
+	sendHostname     string
+
+	// last logged values of the requested options which are not consumed by Talos
+	loggedOptions map[uint8]string
+
+	newClient func(linkName string, opts ...nclient4.ClientOpt) (*nclient4.Client, error)
+
 	offer *dhcpv4.DHCPv4
 
 	mu          sync.Mutex
@@ -47,6 +59,13 @@ type DHCP4 struct {
 
 // NewDHCP4 creates DHCPv4 operator.
 func NewDHCP4(logger *zap.Logger, linkName string, config network.DHCP4OperatorSpec, platform runtime.Platform) *DHCP4 {
+	clientIdentifier, err := hex.DecodeString(config.ClientIdentifier)
+	if err != nil {
+		logger.Error("failed to parse client identifier, ignored", zap.String("link", linkName))
+
+		clientIdentifier = nil
+	}
+
 	return &DHCP4{
 		logger:              logger,
 		linkName:            linkName,
@@ -55,7 +74,13 @@ func NewDHCP4(logger *zap.Logger, linkName string, config network.DHCP4OperatorS
 		// <3 azure
 		// When including dhcp.OptionInterfaceMTU we don't get a dhcp offer back on azure.
 		// So we'll need to explicitly exclude adding this option for azure.
-		requestMTU: platform.Name() != "azure",
+		requestMTU:       platform.Name() != "azure",
+		clientIdentifier: clientIdentifier,
+		vendorClass:      config.VendorClass,
+		userClasses:      config.UserClasses,
+		requestedOptions: slices.Map(config.RequestedOptions, func(code uint32) dhcpv4.OptionCode { return dhcpv4.GenericOptionCode(code) }),
+		sendHostname:     config.Hostname,
+		newClient:        nclient4.New,
 	}
 }
 
@@ -256,10 +281,17 @@ func (d *DHCP4) parseAck(ack *dhcpv4.DHCPv4) {
 			return result
 		}
 
+		var searchDomains []string
+
+		if domainSearch := ack.DomainSearch(); domainSearch != nil {
+			searchDomains = domainSearch.Labels
+		}
+
 		d.resolvers = []network.ResolverSpecSpec{
 			{
-				DNSServers:  slices.Map(ack.DNS(), convertIP),
-				ConfigLayer: network.ConfigOperator,
+				DNSServers:    slices.Map(ack.DNS(), convertIP),
+				SearchDomains: searchDomains,
+				ConfigLayer:   network.ConfigOperator,
 			},
 		}
 	} else {
@@ -304,7 +336,8 @@ func (d *DHCP4) parseAck(ack *dhcpv4.DHCPv4) {
 	}
 }
 
-func (d *DHCP4) renew(ctx context.Context) (time.Duration, error) {
+// consumedOptions returns the list of options which are requested and consumed by Talos.
+func (d *DHCP4) consumedOptions() []dhcpv4.OptionCode {
 	opts := []dhcpv4.OptionCode{
 		dhcpv4.OptionClasslessStaticRoute,
 		dhcpv4.OptionDomainNameServer,
@@ -321,7 +354,68 @@ func (d *DHCP4) renew(ctx context.Context) (time.Duration, error) {
 		opts = append(opts, dhcpv4.OptionInterfaceMTU)
 	}
 
+	return opts
+}
+
+// logRequestedOptions logs the values of the additionally requested options which are not consumed by Talos.
+//
+// The option is logged only when its value changes, so that lease renewals don't repeat the same log lines.
+func (d *DHCP4) logRequestedOptions(ack *dhcpv4.DHCPv4) {
+	consumed := d.consumedOptions()
+
+	if d.loggedOptions == nil {
+		d.loggedOptions = map[uint8]string{}
+	}
+
+	for _, code := range d.requestedOptions {
+		code := code
+
+		if slices.Contains(consumed, func(c dhcpv4.OptionCode) bool { return c.Code() == code.Code() }) {
+			continue
+		}
+
+		value := ack.Options.Get(code)
+		if value == nil {
+			continue
+		}
+
+		if previous, ok := d.loggedOptions[code.Code()]; ok && previous == string(value) {
+			continue
+		}
+
+		d.loggedOptions[code.Code()] = string(value)
+
+		d.logger.Info("DHCP option received", zap.String("link", d.linkName), zap.Uint8("code", code.Code()), zap.ByteString("value", value))
+	}
+}
+
+func (d *DHCP4) renew(ctx context.Context) (time.Duration, error) {
+	opts := d.consumedOptions()
+
+	for _, code := range d.requestedOptions {
+		if !slices.Contains(opts, func(c dhcpv4.OptionCode) bool { return c.Code() == code.Code() }) {
+			opts = append(opts, code)
+		}
+	}
+
 	mods := []dhcpv4.Modifier{dhcpv4.WithRequestedOptions(opts...)}
+
+	if len(d.clientIdentifier) > 0 {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClientIdentifier(d.clientIdentifier)))
+	}
+
+	if d.vendorClass != "" {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClassIdentifier(d.vendorClass)))
+	}
+
+	if len(d.userClasses) > 0 {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptRFC3004UserClass(d.userClasses)))
+	}
+
+	if d.sendHostname != "" {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptHostName(d.sendHostname)))
+	}
+
 	clientOpts := []nclient4.ClientOpt{}
 
 	if d.offer != nil {
@@ -337,7 +431,7 @@ func (d *DHCP4) renew(ctx context.Context) (time.Duration, error) {
 		clientOpts = append(clientOpts, nclient4.WithServerAddr(addr))
 	}
 
-	cli, err := nclient4.New(d.linkName, clientOpts...)
+	cli, err := d.newClient(d.linkName, clientOpts...)
 	if err != nil {
 		return 0, err
 	}
@@ -364,6 +458,7 @@ func (d *DHCP4) renew(ctx context.Context) (time.Duration, error) {
 
 	d.offer = lease.Offer
 	d.parseAck(lease.ACK)
+	d.logRequestedOptions(lease.ACK)
 
 	return lease.ACK.IPAddressLeaseTime(time.Minute * 30), nil
 }
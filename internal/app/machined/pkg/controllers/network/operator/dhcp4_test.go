@@ -0,0 +1,234 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv4/nclient4"
+	"github.com/insomniacslk/dhcp/rfc1035label"
+	"github.com/siderolabs/gen/slices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/operator"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/metal"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+var clientHWAddr = net.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}
+
+// dhcp4Server is an in-process DHCPv4 server listening on the loopback.
+type dhcp4Server struct {
+	conn net.PacketConn
+
+	mu       sync.Mutex
+	requests []*dhcpv4.DHCPv4
+}
+
+func newDHCP4Server(t *testing.T) *dhcp4Server {
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	srv := &dhcp4Server{conn: conn}
+
+	go srv.serve()
+
+	return srv
+}
+
+func (srv *dhcp4Server) serve() {
+	buf := make([]byte, 1500)
+
+	for {
+		n, peer, err := srv.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		msg, err := dhcpv4.FromBytes(buf[:n])
+		if err != nil {
+			continue
+		}
+
+		var replyType dhcpv4.MessageType
+
+		switch msg.MessageType() { //nolint:exhaustive
+		case dhcpv4.MessageTypeDiscover:
+			replyType = dhcpv4.MessageTypeOffer
+		case dhcpv4.MessageTypeRequest:
+			replyType = dhcpv4.MessageTypeAck
+		default:
+			continue
+		}
+
+		srv.mu.Lock()
+		srv.requests = append(srv.requests, msg)
+		srv.mu.Unlock()
+
+		_, dest, _ := net.ParseCIDR("10.6.0.0/16") //nolint:errcheck
+
+		reply, err := dhcpv4.NewReplyFromRequest(msg,
+			dhcpv4.WithMessageType(replyType),
+			dhcpv4.WithYourIP(net.ParseIP("10.5.0.2")),
+			dhcpv4.WithServerIP(net.ParseIP("127.0.0.1")),
+			dhcpv4.WithNetmask(net.CIDRMask(24, 32)),
+			dhcpv4.WithOption(dhcpv4.OptServerIdentifier(net.ParseIP("127.0.0.1"))),
+			dhcpv4.WithOption(dhcpv4.OptIPAddressLeaseTime(time.Hour)),
+			dhcpv4.WithOption(dhcpv4.OptDNS(net.ParseIP("10.5.0.1"))),
+			dhcpv4.WithOption(dhcpv4.OptDomainSearch(&rfc1035label.Labels{Labels: []string{"example.com", "example.org"}})),
+			dhcpv4.WithOption(dhcpv4.OptNTPServers(net.ParseIP("10.5.0.3"))),
+			dhcpv4.WithOption(dhcpv4.OptClasslessStaticRoute(&dhcpv4.Route{Dest: dest, Router: net.ParseIP("10.5.0.1")})),
+			dhcpv4.WithOption(dhcpv4.Option{Code: dhcpv4.OptionInterfaceMTU, Value: dhcpv4.Uint16(1400)}),
+			dhcpv4.WithOption(dhcpv4.OptTFTPServerName("tftp.example.com")),
+		)
+		if err != nil {
+			continue
+		}
+
+		srv.conn.WriteTo(reply.ToBytes(), peer) //nolint:errcheck
+	}
+}
+
+func (srv *dhcp4Server) lastRequest() *dhcpv4.DHCPv4 {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if len(srv.requests) == 0 {
+		return nil
+	}
+
+	return srv.requests[len(srv.requests)-1]
+}
+
+func TestDHCP4(t *testing.T) {
+	srv := newDHCP4Server(t)
+
+	observedCore, observedLogs := observer.New(zapcore.InfoLevel)
+
+	logger := zaptest.NewLogger(t, zaptest.WrapOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(core, observedCore)
+	})))
+
+	dhcp := operator.NewDHCP4(logger, "eth0", network.DHCP4OperatorSpec{
+		RouteMetric:      1024,
+		ClientIdentifier: "01525400123456",
+		VendorClass:      "PXEClient",
+		UserClasses:      []string{"talos", "metal"},
+		RequestedOptions: []uint32{66, 67, 121},
+		Hostname:         "node1",
+	}, &metal.Metal{})
+
+	dhcp.SetClientFactory(func(_ string, opts ...nclient4.ClientOpt) (*nclient4.Client, error) {
+		conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+		if err != nil {
+			return nil, err
+		}
+
+		return nclient4.NewWithConn(conn, clientHWAddr,
+			append(opts,
+				nclient4.WithServerAddr(srv.conn.LocalAddr().(*net.UDPAddr)),
+				nclient4.WithTimeout(time.Second),
+			)...,
+		)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	notifyCh := make(chan struct{})
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		dhcp.Run(ctx, notifyCh)
+	}()
+
+	select {
+	case <-notifyCh:
+	case <-ctx.Done():
+		require.FailNow(t, "timed out waiting for the lease")
+	}
+
+	cancel()
+	wg.Wait()
+
+	// check client options sent to the server
+	request := srv.lastRequest()
+	require.NotNil(t, request)
+
+	assert.Equal(t, dhcpv4.MessageTypeRequest, request.MessageType())
+	assert.Equal(t, []byte{0x01, 0x52, 0x54, 0x00, 0x12, 0x34, 0x56}, request.GetOneOption(dhcpv4.OptionClientIdentifier))
+	assert.Equal(t, "PXEClient", request.ClassIdentifier())
+	assert.Equal(t, []string{"talos", "metal"}, request.UserClass())
+	assert.Equal(t, "node1", request.HostName())
+	requested := slices.Map(request.ParameterRequestList(), func(c dhcpv4.OptionCode) uint8 { return c.Code() })
+	assert.Subset(t, requested, []uint8{66, 67, 119, 121})
+	assert.Len(t, slices.Filter(requested, func(c uint8) bool { return c == 121 }), 1)
+
+	// check received options
+	assert.Equal(t, []network.AddressSpecSpec{
+		{
+			Address:     netip.MustParsePrefix("10.5.0.2/24"),
+			LinkName:    "eth0",
+			Family:      nethelpers.FamilyInet4,
+			Scope:       nethelpers.ScopeGlobal,
+			Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+			ConfigLayer: network.ConfigOperator,
+		},
+	}, dhcp.AddressSpecs())
+
+	assert.Equal(t, []network.LinkSpecSpec{
+		{
+			Name: "eth0",
+			MTU:  1400,
+			Up:   true,
+		},
+	}, dhcp.LinkSpecs())
+
+	routes := dhcp.RouteSpecs()
+	require.Len(t, routes, 1)
+	assert.Equal(t, netip.MustParsePrefix("10.6.0.0/16"), routes[0].Destination)
+	assert.Equal(t, netip.MustParseAddr("10.5.0.1"), routes[0].Gateway)
+	assert.EqualValues(t, 1024, routes[0].Priority)
+
+	assert.Equal(t, []network.ResolverSpecSpec{
+		{
+			DNSServers:    []netip.Addr{netip.MustParseAddr("10.5.0.1")},
+			SearchDomains: []string{"example.com", "example.org"},
+			ConfigLayer:   network.ConfigOperator,
+		},
+	}, dhcp.ResolverSpecs())
+
+	assert.Equal(t, []network.TimeServerSpecSpec{
+		{
+			NTPServers:  []string{"10.5.0.3"},
+			ConfigLayer: network.ConfigOperator,
+		},
+	}, dhcp.TimeServerSpecs())
+
+	// options which are not consumed by Talos are logged
+	logged := observedLogs.FilterMessage("DHCP option received").All()
+	require.Len(t, logged, 1)
+	assert.EqualValues(t, 66, logged[0].ContextMap()["code"])
+	assert.Equal(t, "tftp.example.com", logged[0].ContextMap()["value"])
+}
@@ -0,0 +1,25 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import "github.com/insomniacslk/dhcp/dhcpv4/nclient4"
+
+// SetClientFactory overrides the DHCPv4 client constructor.
+func (d *DHCP4) SetClientFactory(f func(linkName string, opts ...nclient4.ClientOpt) (*nclient4.Client, error)) {
+	d.newClient = f
+}
+
+// SetLeader overrides the VIP leadership status.
+func (vip *VIP) SetLeader(leader bool) {
+	vip.mu.Lock()
+	defer vip.mu.Unlock()
+
+	vip.leader = leader
+}
+
+// EtcdElectionKey exposes the VIP etcd election key.
+func (vip *VIP) EtcdElectionKey() string {
+	return vip.etcdElectionKey()
+}
@@ -48,6 +48,10 @@ type VIP struct {
 
 // NewVIP creates Virtual IP operator.
 func NewVIP(logger *zap.Logger, linkName string, spec network.VIPOperatorSpec, state state.State) *VIP {
+	// normalize the shared IP, so that all nodes use the same address for the election key
+	// and the address spec even if the IP was specified as IPv4-mapped IPv6 address
+	spec.IP = spec.IP.Unmap().WithZone("")
+
 	var handler vip.Handler
 
 	switch {
@@ -55,6 +59,8 @@ func NewVIP(logger *zap.Logger, linkName string, spec network.VIPOperatorSpec, s
 		handler = vip.NewEquinixMetalHandler(logger, spec.IP.String(), spec.EquinixMetal)
 	case spec.HCloud != network.VIPHCloudSpec{}:
 		handler = vip.NewHCloudHandler(logger, spec.IP.String(), spec.HCloud)
+	case len(spec.BGP.Peers) > 0:
+		handler = vip.NewBGPHandler(logger, spec.IP, spec.BGP)
 	default:
 		handler = vip.NopHandler{}
 	}
@@ -101,12 +107,13 @@ func (vip *VIP) AddressSpecs() []network.AddressSpecSpec {
 		return nil
 	}
 
-	family := nethelpers.FamilyInet6
-	gratuitousARP := false
+	family := nethelpers.FamilyInet4
+	flags := nethelpers.AddressFlags(nethelpers.AddressPermanent)
 
-	if vip.sharedIP.Is4() {
-		family = nethelpers.FamilyInet4
-		gratuitousARP = vip.gratuitousARP
+	if vip.sharedIP.Is6() {
+		family = nethelpers.FamilyInet6
+		// skip DAD, as the address is moved between the nodes and should be usable (and announced) immediately
+		flags |= nethelpers.AddressFlags(nethelpers.AddressNoDAD)
 	}
 
 	return []network.AddressSpecSpec{
@@ -115,8 +122,8 @@ func (vip *VIP) AddressSpecs() []network.AddressSpecSpec {
 			LinkName:        vip.linkName,
 			Family:          family,
 			Scope:           nethelpers.ScopeGlobal,
-			Flags:           nethelpers.AddressFlags(nethelpers.AddressPermanent),
-			AnnounceWithARP: gratuitousARP,
+			Flags:           flags,
+			AnnounceWithARP: vip.gratuitousARP,
 			ConfigLayer:     network.ConfigOperator,
 		},
 	}
@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vip
+
+import (
+	"context"
+	"net/netip"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/pkg/bgp"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// BGPHandler announces the virtual IP over BGP while it is acquired.
+type BGPHandler struct {
+	logger *zap.Logger
+
+	prefix netip.Prefix
+	spec   network.VIPBGPSpec
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewBGPHandler creates new BGPHandler.
+func NewBGPHandler(logger *zap.Logger, vip netip.Addr, spec network.VIPBGPSpec) *BGPHandler {
+	return &BGPHandler{
+		logger: logger,
+		prefix: netip.PrefixFrom(vip, vip.BitLen()),
+		spec:   spec,
+	}
+}
+
+// Acquire implements Handler interface.
+//
+// Acquire starts BGP speakers for each peer, sessions are established in the background.
+func (handler *BGPHandler) Acquire(ctx context.Context) error {
+	handler.stop()
+
+	// speakers should outlive the context, they are stopped on Release
+	speakerCtx, cancel := context.WithCancel(context.Background())
+
+	handler.cancel = cancel
+
+	for _, peer := range handler.spec.Peers {
+		speaker := bgp.NewSpeaker(handler.logger, bgp.Config{
+			ASN:      handler.spec.LocalASN,
+			RouterID: handler.spec.RouterID,
+			HoldTime: handler.spec.HoldTime,
+		}, bgp.Peer{
+			Address: peer.Address,
+			ASN:     peer.ASN,
+		}, []netip.Prefix{handler.prefix})
+
+		handler.wg.Add(1)
+
+		go func() {
+			defer handler.wg.Done()
+
+			speaker.Run(speakerCtx)
+		}()
+	}
+
+	handler.logger.Info("started announcing VIP over BGP", zap.Stringer("prefix", handler.prefix), zap.Int("peers", len(handler.spec.Peers)))
+
+	return nil
+}
+
+// Release implements Handler interface.
+//
+// Release withdraws the prefix and closes BGP sessions.
+func (handler *BGPHandler) Release(ctx context.Context) error {
+	handler.stop()
+
+	handler.logger.Info("stopped announcing VIP over BGP", zap.Stringer("prefix", handler.prefix))
+
+	return nil
+}
+
+func (handler *BGPHandler) stop() {
+	if handler.cancel == nil {
+		return
+	}
+
+	handler.cancel()
+	handler.wg.Wait()
+
+	handler.cancel = nil
+}
@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vip_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/operator/vip"
+	"github.com/talos-systems/talos/internal/pkg/bgp"
+	"github.com/talos-systems/talos/internal/pkg/bgp/bgptest"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+func TestBGPHandler(t *testing.T) {
+	t.Parallel()
+
+	for _, sharedIP := range []netip.Addr{netip.MustParseAddr("10.5.0.100"), netip.MustParseAddr("fd00::100")} {
+		sharedIP := sharedIP
+
+		t.Run(sharedIP.String(), func(t *testing.T) {
+			t.Parallel()
+
+			// the prefix is announced with the local address of the session as the next hop, so the session should be of the same family
+			loopback := netip.MustParseAddr("127.0.0.1")
+			if sharedIP.Is6() {
+				loopback = netip.IPv6Loopback()
+			}
+
+			ebgpPeer := bgptest.StartOn(t, 65000, loopback)
+			ibgpPeer := bgptest.StartOn(t, 65001, loopback)
+
+			handler := vip.NewBGPHandler(zaptest.NewLogger(t), sharedIP, network.VIPBGPSpec{
+				LocalASN: 65001,
+				RouterID: netip.MustParseAddr("10.5.0.2"),
+				Peers: []network.VIPBGPPeerSpec{
+					{
+						Address: ebgpPeer.Addr(),
+						ASN:     65000,
+					},
+					{
+						Address: ibgpPeer.Addr(),
+						ASN:     65001,
+					},
+				},
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			prefix := netip.PrefixFrom(sharedIP, sharedIP.BitLen())
+
+			for i := 0; i < 2; i++ {
+				require.NoError(t, handler.Acquire(ctx))
+
+				assert.Eventually(t, func() bool {
+					return len(ebgpPeer.Routes()) == 1 && len(ibgpPeer.Routes()) == 1
+				}, 5*time.Second, 10*time.Millisecond)
+
+				assert.Equal(t, bgptest.Route{
+					NextHop: loopback,
+					ASPath:  []uint32{65001},
+				}, ebgpPeer.Routes()[prefix])
+
+				assert.Equal(t, bgptest.Route{
+					NextHop:   loopback,
+					LocalPref: 100,
+				}, ibgpPeer.Routes()[prefix])
+
+				require.NoError(t, handler.Release(ctx))
+
+				for _, peer := range []*bgptest.Peer{ebgpPeer, ibgpPeer} {
+					assert.Eventually(t, func() bool {
+						return len(peer.Routes()) == 0 && len(peer.Notifications()) == i+1
+					}, 5*time.Second, 10*time.Millisecond)
+
+					assert.Equal(t, uint8(bgp.NotificationCease), peer.Notifications()[i].Code)
+				}
+			}
+
+			// releasing again is no-op
+			require.NoError(t, handler.Release(ctx))
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/operator"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+func TestVIPAddressSpecs(t *testing.T) {
+	t.Parallel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for _, test := range []struct {
+		name          string
+		spec          network.VIPOperatorSpec
+		expectedKey   string
+		expectedSpecs []network.AddressSpecSpec
+	}{
+		{
+			name: "IPv4",
+			spec: network.VIPOperatorSpec{
+				IP:            netip.MustParseAddr("10.5.0.100"),
+				GratuitousARP: true,
+			},
+			expectedKey: "talos:v1:vip:election:10.5.0.100",
+			expectedSpecs: []network.AddressSpecSpec{
+				{
+					Address:         netip.MustParsePrefix("10.5.0.100/32"),
+					LinkName:        "eth0",
+					Family:          nethelpers.FamilyInet4,
+					Scope:           nethelpers.ScopeGlobal,
+					Flags:           nethelpers.AddressFlags(nethelpers.AddressPermanent),
+					AnnounceWithARP: true,
+					ConfigLayer:     network.ConfigOperator,
+				},
+			},
+		},
+		{
+			name: "IPv4-mapped",
+			spec: network.VIPOperatorSpec{
+				IP:            netip.MustParseAddr("::ffff:10.5.0.100"),
+				GratuitousARP: true,
+			},
+			expectedKey: "talos:v1:vip:election:10.5.0.100",
+			expectedSpecs: []network.AddressSpecSpec{
+				{
+					Address:         netip.MustParsePrefix("10.5.0.100/32"),
+					LinkName:        "eth0",
+					Family:          nethelpers.FamilyInet4,
+					Scope:           nethelpers.ScopeGlobal,
+					Flags:           nethelpers.AddressFlags(nethelpers.AddressPermanent),
+					AnnounceWithARP: true,
+					ConfigLayer:     network.ConfigOperator,
+				},
+			},
+		},
+		{
+			name: "IPv6",
+			spec: network.VIPOperatorSpec{
+				IP:            netip.MustParseAddr("fd00::100"),
+				GratuitousARP: true,
+			},
+			expectedKey: "talos:v1:vip:election:fd00::100",
+			expectedSpecs: []network.AddressSpecSpec{
+				{
+					Address:         netip.MustParsePrefix("fd00::100/128"),
+					LinkName:        "eth0",
+					Family:          nethelpers.FamilyInet6,
+					Scope:           nethelpers.ScopeGlobal,
+					Flags:           nethelpers.AddressFlags(nethelpers.AddressPermanent | nethelpers.AddressNoDAD),
+					AnnounceWithARP: true,
+					ConfigLayer:     network.ConfigOperator,
+				},
+			},
+		},
+		{
+			name: "IPv6 without announce",
+			spec: network.VIPOperatorSpec{
+				IP: netip.MustParseAddr("fd00::100"),
+			},
+			expectedKey: "talos:v1:vip:election:fd00::100",
+			expectedSpecs: []network.AddressSpecSpec{
+				{
+					Address:     netip.MustParsePrefix("fd00::100/128"),
+					LinkName:    "eth0",
+					Family:      nethelpers.FamilyInet6,
+					Scope:       nethelpers.ScopeGlobal,
+					Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent | nethelpers.AddressNoDAD),
+					ConfigLayer: network.ConfigOperator,
+				},
+			},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			vip := operator.NewVIP(zaptest.NewLogger(t), "eth0", test.spec, st)
+
+			assert.Equal(t, test.expectedKey, vip.EtcdElectionKey())
+
+			// not a leader, no addresses
+			assert.Empty(t, vip.AddressSpecs())
+
+			vip.SetLeader(true)
+
+			assert.Equal(t, test.expectedSpecs, vip.AddressSpecs())
+
+			vip.SetLeader(false)
+
+			assert.Empty(t, vip.AddressSpecs())
+		})
+	}
+}
+
+func TestVIPDualStack(t *testing.T) {
+	t.Parallel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+	logger := zaptest.NewLogger(t)
+
+	vip4 := operator.NewVIP(logger, "eth0", network.VIPOperatorSpec{IP: netip.MustParseAddr("10.5.0.100"), GratuitousARP: true}, st)
+	vip6 := operator.NewVIP(logger, "eth0.26", network.VIPOperatorSpec{IP: netip.MustParseAddr("fd00::100"), GratuitousARP: true}, st)
+
+	// both VIPs run independent elections
+	assert.NotEqual(t, vip4.EtcdElectionKey(), vip6.EtcdElectionKey())
+	assert.NotEqual(t, vip4.Prefix(), vip6.Prefix())
+
+	vip4.SetLeader(true)
+	vip6.SetLeader(true)
+
+	specs := append(vip4.AddressSpecs(), vip6.AddressSpecs()...)
+
+	assert.Len(t, specs, 2)
+
+	assert.Equal(t, netip.MustParsePrefix("10.5.0.100/32"), specs[0].Address)
+	assert.Equal(t, nethelpers.FamilyInet4, specs[0].Family)
+
+	assert.Equal(t, netip.MustParsePrefix("fd00::100/128"), specs[1].Address)
+	assert.Equal(t, nethelpers.FamilyInet6, specs[1].Family)
+
+	for _, spec := range specs {
+		assert.True(t, spec.AnnounceWithARP, spec.Address)
+	}
+}
@@ -7,16 +7,20 @@ package network
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/slices"
+	"github.com/siderolabs/go-pointer"
 	"github.com/talos-systems/go-procfs/procfs"
 	"go.uber.org/zap"
 
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
 )
 
@@ -33,6 +37,12 @@ func (ctrl *OperatorConfigController) Name() string {
 // Inputs implements controller.Controller interface.
 func (ctrl *OperatorConfigController) Inputs() []controller.Input {
 	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: network.NamespaceName,
 			Type:      network.DeviceConfigSpecType,
@@ -74,6 +84,17 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 
 		touchedIDs := make(map[resource.ID]struct{})
 
+		var hostname string
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			hostname = cfg.(*config.MachineConfig).Config().Machine().Network().Hostname()
+		}
+
 		items, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.DeviceConfigSpecType, "", resource.VersionUndefined))
 		if err != nil {
 			if !state.IsNotFoundError(err) {
@@ -117,23 +138,18 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 				}
 
 				if device.DHCP() && device.DHCPOptions().IPv4() {
-					routeMetric := device.DHCPOptions().RouteMetric()
-					if routeMetric == 0 {
-						routeMetric = DefaultRouteMetric
-					}
-
 					specs = append(specs, network.OperatorSpecSpec{
-						Operator:  network.OperatorDHCP4,
-						LinkName:  device.Interface(),
-						RequireUp: true,
-						DHCP4: network.DHCP4OperatorSpec{
-							RouteMetric: routeMetric,
-						},
+						Operator:    network.OperatorDHCP4,
+						LinkName:    device.Interface(),
+						RequireUp:   true,
+						DHCP4:       dhcp4OperatorSpec(device.DHCPOptions(), hostname),
 						ConfigLayer: network.ConfigMachineConfiguration,
 					})
 				}
 
-				if device.DHCP() && device.DHCPOptions().IPv6() {
+				dhcp6Mode := device.IPv6() != nil && device.IPv6().Mode() == nethelpers.IPv6ModeDHCP6.String()
+
+				if (device.DHCP() && device.DHCPOptions().IPv6()) || dhcp6Mode {
 					routeMetric := device.DHCPOptions().RouteMetric()
 					if routeMetric == 0 {
 						routeMetric = DefaultRouteMetric
@@ -153,18 +169,11 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 
 				for _, vlan := range device.Vlans() {
 					if vlan.DHCP() && vlan.DHCPOptions().IPv4() {
-						routeMetric := vlan.DHCPOptions().RouteMetric()
-						if routeMetric == 0 {
-							routeMetric = DefaultRouteMetric
-						}
-
 						specs = append(specs, network.OperatorSpecSpec{
-							Operator:  network.OperatorDHCP4,
-							LinkName:  fmt.Sprintf("%s.%d", device.Interface(), vlan.ID()),
-							RequireUp: true,
-							DHCP4: network.DHCP4OperatorSpec{
-								RouteMetric: routeMetric,
-							},
+							Operator:    network.OperatorDHCP4,
+							LinkName:    fmt.Sprintf("%s.%d", device.Interface(), vlan.ID()),
+							RequireUp:   true,
+							DHCP4:       dhcp4OperatorSpec(vlan.DHCPOptions(), hostname),
 							ConfigLayer: network.ConfigMachineConfiguration,
 						})
 					}
@@ -302,3 +311,28 @@ func (ctrl *OperatorConfigController) apply(ctx context.Context, r controller.Ru
 
 	return ids, nil
 }
+
+// dhcp4OperatorSpec builds DHCPv4 operator settings from the machine configuration.
+func dhcp4OperatorSpec(opts talosconfig.DHCPOptions, hostname string) network.DHCP4OperatorSpec {
+	routeMetric := opts.RouteMetric()
+	if routeMetric == 0 {
+		routeMetric = DefaultRouteMetric
+	}
+
+	spec := network.DHCP4OperatorSpec{
+		RouteMetric:      routeMetric,
+		ClientIdentifier: opts.ClientIdentifier(),
+		VendorClass:      opts.VendorClass(),
+		UserClasses:      opts.UserClasses(),
+		RequestedOptions: slices.Map(opts.RequestedOptions(), func(code int) uint32 { return uint32(code) }),
+	}
+
+	switch opts.SendHostname() {
+	case nethelpers.DHCPSendHostnameHostname.String():
+		spec.Hostname, _, _ = strings.Cut(hostname, ".")
+	case nethelpers.DHCPSendHostnameFQDN.String():
+		spec.Hostname = hostname
+	}
+
+	return spec
+}
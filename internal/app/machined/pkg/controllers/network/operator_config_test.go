@@ -274,6 +274,7 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP4() {
 			ConfigVersion: "v1alpha1",
 			MachineConfig: &v1alpha1.MachineConfig{
 				MachineNetwork: &v1alpha1.NetworkConfig{
+					NetworkHostname: "node1.example.com",
 					NetworkInterfaces: []*v1alpha1.Device{
 						{
 							DeviceInterface: "eth0",
@@ -291,8 +292,13 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP4() {
 							DeviceInterface: "eth3",
 							DeviceDHCP:      pointer.To(true),
 							DeviceDHCPOptions: &v1alpha1.DHCPOptions{
-								DHCPIPv4:        pointer.To(true),
-								DHCPRouteMetric: 256,
+								DHCPIPv4:             pointer.To(true),
+								DHCPRouteMetric:      256,
+								DHCPClientIdentifier: "01525400123456",
+								DHCPVendorClass:      "PXEClient",
+								DHCPUserClasses:      []string{"talos"},
+								DHCPRequestedOptions: []int{66, 67},
+								DHCPSendHostname:     "hostname",
 							},
 						},
 						{
@@ -350,7 +356,14 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP4() {
 							suite.Assert().EqualValues(netctrl.DefaultRouteMetric, r.TypedSpec().DHCP4.RouteMetric)
 						case "configuration/dhcp4/eth3":
 							suite.Assert().Equal("eth3", r.TypedSpec().LinkName)
-							suite.Assert().EqualValues(256, r.TypedSpec().DHCP4.RouteMetric)
+							suite.Assert().Equal(network.DHCP4OperatorSpec{
+								RouteMetric:      256,
+								ClientIdentifier: "01525400123456",
+								VendorClass:      "PXEClient",
+								UserClasses:      []string{"talos"},
+								RequestedOptions: []uint32{66, 67},
+								Hostname:         "node1",
+							}, r.TypedSpec().DHCP4)
 						case "configuration/dhcp4/eth4.25":
 							suite.Assert().Equal("eth4.25", r.TypedSpec().LinkName)
 							suite.Assert().EqualValues(netctrl.DefaultRouteMetric, r.TypedSpec().DHCP4.RouteMetric)
@@ -422,6 +435,12 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP6() {
 								DHCPRouteMetric: 512,
 							},
 						},
+						{
+							DeviceInterface: "eth4",
+							DeviceIPv6Config: &v1alpha1.DeviceIPv6Config{
+								IPv6Mode: "dhcp6",
+							},
+						},
 					},
 				},
 			},
@@ -444,6 +463,7 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP6() {
 					[]string{
 						"configuration/dhcp6/eth2",
 						"configuration/dhcp6/eth3",
+						"configuration/dhcp6/eth4",
 					}, func(r *network.OperatorSpec) error {
 						suite.Assert().Equal(network.OperatorDHCP6, r.TypedSpec().Operator)
 						suite.Assert().True(r.TypedSpec().RequireUp)
@@ -455,6 +475,9 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP6() {
 						case "configuration/dhcp6/eth3":
 							suite.Assert().Equal("eth3", r.TypedSpec().LinkName)
 							suite.Assert().EqualValues(512, r.TypedSpec().DHCP6.RouteMetric)
+						case "configuration/dhcp6/eth4":
+							suite.Assert().Equal("eth4", r.TypedSpec().LinkName)
+							suite.Assert().EqualValues(netctrl.DefaultRouteMetric, r.TypedSpec().DHCP6.RouteMetric)
 						}
 
 						return nil
@@ -7,6 +7,7 @@ package network
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 	"time"
 
@@ -232,7 +233,7 @@ func (ctrl *OperatorSpecController) reconcileOperators(ctx context.Context, r co
 			// stop operator
 			ctrl.operators[id].Stop()
 			delete(ctrl.operators, id)
-		} else if *shouldRun[id] != ctrl.operators[id].Spec {
+		} else if !reflect.DeepEqual(*shouldRun[id], ctrl.operators[id].Spec) {
 			logger.Debug("replacing operator", zap.String("operator", id))
 
 			// stop operator
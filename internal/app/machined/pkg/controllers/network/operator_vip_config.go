@@ -19,6 +19,7 @@ import (
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/operator/vip"
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
 )
 
@@ -230,9 +231,46 @@ func handleVIP(ctx context.Context, vlanConfig talosconfig.VIPConfig, deviceName
 		if err = vip.GetNetworkAndDeviceIDs(ctx, &spec.VIP.HCloud, sharedIP); err != nil {
 			return network.OperatorSpecSpec{}, err
 		}
+	// BGP-announced VIP
+	case vlanConfig.BGP() != nil:
+		spec.VIP.GratuitousARP = false
+
+		if spec.VIP.BGP, err = vipBGPSpec(vlanConfig.BGP()); err != nil {
+			return network.OperatorSpecSpec{}, err
+		}
 	// Regular layer 2 VIP
 	default:
 	}
 
 	return spec, nil
 }
+
+func vipBGPSpec(bgpConfig talosconfig.VIPBGP) (network.VIPBGPSpec, error) {
+	spec := network.VIPBGPSpec{
+		LocalASN: bgpConfig.LocalASN(),
+		HoldTime: bgpConfig.HoldTime(),
+	}
+
+	if bgpConfig.RouterID() != "" {
+		routerID, err := netip.ParseAddr(bgpConfig.RouterID())
+		if err != nil {
+			return spec, fmt.Errorf("error parsing BGP router ID: %w", err)
+		}
+
+		spec.RouterID = routerID
+	}
+
+	for _, peer := range bgpConfig.Peers() {
+		addr, err := nethelpers.ParseBGPPeerAddress(peer.Address())
+		if err != nil {
+			return spec, fmt.Errorf("error parsing BGP peer address: %w", err)
+		}
+
+		spec.Peers = append(spec.Peers, network.VIPBGPPeerSpec{
+			Address: addr,
+			ASN:     peer.ASN(),
+		})
+	}
+
+	return spec, nil
+}
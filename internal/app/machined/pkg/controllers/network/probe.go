@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/internal/probe"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// ProbeController runs network probes configured with ProbeSpecs and publishes results as ProbeStatuses.
+type ProbeController struct {
+	runners map[string]*probe.Runner
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ProbeController) Name() string {
+	return "network.ProbeController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ProbeController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ProbeSpecType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ProbeController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.ProbeStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ProbeController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	notifyCh := make(chan probe.Notification)
+
+	ctrl.runners = make(map[string]*probe.Runner)
+
+	defer func() {
+		for _, runner := range ctrl.runners {
+			runner.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			if err := ctrl.reconcileRunners(ctx, r, notifyCh, logger); err != nil {
+				return err
+			}
+		case notification := <-notifyCh:
+			if _, ok := ctrl.runners[notification.ID]; !ok {
+				// probe was already stopped
+				continue
+			}
+
+			if err := r.Modify(ctx, network.NewProbeStatus(network.NamespaceName, notification.ID), func(r resource.Resource) error {
+				*r.(*network.ProbeStatus).TypedSpec() = notification.Status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying probe status: %w", err)
+			}
+		}
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *ProbeController) reconcileRunners(ctx context.Context, r controller.Runtime, notifyCh chan<- probe.Notification, logger *zap.Logger) error {
+	specList, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.ProbeSpecType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing probe specs: %w", err)
+	}
+
+	shouldRun := make(map[string]network.ProbeSpecSpec, len(specList.Items))
+
+	for _, res := range specList.Items {
+		shouldRun[res.Metadata().ID()] = *res.(*network.ProbeSpec).TypedSpec()
+	}
+
+	// stop runners which are no longer needed or which spec has changed
+	for id, runner := range ctrl.runners {
+		spec, exists := shouldRun[id]
+
+		if exists && spec == runner.Spec {
+			continue
+		}
+
+		runner.Stop()
+
+		delete(ctrl.runners, id)
+	}
+
+	// start missing runners
+	for id, spec := range shouldRun {
+		if _, running := ctrl.runners[id]; running {
+			continue
+		}
+
+		runner := &probe.Runner{
+			ID:   id,
+			Spec: spec,
+		}
+
+		runner.Start(ctx, notifyCh, logger)
+
+		ctrl.runners[id] = runner
+	}
+
+	// clean up statuses of probes which are not running
+	statusList, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.ProbeStatusType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing probe statuses: %w", err)
+	}
+
+	for _, res := range statusList.Items {
+		if _, running := ctrl.runners[res.Metadata().ID()]; running {
+			continue
+		}
+
+		if err = r.Destroy(ctx, res.Metadata()); err != nil {
+			return fmt.Errorf("error destroying probe status: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// ProbeConfigController manages network.ProbeSpec based on machine configuration.
+type ProbeConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Name() string {
+	return "network.ProbeConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.ProbeSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			for _, probe := range cfg.(*config.MachineConfig).Config().Machine().Network().Probes() {
+				spec := ctrl.parseProbe(probe)
+
+				if err = r.Modify(ctx, network.NewProbeSpec(network.NamespaceName, probe.Name()), func(r resource.Resource) error {
+					*r.(*network.ProbeSpec).TypedSpec() = spec
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error modifying probe spec: %w", err)
+				}
+
+				touchedIDs[probe.Name()] = struct{}{}
+			}
+		}
+
+		// list specs for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.ProbeSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up specs: %w", err)
+				}
+			}
+		}
+	}
+}
+
+func (ctrl *ProbeConfigController) parseProbe(probe talosconfig.NetworkProbe) network.ProbeSpecSpec {
+	spec := network.ProbeSpecSpec{
+		Interval:         probe.Interval(),
+		FailureThreshold: probe.FailureThreshold(),
+		ConfigLayer:      network.ConfigMachineConfiguration,
+	}
+
+	if tcp := probe.TCP(); tcp != nil {
+		spec.TCP = network.TCPProbeSpec{
+			Endpoint: tcp.Endpoint(),
+			Timeout:  tcp.Timeout(),
+		}
+	}
+
+	if icmp := probe.ICMP(); icmp != nil {
+		spec.ICMP = network.ICMPProbeSpec{
+			Host:    icmp.Host(),
+			Timeout: icmp.Timeout(),
+		}
+	}
+
+	if http := probe.HTTP(); http != nil {
+		spec.HTTP = network.HTTPProbeSpec{
+			URL:     http.URL(),
+			Timeout: http.Timeout(),
+		}
+	}
+
+	return spec
+}
@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type ProbeSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *ProbeSuite) TestReconcile() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+
+	defer listener.Close() //nolint:errcheck
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			conn.Close() //nolint:errcheck
+		}
+	}()
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkProbes: []*v1alpha1.NetworkProbe{
+					{
+						ProbeName:     "up",
+						ProbeInterval: 100 * time.Millisecond,
+						ProbeTCP: &v1alpha1.NetworkProbeTCP{
+							TCPEndpoint: listener.Addr().String(),
+						},
+					},
+					{
+						ProbeName:             "down",
+						ProbeInterval:         100 * time.Millisecond,
+						ProbeFailureThreshold: 1,
+						ProbeTCP: &v1alpha1.NetworkProbeTCP{
+							TCPEndpoint: "127.0.0.1:1",
+							TCPTimeout:  time.Second,
+						},
+					},
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		spec, err := ctest.Get[*network.ProbeSpec](suite, resource.NewMetadata(network.NamespaceName, network.ProbeSpecType, "up", resource.VersionUndefined))
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(network.ProbeSpecSpec{
+			Interval:         100 * time.Millisecond,
+			FailureThreshold: 3,
+			TCP: network.TCPProbeSpec{
+				Endpoint: listener.Addr().String(),
+				Timeout:  5 * time.Second,
+			},
+			ConfigLayer: network.ConfigMachineConfiguration,
+		}, *spec.TypedSpec())
+
+		upStatus, err := ctest.Get[*network.ProbeStatus](suite, resource.NewMetadata(network.NamespaceName, network.ProbeStatusType, "up", resource.VersionUndefined))
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(network.ProbeStatusSpec{Success: true}, *upStatus.TypedSpec())
+
+		downStatus, err := ctest.Get[*network.ProbeStatus](suite, resource.NewMetadata(network.NamespaceName, network.ProbeStatusType, "down", resource.VersionUndefined))
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.False(downStatus.TypedSpec().Success)
+		assert.Contains(downStatus.TypedSpec().LastError, "connection refused")
+	}))
+
+	// remove the probes from the config, statuses should be cleaned up
+	cfg.Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkProbes = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		for _, id := range []string{"up", "down"} {
+			_, err := ctest.Get[*network.ProbeSpec](suite, resource.NewMetadata(network.NamespaceName, network.ProbeSpecType, id, resource.VersionUndefined))
+			assert.True(state.IsNotFoundError(err))
+
+			_, err = ctest.Get[*network.ProbeStatus](suite, resource.NewMetadata(network.NamespaceName, network.ProbeStatusType, id, resource.VersionUndefined))
+			assert.True(state.IsNotFoundError(err))
+		}
+	}))
+}
+
+func TestProbeSuite(t *testing.T) {
+	suite.Run(t, &ProbeSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.ProbeConfigController{}))
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.ProbeController{}))
+			},
+		},
+	})
+}
@@ -84,6 +84,7 @@ func (ctrl *ResolverMergeController) Run(ctx context.Context, r controller.Runti
 			if spec.TypedSpec().ConfigLayer == final.ConfigLayer {
 				// merge server lists on the same level
 				final.DNSServers = append(final.DNSServers, spec.TypedSpec().DNSServers...)
+				final.SearchDomains = append(final.SearchDomains, spec.TypedSpec().SearchDomains...)
 			} else {
 				// otherwise, replace the lists
 				final = *spec.TypedSpec()
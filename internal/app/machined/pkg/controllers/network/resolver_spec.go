@@ -96,6 +96,7 @@ func (ctrl *ResolverSpecController) Run(ctx context.Context, r controller.Runtim
 					status := r.(*network.ResolverStatus) //nolint:forcetypeassert,errcheck
 
 					status.TypedSpec().DNSServers = spec.TypedSpec().DNSServers
+					status.TypedSpec().SearchDomains = spec.TypedSpec().SearchDomains
 
 					return nil
 				}); err != nil {
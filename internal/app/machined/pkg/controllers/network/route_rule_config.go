@@ -0,0 +1,179 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// RouteRuleConfigController manages network.RouteRuleSpec based on machine configuration.
+type RouteRuleConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Name() string {
+	return "network.RouteRuleConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RouteRuleSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			for _, rule := range cfg.(*config.MachineConfig).Config().Machine().Network().Rules() {
+				specs, err := ctrl.parseRule(rule)
+				if err != nil {
+					logger.Info("skipping routing rule", zap.Uint32("priority", rule.Priority()), zap.Error(err))
+
+					continue
+				}
+
+				for _, spec := range specs {
+					spec := spec
+					id := network.LayeredID(spec.ConfigLayer, network.RouteRuleID(spec.Family, spec.Priority))
+
+					if err = r.Modify(ctx, network.NewRouteRuleSpec(network.ConfigNamespaceName, id), func(r resource.Resource) error {
+						*r.(*network.RouteRuleSpec).TypedSpec() = spec
+
+						return nil
+					}); err != nil {
+						return fmt.Errorf("error modifying routing rule spec: %w", err)
+					}
+
+					touchedIDs[id] = struct{}{}
+				}
+			}
+		}
+
+		// list specs for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.RouteRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up routing rules: %w", err)
+				}
+			}
+		}
+	}
+}
+
+// parseRule converts machine configuration rule into specs.
+//
+// If the rule doesn't match on the source or destination prefix, it is installed for both address families.
+func (ctrl *RouteRuleConfigController) parseRule(rule talosconfig.NetworkRule) ([]network.RouteRuleSpecSpec, error) {
+	spec := network.RouteRuleSpecSpec{
+		Table:       nethelpers.RoutingTable(rule.Table()),
+		Priority:    rule.Priority(),
+		FwMark:      rule.FwMark(),
+		FwMask:      rule.FwMask(),
+		IIFName:     rule.IIFName(),
+		OIFName:     rule.OIFName(),
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	if spec.FwMark != 0 && spec.FwMask == 0 {
+		// kernel default is to match all bits of the mark
+		spec.FwMask = 0xffffffff
+	}
+
+	var err error
+
+	if rule.From() != "" {
+		if spec.Source, err = netip.ParsePrefix(rule.From()); err != nil {
+			return nil, fmt.Errorf("error parsing source prefix: %w", err)
+		}
+	}
+
+	if rule.To() != "" {
+		if spec.Destination, err = netip.ParsePrefix(rule.To()); err != nil {
+			return nil, fmt.Errorf("error parsing destination prefix: %w", err)
+		}
+	}
+
+	var families []nethelpers.Family
+
+	switch {
+	case spec.Source.IsValid():
+		families = []nethelpers.Family{familyOf(spec.Source.Addr())}
+	case spec.Destination.IsValid():
+		families = []nethelpers.Family{familyOf(spec.Destination.Addr())}
+	default:
+		families = []nethelpers.Family{nethelpers.FamilyInet4, nethelpers.FamilyInet6}
+	}
+
+	specs := make([]network.RouteRuleSpecSpec, 0, len(families))
+
+	for _, family := range families {
+		spec.Family = family
+
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
+
+func familyOf(addr netip.Addr) nethelpers.Family {
+	if addr.Is4() {
+		return nethelpers.FamilyInet4
+	}
+
+	return nethelpers.FamilyInet6
+}
@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type RouteRuleConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RouteRuleConfigSuite) TestMachineConfiguration() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkRules: []*v1alpha1.NetworkRule{
+					{
+						RuleFrom:     "10.6.0.0/24",
+						RuleTable:    100,
+						RulePriority: 1000,
+					},
+					{
+						RuleFwMark:   0x100,
+						RuleIIFName:  "eth1",
+						RuleTable:    200,
+						RulePriority: 1100,
+					},
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		for id, expected := range map[string]network.RouteRuleSpecSpec{
+			"configuration/inet4/1000": {
+				Family:      nethelpers.FamilyInet4,
+				Source:      netip.MustParsePrefix("10.6.0.0/24"),
+				Table:       100,
+				Priority:    1000,
+				Protocol:    nethelpers.ProtocolStatic,
+				ConfigLayer: network.ConfigMachineConfiguration,
+			},
+			"configuration/inet4/1100": {
+				Family:      nethelpers.FamilyInet4,
+				Table:       200,
+				Priority:    1100,
+				FwMark:      0x100,
+				FwMask:      0xffffffff,
+				IIFName:     "eth1",
+				Protocol:    nethelpers.ProtocolStatic,
+				ConfigLayer: network.ConfigMachineConfiguration,
+			},
+			"configuration/inet6/1100": {
+				Family:      nethelpers.FamilyInet6,
+				Table:       200,
+				Priority:    1100,
+				FwMark:      0x100,
+				FwMask:      0xffffffff,
+				IIFName:     "eth1",
+				Protocol:    nethelpers.ProtocolStatic,
+				ConfigLayer: network.ConfigMachineConfiguration,
+			},
+		} {
+			spec, err := ctest.Get[*network.RouteRuleSpec](suite, resource.NewMetadata(network.ConfigNamespaceName, network.RouteRuleSpecType, id, resource.VersionUndefined))
+			if err != nil {
+				assert.NoError(err)
+
+				continue
+			}
+
+			assert.Equal(expected, *spec.TypedSpec())
+		}
+	}))
+
+	// remove the rules from the config, specs should be cleaned up
+	cfg.Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkRules = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		for _, id := range []string{"configuration/inet4/1000", "configuration/inet4/1100", "configuration/inet6/1100"} {
+			_, err := ctest.Get[*network.RouteRuleSpec](suite, resource.NewMetadata(network.ConfigNamespaceName, network.RouteRuleSpecType, id, resource.VersionUndefined))
+			assert.True(state.IsNotFoundError(err))
+		}
+	}))
+}
+
+func TestRouteRuleConfigSuite(t *testing.T) {
+	suite.Run(t, &RouteRuleConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RouteRuleConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type RouteRuleMergeSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RouteRuleMergeSuite) TestMerge() {
+	platform := network.NewRouteRuleSpec(network.ConfigNamespaceName, "platform/inet4/1000")
+	*platform.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.5.0.0/24"),
+		Table:       100,
+		Priority:    1000,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigPlatform,
+	}
+
+	static := network.NewRouteRuleSpec(network.ConfigNamespaceName, "configuration/inet4/1000")
+	*static.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.6.0.0/24"),
+		Table:       200,
+		Priority:    1000,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	static6 := network.NewRouteRuleSpec(network.ConfigNamespaceName, "configuration/inet6/1000")
+	*static6.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet6,
+		FwMark:      0x100,
+		FwMask:      0xffffffff,
+		Table:       200,
+		Priority:    1000,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	for _, res := range []resource.Resource{platform, static, static6} {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), res), "%v", res.Spec())
+	}
+
+	suite.assertRule("inet4/1000", *static.TypedSpec())
+	suite.assertRule("inet6/1000", *static6.TypedSpec())
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), static.Metadata()))
+
+	suite.assertRule("inet4/1000", *platform.TypedSpec())
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), platform.Metadata()))
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), static6.Metadata()))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		for _, id := range []string{"inet4/1000", "inet6/1000"} {
+			_, err := ctest.Get[*network.RouteRuleSpec](suite, resource.NewMetadata(network.NamespaceName, network.RouteRuleSpecType, id, resource.VersionUndefined))
+			assert.True(state.IsNotFoundError(err))
+		}
+	}))
+}
+
+func (suite *RouteRuleMergeSuite) assertRule(id string, expected network.RouteRuleSpecSpec) {
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		rule, err := ctest.Get[*network.RouteRuleSpec](suite, resource.NewMetadata(network.NamespaceName, network.RouteRuleSpecType, id, resource.VersionUndefined))
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(resource.PhaseRunning, rule.Metadata().Phase())
+		assert.Equal(expected, *rule.TypedSpec())
+	}))
+}
+
+func TestRouteRuleMergeSuite(t *testing.T) {
+	suite.Run(t, &RouteRuleMergeSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RouteRuleMergeController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,260 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/hashicorp/go-multierror"
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// RouteRuleSpecController applies network.RouteRuleSpec to the kernel routing policy database.
+type RouteRuleSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Name() string {
+	return "network.RouteRuleSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.RouteRuleSpecType,
+			Kind:      controller.InputStrong,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// watch rule changes to restore the rules removed outside of the controller
+	watcher, err := watch.NewRtNetlink(r, unix.RTMGRP_IPV4_RULE|rtmgrpIPv6Rule)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list source network configuration resources
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.RouteRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing source routing rules: %w", err)
+		}
+
+		// add finalizers for all live resources
+		for _, res := range list.Items {
+			if res.Metadata().Phase() != resource.PhaseRunning {
+				continue
+			}
+
+			if err = r.AddFinalizer(ctx, res.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer: %w", err)
+			}
+		}
+
+		// list rtnetlink rules
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing routing rules: %w", err)
+		}
+
+		var multiErr *multierror.Error
+
+		// loop over rules and make reconcile decision
+		for _, res := range list.Items {
+			rule := res.(*network.RouteRuleSpec) //nolint:forcetypeassert,errcheck
+
+			if err = ctrl.syncRule(ctx, r, logger, conn, rules, rule); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+	}
+}
+
+// findRules returns the kernel rules which might have been created for the spec.
+//
+// Rules are matched by family, priority and protocol, so that the rules created outside of the controller
+// (e.g. by KubeSpan) are never touched.
+func findRules(rules []rtnetlink.RuleMessage, family nethelpers.Family, priority uint32, protocol nethelpers.RouteProtocol) []*rtnetlink.RuleMessage {
+	var result []*rtnetlink.RuleMessage //nolint:prealloc
+
+	for i, rule := range rules {
+		if rule.Family != uint8(family) {
+			continue
+		}
+
+		if rule.Attributes == nil || rule.Attributes.Priority == nil || *rule.Attributes.Priority != priority {
+			continue
+		}
+
+		if rule.Attributes.Protocol == nil || nethelpers.RouteProtocol(*rule.Attributes.Protocol) != protocol {
+			continue
+		}
+
+		result = append(result, &rules[i])
+	}
+
+	return result
+}
+
+// ruleMatches checks whether the kernel rule matches the spec.
+func ruleMatches(existing *rtnetlink.RuleMessage, spec *network.RouteRuleSpecSpec) bool {
+	status := ruleMessageToStatus(existing)
+
+	return status.Source == spec.Source &&
+		status.Destination == spec.Destination &&
+		status.Table == spec.Table &&
+		status.FwMark == spec.FwMark &&
+		status.FwMask == spec.FwMask &&
+		status.IIFName == spec.IIFName &&
+		status.OIFName == spec.OIFName
+}
+
+func ruleMessageFromSpec(spec *network.RouteRuleSpecSpec) *rtnetlink.RuleMessage {
+	msg := &rtnetlink.RuleMessage{
+		Family: uint8(spec.Family),
+		Action: unix.FR_ACT_TO_TBL,
+		Attributes: &rtnetlink.RuleAttributes{
+			Priority: pointer.To(spec.Priority),
+			Table:    pointer.To(uint32(spec.Table)),
+			Protocol: pointer.To(uint8(spec.Protocol)),
+		},
+	}
+
+	if spec.Table < 256 {
+		msg.Table = uint8(spec.Table)
+	}
+
+	if spec.Source.IsValid() {
+		msg.SrcLength = uint8(spec.Source.Bits())
+		msg.Attributes.Src = pointer.To(net.IP(spec.Source.Addr().AsSlice()))
+	}
+
+	if spec.Destination.IsValid() {
+		msg.DstLength = uint8(spec.Destination.Bits())
+		msg.Attributes.Dst = pointer.To(net.IP(spec.Destination.Addr().AsSlice()))
+	}
+
+	if spec.FwMark != 0 {
+		msg.Attributes.FwMark = pointer.To(spec.FwMark)
+		msg.Attributes.FwMask = pointer.To(spec.FwMask)
+	}
+
+	if spec.IIFName != "" {
+		msg.Attributes.IIFName = pointer.To(spec.IIFName)
+	}
+
+	if spec.OIFName != "" {
+		msg.Attributes.OIFName = pointer.To(spec.OIFName)
+	}
+
+	return msg
+}
+
+func (ctrl *RouteRuleSpecController) syncRule(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn,
+	rules []rtnetlink.RuleMessage, rule *network.RouteRuleSpec,
+) error {
+	spec := rule.TypedSpec()
+
+	switch rule.Metadata().Phase() {
+	case resource.PhaseTearingDown:
+		for _, existing := range findRules(rules, spec.Family, spec.Priority, spec.Protocol) {
+			if err := conn.Rule.Delete(existing); err != nil {
+				return fmt.Errorf("error removing routing rule: %w", err)
+			}
+
+			logger.Info("deleted routing rule",
+				zap.Stringer("family", spec.Family),
+				zap.Uint32("priority", spec.Priority),
+				zap.Stringer("table", spec.Table),
+			)
+		}
+
+		// now remove finalizer as rule was deleted
+		if err := r.RemoveFinalizer(ctx, rule.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error removing finalizer: %w", err)
+		}
+	case resource.PhaseRunning:
+		matchFound := false
+
+		for _, existing := range findRules(rules, spec.Family, spec.Priority, spec.Protocol) {
+			if !matchFound && ruleMatches(existing, spec) {
+				matchFound = true
+
+				continue
+			}
+
+			// delete the rule, it doesn't match the spec
+			if err := conn.Rule.Delete(existing); err != nil {
+				return fmt.Errorf("error removing routing rule: %w", err)
+			}
+
+			logger.Debug("removed routing rule due to mismatch",
+				zap.Stringer("family", spec.Family),
+				zap.Uint32("priority", spec.Priority),
+			)
+		}
+
+		if matchFound {
+			return nil
+		}
+
+		msg := ruleMessageFromSpec(spec)
+
+		if err := conn.Rule.Add(msg); err != nil {
+			return fmt.Errorf("error adding routing rule: %w, message %+v", err, *msg)
+		}
+
+		logger.Info("created routing rule",
+			zap.Stringer("family", spec.Family),
+			zap.Uint32("priority", spec.Priority),
+			zap.Stringer("table", spec.Table),
+			zap.Stringer("src", spec.Source),
+			zap.Stringer("dst", spec.Destination),
+			zap.Uint32("fwmark", spec.FwMark),
+			zap.String("iif", spec.IIFName),
+			zap.String("oif", spec.OIFName),
+		)
+	}
+
+	return nil
+}
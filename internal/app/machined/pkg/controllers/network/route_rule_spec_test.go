@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type RouteRuleSpecSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RouteRuleSpecSuite) TestRule() {
+	rule := network.NewRouteRuleSpec(network.NamespaceName, "inet4/31001")
+	*rule.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.150.0.0/24"),
+		Table:       1001,
+		Priority:    31001,
+		FwMark:      0x100,
+		FwMask:      0xff00,
+		IIFName:     "dummy-rule",
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), rule))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		status, err := ctest.Get[*network.RouteRuleStatus](suite, resource.NewMetadata(network.NamespaceName, network.RouteRuleStatusType, "inet4/31001", resource.VersionUndefined))
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(network.RouteRuleStatusSpec{
+			Family:   nethelpers.FamilyInet4,
+			Source:   netip.MustParsePrefix("10.150.0.0/24"),
+			Table:    1001,
+			Priority: 31001,
+			FwMark:   0x100,
+			FwMask:   0xff00,
+			IIFName:  "dummy-rule",
+			Protocol: nethelpers.ProtocolStatic,
+		}, *status.TypedSpec())
+	}))
+
+	// update the rule, it should be replaced
+	ctest.UpdateWithConflicts(suite, rule, func(r *network.RouteRuleSpec) error {
+		r.TypedSpec().Table = 1002
+
+		return nil
+	})
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		status, err := ctest.Get[*network.RouteRuleStatus](suite, resource.NewMetadata(network.NamespaceName, network.RouteRuleStatusType, "inet4/31001", resource.VersionUndefined))
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(nethelpers.RoutingTable(1002), status.TypedSpec().Table)
+	}))
+
+	// teardown the rule
+	ready, err := suite.State().Teardown(suite.Ctx(), rule.Metadata())
+	suite.Require().NoError(err)
+	suite.Assert().False(ready)
+
+	_, err = suite.State().WatchFor(suite.Ctx(), rule.Metadata(), state.WithFinalizerEmpty())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), rule.Metadata()))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		_, err := ctest.Get[*network.RouteRuleStatus](suite, resource.NewMetadata(network.NamespaceName, network.RouteRuleStatusType, "inet4/31001", resource.VersionUndefined))
+		assert.True(state.IsNotFoundError(err))
+	}))
+}
+
+func TestRouteRuleSpecSuite(t *testing.T) {
+	suite.Run(t, &RouteRuleSpecSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RouteRuleSpecController{}))
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RouteRuleStatusController{}))
+			},
+		},
+	})
+}
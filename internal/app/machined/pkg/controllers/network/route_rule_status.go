@@ -0,0 +1,165 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/jsimonetti/rtnetlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// rtmgrpIPv6Rule is the legacy multicast group bit for RTNLGRP_IPV6_RULE (x/sys/unix doesn't define it).
+const rtmgrpIPv6Rule = 1 << (unix.RTNLGRP_IPV6_RULE - 1)
+
+// RouteRuleStatusController manages network.RouteRuleStatus based on the kernel routing policy rules.
+type RouteRuleStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Name() string {
+	return "network.RouteRuleStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RouteRuleStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	watcher, err := watch.NewRtNetlink(r, unix.RTMGRP_IPV4_RULE|rtmgrpIPv6Rule)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list resources for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.RouteRuleStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		itemsToDelete := map[resource.ID]struct{}{}
+
+		for _, r := range list.Items {
+			itemsToDelete[r.Metadata().ID()] = struct{}{}
+		}
+
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing routing rules: %w", err)
+		}
+
+		for _, rule := range rules {
+			rule := rule
+			spec := ruleMessageToStatus(&rule)
+			id := network.RouteRuleID(spec.Family, spec.Priority)
+
+			if err = r.Modify(ctx, network.NewRouteRuleStatus(network.NamespaceName, id), func(r resource.Resource) error {
+				*r.(*network.RouteRuleStatus).TypedSpec() = spec
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
+
+			delete(itemsToDelete, id)
+		}
+
+		for id := range itemsToDelete {
+			if err = r.Destroy(ctx, resource.NewMetadata(network.NamespaceName, network.RouteRuleStatusType, id, resource.VersionUndefined)); err != nil {
+				return fmt.Errorf("error deleting routing rule status %q: %w", id, err)
+			}
+		}
+	}
+}
+
+//nolint:gocyclo
+func ruleMessageToStatus(rule *rtnetlink.RuleMessage) network.RouteRuleStatusSpec {
+	spec := network.RouteRuleStatusSpec{
+		Family: nethelpers.Family(rule.Family),
+		Table:  nethelpers.RoutingTable(rule.Table),
+	}
+
+	if rule.Attributes == nil {
+		return spec
+	}
+
+	if rule.Attributes.Src != nil {
+		if addr, ok := netip.AddrFromSlice(*rule.Attributes.Src); ok {
+			spec.Source = netip.PrefixFrom(addr.Unmap(), int(rule.SrcLength))
+		}
+	}
+
+	if rule.Attributes.Dst != nil {
+		if addr, ok := netip.AddrFromSlice(*rule.Attributes.Dst); ok {
+			spec.Destination = netip.PrefixFrom(addr.Unmap(), int(rule.DstLength))
+		}
+	}
+
+	if rule.Attributes.Table != nil {
+		spec.Table = nethelpers.RoutingTable(*rule.Attributes.Table)
+	}
+
+	if rule.Attributes.Priority != nil {
+		spec.Priority = *rule.Attributes.Priority
+	}
+
+	if rule.Attributes.FwMark != nil {
+		spec.FwMark = *rule.Attributes.FwMark
+	}
+
+	if rule.Attributes.FwMask != nil {
+		spec.FwMask = *rule.Attributes.FwMask
+	}
+
+	if rule.Attributes.IIFName != nil {
+		spec.IIFName = *rule.Attributes.IIFName
+	}
+
+	if rule.Attributes.OIFName != nil {
+		spec.OIFName = *rule.Attributes.OIFName
+	}
+
+	if rule.Attributes.Protocol != nil {
+		spec.Protocol = nethelpers.RouteProtocol(*rule.Attributes.Protocol)
+	}
+
+	return spec
+}
@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type RouteRuleStatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RouteRuleStatusSuite) TestDefaultRules() {
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		// default kernel rule: 32766: from all lookup main
+		rule, err := ctest.Get[*network.RouteRuleStatus](suite, resource.NewMetadata(network.NamespaceName, network.RouteRuleStatusType, "inet4/32766", resource.VersionUndefined))
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		assert.Equal(nethelpers.FamilyInet4, rule.TypedSpec().Family)
+		assert.Equal(nethelpers.TableMain, rule.TypedSpec().Table)
+		assert.False(rule.TypedSpec().Source.IsValid())
+		assert.False(rule.TypedSpec().Destination.IsValid())
+	}))
+}
+
+func TestRouteRuleStatusSuite(t *testing.T) {
+	suite.Run(t, &RouteRuleStatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RouteRuleStatusController{}))
+			},
+		},
+	})
+}
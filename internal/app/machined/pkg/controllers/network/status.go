@@ -51,6 +51,16 @@ func (ctrl *StatusController) Inputs() []controller.Input {
 			Type:      files.EtcFileStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ProbeSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ProbeStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -127,6 +137,12 @@ func (ctrl *StatusController) Run(ctx context.Context, r controller.Runtime, log
 			}
 		}
 
+		// probes
+		result.ProbesReady, err = ctrl.probesReady(ctx, r)
+		if err != nil {
+			return err
+		}
+
 		// update output status
 		if err = r.Modify(ctx, network.NewStatus(network.NamespaceName, network.StatusID),
 			func(r resource.Resource) error {
@@ -138,3 +154,28 @@ func (ctrl *StatusController) Run(ctx context.Context, r controller.Runtime, log
 		}
 	}
 }
+
+// probesReady returns true if every defined probe reports success.
+func (ctrl *StatusController) probesReady(ctx context.Context, r controller.Runtime) (bool, error) {
+	specs, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.ProbeSpecType, "", resource.VersionUndefined))
+	if err != nil {
+		return false, fmt.Errorf("error listing probe specs: %w", err)
+	}
+
+	for _, spec := range specs.Items {
+		status, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.ProbeStatusType, spec.Metadata().ID(), resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("error getting probe status: %w", err)
+		}
+
+		if !status.(*network.ProbeStatus).TypedSpec().Success {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -88,7 +88,7 @@ func (suite *StatusSuite) TestNone() {
 	suite.Assert().NoError(
 		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
 			func() error {
-				return suite.assertStatus(network.StatusSpec{})
+				return suite.assertStatus(network.StatusSpec{ProbesReady: true})
 			},
 		),
 	)
@@ -103,7 +103,7 @@ func (suite *StatusSuite) TestAddresses() {
 	suite.Assert().NoError(
 		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
 			func() error {
-				return suite.assertStatus(network.StatusSpec{AddressReady: true})
+				return suite.assertStatus(network.StatusSpec{AddressReady: true, ProbesReady: true})
 			},
 		),
 	)
@@ -118,7 +118,7 @@ func (suite *StatusSuite) TestRoutes() {
 	suite.Assert().NoError(
 		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
 			func() error {
-				return suite.assertStatus(network.StatusSpec{ConnectivityReady: true})
+				return suite.assertStatus(network.StatusSpec{ConnectivityReady: true, ProbesReady: true})
 			},
 		),
 	)
@@ -133,7 +133,7 @@ func (suite *StatusSuite) TestHostname() {
 	suite.Assert().NoError(
 		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
 			func() error {
-				return suite.assertStatus(network.StatusSpec{HostnameReady: true})
+				return suite.assertStatus(network.StatusSpec{HostnameReady: true, ProbesReady: true})
 			},
 		),
 	)
@@ -149,7 +149,35 @@ func (suite *StatusSuite) TestEtcFiles() {
 	suite.Assert().NoError(
 		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
 			func() error {
-				return suite.assertStatus(network.StatusSpec{EtcFilesReady: true})
+				return suite.assertStatus(network.StatusSpec{EtcFilesReady: true, ProbesReady: true})
+			},
+		),
+	)
+}
+
+func (suite *StatusSuite) TestProbes() {
+	probeSpec := network.NewProbeSpec(network.NamespaceName, "uplink")
+	probeSpec.TypedSpec().TCP.Endpoint = "10.0.0.1:443"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, probeSpec))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertStatus(network.StatusSpec{})
+			},
+		),
+	)
+
+	probeStatus := network.NewProbeStatus(network.NamespaceName, "uplink")
+	probeStatus.TypedSpec().Success = true
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, probeStatus))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertStatus(network.StatusSpec{ProbesReady: true})
 			},
 		),
 	)
@@ -0,0 +1,133 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	humanize "github.com/dustin/go-humanize"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+)
+
+// ImageGCController prunes the images in the system containerd namespace according to the machine config policy.
+//
+// Images used by the containers, configured via the machine config and used for the current and previous install are kept.
+type ImageGCController struct {
+	// InstallImageRefs are the references of the installer images for the current and previous install.
+	InstallImageRefs []string
+
+	// GarbageCollect finds and prunes unreferenced images, defaults to image.GarbageCollectSystem.
+	GarbageCollect func(ctx context.Context, referenced []string, minAge time.Duration, dryRun bool) ([]image.GCImage, error)
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ImageGCController) Name() string {
+	return "runtime.ImageGCController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ImageGCController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ImageGCController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *ImageGCController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.GarbageCollect == nil {
+		ctrl.GarbageCollect = image.GarbageCollectSystem
+	}
+
+	var (
+		ticker   *time.Ticker
+		tickerC  <-chan time.Time
+		interval time.Duration
+	)
+
+	stopTicker := func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+
+		ticker, tickerC, interval = nil, nil, 0
+	}
+
+	defer stopTicker()
+
+	for {
+		collect := false
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-tickerC:
+			collect = true
+		}
+
+		cfg, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		if cfg == nil || !cfg.Config().Machine().ImageGC().Enabled() {
+			stopTicker()
+
+			continue
+		}
+
+		policy := cfg.Config().Machine().ImageGC()
+
+		if policy.Interval() != interval {
+			stopTicker()
+
+			interval = policy.Interval()
+			ticker = time.NewTicker(interval)
+			tickerC = ticker.C
+		}
+
+		if !collect {
+			continue
+		}
+
+		pruned, err := ctrl.GarbageCollect(ctx, image.SystemReferences(cfg.Config(), ctrl.InstallImageRefs), policy.MinAge(), false)
+		if err != nil {
+			// image GC is best effort, it will be retried on the next tick
+			logger.Warn("image garbage collection failed", zap.Error(err))
+
+			continue
+		}
+
+		for _, img := range pruned {
+			logger.Info("pruned unreferenced image",
+				zap.String("image", img.Name),
+				zap.String("digest", img.Digest),
+				zap.String("size", humanize.Bytes(uint64(img.Size))),
+			)
+		}
+	}
+}
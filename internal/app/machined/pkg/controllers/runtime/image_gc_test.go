@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/suite"
+
+	runtimecontrollers "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+)
+
+type ImageGCSuite struct {
+	RuntimeSuite
+}
+
+type imageGCCall struct {
+	referenced []string
+	minAge     time.Duration
+	dryRun     bool
+}
+
+func (suite *ImageGCSuite) TestReconcile() {
+	calls := make(chan imageGCCall, 16)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.ImageGCController{
+		InstallImageRefs: []string{"ghcr.io/siderolabs/installer:v1.2.1", "ghcr.io/siderolabs/installer:v1.2.0"},
+		GarbageCollect: func(ctx context.Context, referenced []string, minAge time.Duration, dryRun bool) ([]image.GCImage, error) {
+			calls <- imageGCCall{referenced: referenced, minAge: minAge, dryRun: dryRun}
+
+			return []image.GCImage{{Name: "ghcr.io/siderolabs/installer:v1.1.0"}}, nil
+		},
+	}))
+
+	suite.startRuntime()
+
+	machineConfig := func(enabled bool) *v1alpha1.Config {
+		return &v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineType: "worker",
+				MachineInstall: &v1alpha1.InstallConfig{
+					InstallImage: "ghcr.io/siderolabs/installer:v1.2.2",
+				},
+				MachineImageGC: &v1alpha1.ImageGCConfig{
+					ImageGCEnabled:  pointer.To(enabled),
+					ImageGCInterval: 100 * time.Millisecond,
+					ImageGCMinAge:   time.Hour,
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{},
+		}
+	}
+
+	cfg := config.NewMachineConfig(machineConfig(true))
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	select {
+	case call := <-calls:
+		suite.Assert().False(call.dryRun)
+		suite.Assert().Equal(time.Hour, call.minAge)
+		suite.Assert().Subset(call.referenced, []string{
+			"ghcr.io/siderolabs/installer:v1.2.2",
+			"ghcr.io/siderolabs/installer:v1.2.1",
+			"ghcr.io/siderolabs/installer:v1.2.0",
+		})
+	case <-time.After(10 * time.Second):
+		suite.FailNow("timed out waiting for image garbage collection")
+	}
+
+	// disable image GC
+	newCfg := config.NewMachineConfig(machineConfig(false))
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.state.Update(suite.ctx, newCfg))
+
+	// the controller might have been in the middle of the garbage collection run while the config was updated
+	time.Sleep(500 * time.Millisecond)
+
+	for len(calls) > 0 {
+		<-calls
+	}
+
+	select {
+	case <-calls:
+		suite.Fail("unexpected image garbage collection with the disabled policy")
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func TestImageGCSuite(t *testing.T) {
+	suite.Run(t, new(ImageGCSuite))
+}
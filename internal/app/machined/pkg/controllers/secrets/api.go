@@ -5,6 +5,7 @@
 package secrets
 
 import (
+	"bytes"
 	"context"
 	stdlibx509 "crypto/x509"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/slices"
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 
@@ -312,6 +314,7 @@ func (ctrl *APIController) generateControlPlane(ctx context.Context, r controlle
 			apiSecrets.CA = &x509.PEMEncodedCertificateAndKey{
 				Crt: rootSpec.CA.Crt,
 			}
+			apiSecrets.AcceptedCAs = acceptedCAs(rootSpec.CA.Crt, rootSpec.AcceptedCAs...)
 			apiSecrets.Server = x509.NewCertificateAndKeyFromKeyPair(serverCert)
 			apiSecrets.Client = x509.NewCertificateAndKeyFromKeyPair(clientCert)
 
@@ -334,7 +337,17 @@ func (ctrl *APIController) generateControlPlane(ctx context.Context, r controlle
 func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runtime, logger *zap.Logger,
 	rootSpec *secrets.OSRootSpec, endpointsStr []string, certSANs *secrets.CertSANSpec,
 ) error {
-	remoteGen, err := gen.NewRemoteGenerator(rootSpec.Token, endpointsStr, rootSpec.CA)
+	// trustd might present a certificate issued by any of the trusted CAs (e.g. during the CA rotation)
+	trustedCAs := &x509.PEMEncodedCertificateAndKey{
+		Crt: append([]byte(nil), rootSpec.CA.Crt...),
+	}
+
+	for _, acceptedCA := range acceptedCAs(rootSpec.CA.Crt, rootSpec.AcceptedCAs...) {
+		trustedCAs.Crt = append(trustedCAs.Crt, '\n')
+		trustedCAs.Crt = append(trustedCAs.Crt, acceptedCA.Crt...)
+	}
+
+	remoteGen, err := gen.NewRemoteGenerator(rootSpec.Token, endpointsStr, trustedCAs)
 	if err != nil {
 		return fmt.Errorf("failed creating trustd client: %w", err)
 	}
@@ -366,6 +379,9 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 			apiSecrets.CA = &x509.PEMEncodedCertificateAndKey{
 				Crt: ca,
 			}
+			// the CA returned by trustd might be different from the machine CA (e.g. during the CA rotation),
+			// so the machine CA stays trusted along with the accepted CAs
+			apiSecrets.AcceptedCAs = acceptedCAs(ca, append([]*x509.PEMEncodedCertificateAndKey{rootSpec.CA}, rootSpec.AcceptedCAs...)...)
 			apiSecrets.Server = serverCert
 
 			return nil
@@ -382,6 +398,31 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 	return nil
 }
 
+// acceptedCAs returns the certificates (without keys) of the accepted CAs, skipping the current CA and duplicates.
+func acceptedCAs(currentCA []byte, cas ...*x509.PEMEncodedCertificateAndKey) []*x509.PEMEncodedCertificateAndKey {
+	var result []*x509.PEMEncodedCertificateAndKey
+
+	seen := [][]byte{currentCA}
+
+	for _, ca := range cas {
+		if ca == nil || len(ca.Crt) == 0 {
+			continue
+		}
+
+		if slices.Contains(seen, func(crt []byte) bool { return bytes.Equal(crt, ca.Crt) }) {
+			continue
+		}
+
+		seen = append(seen, ca.Crt)
+
+		result = append(result, &x509.PEMEncodedCertificateAndKey{
+			Crt: ca.Crt,
+		})
+	}
+
+	return result
+}
+
 func (ctrl *APIController) teardownAll(ctx context.Context, r controller.Runtime) error {
 	list, err := r.List(ctx, resource.NewMetadata(secrets.NamespaceName, secrets.APIType, "", resource.VersionUndefined))
 	if err != nil {
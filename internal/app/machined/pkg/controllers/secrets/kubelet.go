@@ -90,6 +90,8 @@ func (ctrl *KubeletController) updateKubeletSecrets(cfgProvider talosconfig.Prov
 		return fmt.Errorf("missing cluster.CA secret")
 	}
 
+	kubeletSecrets.AcceptedCAs = cfgProvider.Cluster().AcceptedCAs()
+
 	kubeletSecrets.BootstrapTokenID = cfgProvider.Cluster().Token().ID()
 	kubeletSecrets.BootstrapTokenSecret = cfgProvider.Cluster().Token().Secret()
 
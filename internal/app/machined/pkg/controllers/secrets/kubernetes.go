@@ -241,6 +241,7 @@ func (ctrl *KubernetesController) updateSecrets(k8sRoot *secrets.KubernetesRootS
 		ClusterName: k8sRoot.Name,
 
 		CA:                  k8sRoot.CA,
+		AcceptedCAs:         k8sRoot.AcceptedCAs,
 		CertificateLifetime: KubernetesCertificateValidityDuration,
 
 		CommonName:   constants.KubernetesControllerManagerOrganization,
@@ -261,6 +262,7 @@ func (ctrl *KubernetesController) updateSecrets(k8sRoot *secrets.KubernetesRootS
 		ClusterName: k8sRoot.Name,
 
 		CA:                  k8sRoot.CA,
+		AcceptedCAs:         k8sRoot.AcceptedCAs,
 		CertificateLifetime: KubernetesCertificateValidityDuration,
 
 		CommonName:   constants.KubernetesSchedulerOrganization,
@@ -335,6 +337,10 @@ func (adapter *generateAdminAdapter) CA() *x509.PEMEncodedCertificateAndKey {
 	return adapter.k8sRoot.CA
 }
 
+func (adapter *generateAdminAdapter) AcceptedCAs() []*x509.PEMEncodedCertificateAndKey {
+	return adapter.k8sRoot.AcceptedCAs
+}
+
 func (adapter *generateAdminAdapter) AdminKubeconfig() config.AdminKubeconfig {
 	return adapter
 }
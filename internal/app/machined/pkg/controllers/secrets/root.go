@@ -13,6 +13,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/slices"
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 
@@ -135,6 +136,7 @@ func (ctrl *RootController) Run(ctx context.Context, r controller.Runtime, logge
 
 func (ctrl *RootController) updateOSSecrets(cfgProvider talosconfig.Provider, osSecrets *secrets.OSRootSpec) error {
 	osSecrets.CA = cfgProvider.Machine().Security().CA()
+	osSecrets.AcceptedCAs = cfgProvider.Machine().Security().AcceptedCAs()
 
 	osSecrets.CertSANIPs = nil
 	osSecrets.CertSANDNSNames = nil
@@ -199,11 +201,25 @@ func (ctrl *RootController) updateK8sSecrets(cfgProvider talosconfig.Provider, k
 		return fmt.Errorf("missing cluster.CA secret")
 	}
 
+	k8sSecrets.AcceptedCAs = cfgProvider.Cluster().AcceptedCAs()
+
 	k8sSecrets.ServiceAccount = cfgProvider.Cluster().ServiceAccount()
+	k8sSecrets.AcceptedServiceAccountKeys = cfgProvider.Cluster().AcceptedServiceAccountKeys()
+
+	k8sSecrets.OIDCCA = cfgProvider.Cluster().APIServer().OIDC().CA()
 
 	k8sSecrets.AESCBCEncryptionSecret = cfgProvider.Cluster().AESCBCEncryptionSecret()
 	k8sSecrets.SecretboxEncryptionSecret = cfgProvider.Cluster().SecretboxEncryptionSecret()
 
+	k8sSecrets.KMSProviders = slices.Map(cfgProvider.Cluster().APIServer().KMSProviders(), func(kms talosconfig.KMSProvider) secrets.KubernetesKMSProvider {
+		return secrets.KubernetesKMSProvider{
+			Name:        kms.Name(),
+			Endpoint:    kms.Endpoint(),
+			Timeout:     kms.Timeout(),
+			DecryptOnly: kms.DecryptOnly(),
+		}
+	})
+
 	k8sSecrets.BootstrapTokenID = cfgProvider.Cluster().Token().ID()
 	k8sSecrets.BootstrapTokenSecret = cfgProvider.Cluster().Token().Secret()
 
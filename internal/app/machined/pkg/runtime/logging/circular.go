@@ -20,6 +20,7 @@ import (
 	"github.com/talos-systems/go-debug"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
 // These constants should some day move to config.
@@ -53,13 +54,20 @@ func NewCircularBufferLoggingManager(fallbackLogger *log.Logger) *CircularBuffer
 
 // ServiceLog implements runtime.LoggingManager interface.
 func (manager *CircularBufferLoggingManager) ServiceLog(id string) runtime.LogHandler {
+	fields := map[string]interface{}{
+		// use field name that is not used by anything else
+		"talos-service": id,
+	}
+
+	// tag audit events, so that they can be routed separately from the regular logs
+	if id == constants.KubernetesAuditLogID {
+		fields["talos-audit"] = true
+	}
+
 	return &circularHandler{
 		manager: manager,
 		id:      id,
-		fields: map[string]interface{}{
-			// use field name that is not used by anything else
-			"talos-service": id,
-		},
+		fields:  fields,
 	}
 }
 
@@ -210,6 +218,12 @@ func (handler *circularHandler) runSenders() error {
 	defer r.Close() //nolint:errcheck
 
 	scanner := bufio.NewScanner(r)
+
+	// audit events might be much larger than the default scanner limit, so allow lines up to the buffer size
+	if handler.id == constants.KubernetesAuditLogID {
+		scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), MaxCapacity)
+	}
+
 	for scanner.Scan() {
 		l := bytes.TrimSpace(scanner.Bytes())
 		if len(l) == 0 {
@@ -37,6 +37,7 @@ type MachineState interface {
 	IsInstallStaged() bool
 	StagedInstallImageRef() string
 	StagedInstallOptions() []byte
+	InstallImageRefs() []string
 	KexecPrepared(bool)
 	IsKexecPrepared() bool
 	DBus() DBusState
@@ -36,4 +36,8 @@ const (
 	StagedUpgradeInstallOptions
 	// StateEncryptionConfig stores JSON-serialized v1alpha1.Encryption.
 	StateEncryptionConfig
+	// InstallImageRef stores image reference of the installer which performed the last install or upgrade.
+	InstallImageRef
+	// PreviousInstallImageRef stores image reference of the installer used before InstallImageRef.
+	PreviousInstallImageRef
 )
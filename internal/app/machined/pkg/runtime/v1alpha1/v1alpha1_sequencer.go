@@ -231,6 +231,10 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 		r.State().Platform().Mode() != runtime.ModeContainer,
 		"lvm",
 		ActivateLogicalVolumes,
+	).AppendWhen(
+		r.State().Platform().Mode() != runtime.ModeContainer,
+		"userVolumes",
+		pauseOnFailure(MountUserVolumes, constants.FailurePauseTimeout),
 	).Append(
 		"startEverything",
 		StartAllServices,
@@ -449,6 +453,7 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 		).Append(
 			"unmountUser",
 			UnmountUserDisks,
+			UnmountUserVolumes,
 		).Append(
 			"unmount",
 			UnmountOverlayFilesystems,
@@ -503,6 +508,7 @@ func stopAllPhaselist(r runtime.Runtime, enableKexec bool) PhaseList {
 		).Append(
 			"unmountUser",
 			UnmountUserDisks,
+			UnmountUserVolumes,
 		).Append(
 			"umount",
 			UnmountOverlayFilesystems,
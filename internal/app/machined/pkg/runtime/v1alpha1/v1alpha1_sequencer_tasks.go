@@ -17,6 +17,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"syscall"
 	"text/template"
@@ -25,10 +26,12 @@ import (
 	"github.com/containerd/cgroups"
 	cgroupsv2 "github.com/containerd/cgroups/v2"
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/siderolabs/go-blockdevice/blockdevice"
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem"
 	"github.com/siderolabs/go-blockdevice/blockdevice/partition/gpt"
 	"github.com/siderolabs/go-blockdevice/blockdevice/util"
 	"github.com/siderolabs/go-pointer"
@@ -43,6 +46,7 @@ import (
 	"kernel.org/pub/linux/libs/security/libcap/cap"
 
 	installer "github.com/talos-systems/talos/cmd/installer/pkg/install"
+	blockadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/block"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/adv"
@@ -56,6 +60,7 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/cri"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/internal/pkg/install"
+	"github.com/talos-systems/talos/internal/pkg/lvm"
 	"github.com/talos-systems/talos/internal/pkg/mount"
 	"github.com/talos-systems/talos/internal/pkg/partition"
 	"github.com/talos-systems/talos/pkg/conditions"
@@ -70,6 +75,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/kernel"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 	resourceruntime "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 	"github.com/talos-systems/talos/pkg/version"
@@ -884,7 +890,7 @@ func MountUserDisks(seq runtime.Sequence, data interface{}) (runtime.TaskExecuti
 			return err
 		}
 
-		return mountDisks(r)
+		return mountDisks(ctx, r)
 	}, "mountUserDisks"
 }
 
@@ -943,7 +949,7 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) error {
 						Size:           part.Size(),
 						Force:          true,
 						PartitionType:  partition.LinuxFilesystemData,
-						FileSystemType: partition.FilesystemTypeXFS,
+						FileSystemType: part.Filesystem(),
 					},
 				}
 
@@ -959,45 +965,45 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) error {
 	return m.Execute()
 }
 
-func mountDisks(r runtime.Runtime) (err error) {
-	mountpoints := mount.NewMountPoints()
+func mountDisks(ctx context.Context, r runtime.Runtime) (err error) {
+	mountpoints, err := mount.UserDiskPoints(r.Config().Machine().Disks(), r.Config().Machine().Install().GrowPartitions())
+	if err != nil {
+		return err
+	}
 
-	for _, disk := range r.Config().Machine().Disks() {
-		for i, part := range disk.Partitions() {
-			var partname string
+	iter := mountpoints.Iter()
 
-			partname, err = util.PartPath(disk.Device(), i+1)
-			if err != nil {
+	for iter.Next() {
+		if _, err = os.Stat(iter.Value().Target()); errors.Is(err, os.ErrNotExist) {
+			if err = os.MkdirAll(iter.Value().Target(), 0o700); err != nil {
 				return err
 			}
+		}
+	}
 
-			if _, err = os.Stat(part.MountPoint()); errors.Is(err, os.ErrNotExist) {
-				if err = os.MkdirAll(part.MountPoint(), 0o700); err != nil {
-					return err
-				}
-			}
+	if iter.Err() != nil {
+		return iter.Err()
+	}
+
+	if err = mount.Mount(mountpoints); err != nil {
+		return err
+	}
 
-			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint(), "xfs", unix.MS_NOATIME, ""))
+	iter = mountpoints.Iter()
+
+	for iter.Next() {
+		if err = mount.ReportGrowth(ctx, r, iter.Value().Growth()); err != nil {
+			return err
 		}
 	}
 
-	return mount.Mount(mountpoints)
+	return iter.Err()
 }
 
 func unmountDisks(r runtime.Runtime) (err error) {
-	mountpoints := mount.NewMountPoints()
-
-	for _, disk := range r.Config().Machine().Disks() {
-		for i, part := range disk.Partitions() {
-			var partname string
-
-			partname, err = util.PartPath(disk.Device(), i+1)
-			if err != nil {
-				return err
-			}
-
-			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint(), "xfs", unix.MS_NOATIME, ""))
-		}
+	mountpoints, err := mount.UserDiskPoints(r.Config().Machine().Disks(), false)
+	if err != nil {
+		return err
 	}
 
 	return mount.Unmount(mountpoints)
@@ -1585,6 +1591,10 @@ func Upgrade(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 
 		logger.Println("upgrade successful")
 
+		if err = recordInstallImageRef(in.GetImage()); err != nil {
+			logger.Printf("failed to record installer image reference: %s", err)
+		}
+
 		return nil
 	}, "upgrade"
 }
@@ -1623,6 +1633,34 @@ func LabelNodeAsControlPlane(seq runtime.Sequence, data interface{}) (runtime.Ta
 	}, "labelNodeAsControlPlane"
 }
 
+// recordInstallImageRef stores the reference of the installer image in META.
+//
+// The reference of the previously used installer image is kept, so that both images
+// are protected from the image garbage collection.
+func recordInstallImageRef(ref string) error {
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer meta.Close()
+
+	current, ok := meta.ADV.ReadTag(adv.InstallImageRef)
+	if ok && current == ref {
+		return nil
+	}
+
+	if ok && !meta.ADV.SetTag(adv.PreviousInstallImageRef, current) {
+		return fmt.Errorf("error adding previous install image ref tag")
+	}
+
+	if !meta.ADV.SetTag(adv.InstallImageRef, ref) {
+		return fmt.Errorf("error adding install image ref tag")
+	}
+
+	return meta.Write()
+}
+
 // UpdateBootloader represents the UpdateBootloader task.
 func UpdateBootloader(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1809,7 +1847,13 @@ func UnmountStatePartition(seq runtime.Sequence, data interface{}) (runtime.Task
 // MountEphemeralPartition mounts the ephemeral partition.
 func MountEphemeralPartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
-		return mount.SystemPartitionMount(r, logger, constants.EphemeralPartitionLabel, mount.WithFlags(mount.Resize))
+		var opts []mount.Option
+
+		if r.Config() == nil || r.Config().Machine().Install().GrowPartitions() {
+			opts = append(opts, mount.WithFlags(mount.Resize))
+		}
+
+		return mount.SystemPartitionMount(r, logger, constants.EphemeralPartitionLabel, opts...)
 	}, "mountEphemeralPartition"
 }
 
@@ -1820,6 +1864,63 @@ func UnmountEphemeralPartition(seq runtime.Sequence, data interface{}) (runtime.
 	}, "unmountEphemeralPartition"
 }
 
+// installDisk picks the install disk: either the configured one, or the disk resource matching the disk selector.
+//
+// Disk resources are populated asynchronously, so installDisk waits for the block device discovery to finish,
+// and falls back to scanning the block devices directly if there's no matching disk resource.
+func installDisk(ctx context.Context, logger *log.Logger, r runtime.Runtime) (string, error) {
+	matchers := r.Config().Machine().Install().DiskMatchers()
+	if len(matchers) == 0 {
+		return r.Config().Machine().Install().Disk()
+	}
+
+	if _, err := r.State().V1Alpha2().Resources().WatchFor(ctx,
+		resource.NewMetadata(block.NamespaceName, block.DiscoveryStatusType, block.DiscoveryStatusID, resource.VersionUndefined),
+		state.WithCondition(func(r resource.Resource) (bool, error) {
+			if resource.IsTombstone(r) {
+				return false, nil
+			}
+
+			status, ok := r.(*block.DiscoveryStatus)
+			if !ok {
+				return false, fmt.Errorf("unexpected resource type %T", r)
+			}
+
+			return status.TypedSpec().Ready, nil
+		}),
+	); err != nil {
+		return "", fmt.Errorf("error waiting for block device discovery: %w", err)
+	}
+
+	disks, err := safe.StateList[*block.Disk](ctx, r.State().V1Alpha2().Resources(), resource.NewMetadata(block.NamespaceName, block.DiskType, "", resource.VersionUndefined))
+	if err != nil {
+		return "", fmt.Errorf("error listing disks: %w", err)
+	}
+
+	var matched []string
+
+	for iter := safe.IteratorFromList(disks); iter.Next(); {
+		disk := iter.Value()
+
+		if blockadapter.DiskSpec(disk.TypedSpec()).Matches(matchers...) {
+			matched = append(matched, disk.TypedSpec().DevPath)
+		}
+	}
+
+	sort.Strings(matched)
+
+	switch len(matched) {
+	case 0:
+		logger.Printf("no disk resource matching the install disk selector, scanning block devices")
+
+		return r.Config().Machine().Install().Disk()
+	case 1:
+		return matched[0], nil
+	default:
+		return "", fmt.Errorf("multiple disks match the install disk selector: %q", matched)
+	}
+}
+
 // Install mounts or installs the system partitions.
 func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1832,7 +1933,7 @@ func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 
 			var disk string
 
-			disk, err = r.Config().Machine().Install().Disk()
+			disk, err = installDisk(ctx, logger, r)
 			if err != nil {
 				return err
 			}
@@ -1870,6 +1971,10 @@ func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 
 			logger.Println("install successful")
 
+			if err = recordInstallImageRef(installerImage); err != nil {
+				logger.Printf("failed to record installer image reference: %s", err)
+			}
+
 		case r.State().Machine().IsInstallStaged():
 			devname := r.State().Machine().Disk().BlockDevice.Device().Name()
 
@@ -1912,6 +2017,10 @@ func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 				},
 			)
 
+			if err = recordInstallImageRef(r.State().Machine().StagedInstallImageRef()); err != nil {
+				logger.Printf("failed to record installer image reference: %s", err)
+			}
+
 			logger.Println("staged upgrade successful")
 
 		default:
@@ -1933,6 +2042,152 @@ func ActivateLogicalVolumes(seq runtime.Sequence, data interface{}) (runtime.Tas
 	}, "activateLogicalVolumes"
 }
 
+// MountUserVolumes represents the task for creating, formatting and mounting LVM volumes.
+func MountUserVolumes(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		for _, vg := range r.Config().Machine().VolumeGroups() {
+			if err = setupVolumeGroup(ctx, logger, vg); err != nil {
+				return fmt.Errorf("error setting up volume group %q: %w", vg.Name(), err)
+			}
+		}
+
+		return mountVolumes(r)
+	}, "mountUserVolumes"
+}
+
+// setupVolumeGroup creates the volume group and logical volumes if they don't exist yet.
+//
+// Existing volume groups are extended with the new physical volumes, existing logical volumes are left as is.
+//
+//nolint:gocyclo
+func setupVolumeGroup(ctx context.Context, logger *log.Logger, vg config.VolumeGroup) error {
+	report, err := lvm.ReadReport(ctx)
+	if err != nil {
+		return err
+	}
+
+	var newPVs []string
+
+	for _, device := range vg.PhysicalVolumes() {
+		// LVM reports physical volumes by the device name
+		if resolved, err := filepath.EvalSymlinks(device); err == nil {
+			device = resolved
+		}
+
+		pv, found := report.PhysicalVolume(device)
+
+		switch {
+		case !found:
+			logger.Printf("initializing LVM physical volume %q", device)
+
+			if err = lvm.CreatePhysicalVolume(ctx, device); err != nil {
+				return err
+			}
+
+			newPVs = append(newPVs, device)
+		case pv.VolumeGroup == "":
+			newPVs = append(newPVs, device)
+		case pv.VolumeGroup != vg.Name():
+			return fmt.Errorf("device %q is already a physical volume of volume group %q", device, pv.VolumeGroup)
+		}
+	}
+
+	if _, found := report.VolumeGroup(vg.Name()); !found {
+		logger.Printf("creating LVM volume group %q", vg.Name())
+
+		if err = lvm.CreateVolumeGroup(ctx, vg.Name(), newPVs); err != nil {
+			return err
+		}
+	} else if len(newPVs) > 0 {
+		logger.Printf("extending LVM volume group %q with %q", vg.Name(), newPVs)
+
+		if err = lvm.ExtendVolumeGroup(ctx, vg.Name(), newPVs); err != nil {
+			return err
+		}
+	}
+
+	if err = lvm.ActivateVolumeGroup(ctx, vg.Name()); err != nil {
+		return err
+	}
+
+	for _, lv := range vg.LogicalVolumes() {
+		if _, found := report.LogicalVolume(vg.Name(), lv.Name()); !found {
+			logger.Printf("creating LVM logical volume %q in volume group %q", lv.Name(), vg.Name())
+
+			if err = lvm.CreateLogicalVolume(ctx, vg.Name(), lvm.LogicalVolumeOptions{
+				Name:    lv.Name(),
+				Type:    lv.Type(),
+				Mirrors: lv.Mirrors(),
+				Stripes: len(vg.PhysicalVolumes()) / (lv.Mirrors() + 1),
+				Size:    lv.Size(),
+			}); err != nil {
+				return err
+			}
+		}
+
+		devPath := logicalVolumePath(vg, lv)
+
+		// the filesystem might be missing if the previous boot failed right after the volume was created
+		sb, err := filesystem.Probe(devPath)
+		if err != nil {
+			return fmt.Errorf("error probing %q: %w", devPath, err)
+		}
+
+		if sb == nil {
+			logger.Printf("formatting LVM logical volume %q as %q", devPath, lv.Filesystem())
+
+			if err = partition.Format(devPath, &partition.FormatOptions{FileSystemType: lv.Filesystem()}); err != nil {
+				return fmt.Errorf("error formatting %q: %w", devPath, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func logicalVolumePath(vg config.VolumeGroup, lv config.LogicalVolume) string {
+	return filepath.Join("/dev", vg.Name(), lv.Name())
+}
+
+func volumeMountPoints(r runtime.Runtime) *mount.Points {
+	mountpoints := mount.NewMountPoints()
+
+	for _, vg := range r.Config().Machine().VolumeGroups() {
+		for _, lv := range vg.LogicalVolumes() {
+			if lv.MountPoint() == "" {
+				continue
+			}
+
+			devPath := logicalVolumePath(vg, lv)
+
+			mountpoints.Set(devPath, mount.NewMountPoint(devPath, lv.MountPoint(), lv.Filesystem(), unix.MS_NOATIME, ""))
+		}
+	}
+
+	return mountpoints
+}
+
+func mountVolumes(r runtime.Runtime) error {
+	mountpoints := volumeMountPoints(r)
+
+	iter := mountpoints.Iter()
+
+	for iter.Next() {
+		if err := os.MkdirAll(iter.Value().Target(), 0o700); err != nil {
+			return err
+		}
+	}
+
+	return mount.Mount(mountpoints)
+}
+
+// UnmountUserVolumes represents the UnmountUserVolumes task.
+func UnmountUserVolumes(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		return mount.Unmount(volumeMountPoints(r))
+	}, "unmountUserVolumes"
+}
+
 // KexecPrepare loads next boot kernel via kexec_file_load.
 //
 //nolint:gocyclo
@@ -38,6 +38,8 @@ type MachineState struct {
 	stagedInstallImageRef string
 	stagedInstallOptions  []byte
 
+	installImageRefs []string
+
 	kexecPrepared bool
 
 	dbus DBusState
@@ -152,6 +154,12 @@ func (s *MachineState) probeMeta() {
 
 	s.stagedInstall = ok1 && ok2
 
+	for _, tag := range []uint8{adv.InstallImageRef, adv.PreviousInstallImageRef} {
+		if ref, ok := meta.ADV.ReadTag(tag); ok && ref != "" {
+			s.installImageRefs = append(s.installImageRefs, ref)
+		}
+	}
+
 	if s.stagedInstall {
 		// clear the staged install flags
 		meta.ADV.DeleteTag(adv.StagedUpgradeImageRef)
@@ -222,6 +230,11 @@ func (s *MachineState) StagedInstallOptions() []byte {
 	return s.stagedInstallOptions
 }
 
+// InstallImageRefs implements the machine state interface.
+func (s *MachineState) InstallImageRefs() []string {
+	return s.installImageRefs
+}
+
 // KexecPrepared implements the machine state interface.
 func (s *MachineState) KexecPrepared(prepared bool) {
 	s.kexecPrepared = prepared
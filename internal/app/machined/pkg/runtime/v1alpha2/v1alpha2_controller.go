@@ -20,6 +20,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/cluster"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/config"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/cri"
@@ -39,6 +40,9 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	runtimelogging "github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/pkg/pci"
+	"github.com/talos-systems/talos/internal/pkg/smart"
+	"github.com/talos-systems/talos/internal/pkg/usb"
 	"github.com/talos-systems/talos/pkg/logging"
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
@@ -92,6 +96,21 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&timecontrollers.SyncController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&block.DevicesController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			SysfsPath:    "/sys",
+			UdevDataPath: "/run/udev/data",
+		},
+		&block.DiskHealthController{
+			V1Alpha1Mode:   ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+			Interval:       block.DefaultDiskHealthInterval,
+			Thresholds:     smart.DefaultThresholds,
+		},
+		&block.LVMController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			Interval:     block.DefaultLVMInterval,
+		},
 		&cluster.AffiliateMergeController{},
 		&cluster.ConfigController{},
 		&cluster.DiscoveryServiceController{},
@@ -121,9 +140,20 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			EtcPath:    "/etc",
 			ShadowPath: constants.SystemEtcPath,
 		},
+		&hardware.PCIDevicesController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			DevicesPath:  pci.DevicesPath,
+		},
 		&hardware.SystemInfoController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&hardware.USBDevicesController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			DevicesPath:  usb.DevicesPath,
+		},
+		&k8s.APIServerAuditLogController{
+			LoggingManager: ctrl.loggingManager,
+		},
 		&k8s.ControlPlaneStaticPodController{},
 		&k8s.EndpointController{},
 		&k8s.ExtraManifestController{},
@@ -132,6 +162,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
 			V1Alpha1Mode:     ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&k8s.KubeletServingCSRApproverController{},
 		&k8s.KubeletSpecController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -140,6 +171,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&k8s.ManifestApplyController{},
 		&k8s.NodeIPController{},
 		&k8s.NodeIPConfigController{},
+		&k8s.NodeMetadataApplyController{},
+		&k8s.NodeMetadataConfigController{},
 		&k8s.NodenameController{},
 		&k8s.RenderConfigsStaticPodController{},
 		&k8s.RenderSecretsStaticPodController{},
@@ -179,6 +212,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.LinkMergeController{},
 		&network.LinkStatusController{},
 		&network.LinkSpecController{},
+		&network.LLDPController{},
 		&network.NodeAddressController{},
 		&network.OperatorConfigController{
 			Cmdline: procfs.ProcCmdline(),
@@ -195,6 +229,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1alpha1Platform: ctrl.v1alpha1Runtime.State().Platform(),
 			PlatformState:    ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
 		},
+		&network.ProbeConfigController{},
+		&network.ProbeController{},
 		&network.ResolverConfigController{
 			Cmdline: procfs.ProcCmdline(),
 		},
@@ -204,6 +240,10 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			Cmdline: procfs.ProcCmdline(),
 		},
 		&network.RouteMergeController{},
+		&network.RouteRuleConfigController{},
+		&network.RouteRuleMergeController{},
+		&network.RouteRuleStatusController{},
+		&network.RouteRuleSpecController{},
 		&network.RouteStatusController{},
 		&network.RouteSpecController{},
 		&network.StatusController{},
@@ -223,6 +263,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			ConfigPath:       constants.ExtensionServicesConfigPath,
 		},
 		&runtimecontrollers.ExtensionStatusController{},
+		&runtimecontrollers.ImageGCController{
+			InstallImageRefs: ctrl.v1alpha1Runtime.State().Machine().InstallImageRefs(),
+		},
 		&runtimecontrollers.KernelModuleConfigController{},
 		&runtimecontrollers.KernelModuleSpecController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
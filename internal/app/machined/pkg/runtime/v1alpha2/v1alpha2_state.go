@@ -14,6 +14,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/registry"
 
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
 	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/cri"
@@ -63,6 +64,7 @@ func NewState() (*State, error) {
 		description string
 	}{
 		{v1alpha1.NamespaceName, "Talos v1alpha1 subsystems glue resources."},
+		{block.NamespaceName, "Block devices related resources."},
 		{cluster.NamespaceName, "Cluster configuration and discovery resources."},
 		{cluster.RawNamespaceName, "Cluster unmerged raw resources."},
 		{config.NamespaceName, "Talos node configuration."},
@@ -86,6 +88,14 @@ func NewState() (*State, error) {
 	// register Talos resources
 	for _, r := range []resource.Resource{
 		&v1alpha1.Service{},
+		&block.Disk{},
+		&block.DiskHealth{},
+		&block.DiscoveryStatus{},
+		&block.Filesystem{},
+		&block.LogicalVolume{},
+		&block.Partition{},
+		&block.PartitionGrowth{},
+		&block.VolumeGroup{},
 		&cluster.Affiliate{},
 		&cluster.Config{},
 		&cluster.Identity{},
@@ -101,6 +111,8 @@ func NewState() (*State, error) {
 		&hardware.Processor{},
 		&hardware.MemoryModule{},
 		&hardware.SystemInformation{},
+		&hardware.PCIDevice{},
+		&hardware.USBDevice{},
 		&k8s.AdmissionControlConfig{},
 		&k8s.AuditPolicyConfig{},
 		&k8s.APIServerConfig{},
@@ -116,6 +128,7 @@ func NewState() (*State, error) {
 		&k8s.BootstrapManifestsConfig{},
 		&k8s.NodeIP{},
 		&k8s.NodeIPConfig{},
+		&k8s.NodeMetadataConfig{},
 		&k8s.Nodename{},
 		&k8s.SchedulerConfig{},
 		&k8s.StaticPod{},
@@ -137,11 +150,16 @@ func NewState() (*State, error) {
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
+		&network.LLDPNeighbor{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
+		&network.ProbeSpec{},
+		&network.ProbeStatus{},
 		&network.ResolverStatus{},
 		&network.ResolverSpec{},
+		&network.RouteRuleStatus{},
+		&network.RouteRuleSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
 		&network.Status{},
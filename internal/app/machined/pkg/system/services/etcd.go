@@ -105,7 +105,14 @@ func (e *Etcd) PreFunc(ctx context.Context, r runtime.Runtime) (err error) {
 	// Pull the image and unpack it.
 	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
 
-	_, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, r.Config().Cluster().Etcd().Image(), image.WithSkipIfAlreadyPulled())
+	_, err = image.Pull(
+		containerdctx,
+		r.Config().Machine().Registries(),
+		client,
+		r.Config().Cluster().Etcd().Image(),
+		image.WithSkipIfAlreadyPulled(),
+		image.WithCredentialProviderConfig(r.Config().Machine().Kubelet().CredentialProviderConfig()),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to pull image %q: %w", r.Config().Cluster().Etcd().Image(), err)
 	}
@@ -65,7 +65,14 @@ func (k *Kubelet) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	// Pull the image and unpack it.
 	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
 
-	_, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, spec.Image, image.WithSkipIfAlreadyPulled())
+	_, err = image.Pull(
+		containerdctx,
+		r.Config().Machine().Registries(),
+		client,
+		spec.Image,
+		image.WithSkipIfAlreadyPulled(),
+		image.WithCredentialProviderConfig(spec.CredentialProviderConfig),
+	)
 	if err != nil {
 		return err
 	}
@@ -129,6 +136,18 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 		{Type: "bind", Destination: "/var/log/pods", Source: "/var/log/pods", Options: []string{"rbind", "rshared", "rw"}},
 	}
 
+	// Mount credential provider plugins (installed via system extensions).
+	if len(spec.CredentialProviderConfig) > 0 {
+		if _, err = os.Stat(constants.KubeletCredentialProviderBinDir); err == nil {
+			mounts = append(mounts, specs.Mount{
+				Type:        "bind",
+				Destination: constants.KubeletCredentialProviderBinDir,
+				Source:      constants.KubeletCredentialProviderBinDir,
+				Options:     []string{"bind", "ro"},
+			})
+		}
+	}
+
 	// Add extra mounts.
 	// TODO(andrewrynhard): We should verify that the mount source is
 	// allowlisted. There is the potential that a user can expose
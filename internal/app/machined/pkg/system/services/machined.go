@@ -49,6 +49,7 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/Events":                      role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/GenerateClientConfiguration": role.MakeSet(role.Admin),
 	"/machine.MachineService/GenerateConfiguration":       role.MakeSet(role.Admin),
+	"/machine.MachineService/GrowPartitions":              role.MakeSet(role.Admin),
 	"/machine.MachineService/Hostname":                    role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Kubeconfig":                  role.MakeSet(role.Admin),
 	"/machine.MachineService/List":                        role.MakeSet(role.Admin, role.Reader),
@@ -59,6 +60,7 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/NetworkDeviceStats":          role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/PacketCapture":               role.MakeSet(role.Admin),
 	"/machine.MachineService/Processes":                   role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/PruneImages":                 role.MakeSet(role.Admin),
 	"/machine.MachineService/Read":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/Reboot":                      role.MakeSet(role.Admin),
 	"/machine.MachineService/Reset":                       role.MakeSet(role.Admin),
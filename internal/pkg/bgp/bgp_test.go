@@ -0,0 +1,196 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp_test
+
+import (
+	"bytes"
+	"context"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/talos-systems/talos/internal/pkg/bgp"
+	"github.com/talos-systems/talos/internal/pkg/bgp/bgptest"
+)
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	for _, asn := range []uint32{65001, 4200000001} {
+		open := bgp.Open{
+			ASN:      asn,
+			HoldTime: 90 * time.Second,
+			RouterID: netip.MustParseAddr("10.5.0.2"),
+		}
+
+		var buf bytes.Buffer
+
+		require.NoError(t, bgp.WriteMessage(&buf, bgp.MessageOpen, open.Marshal()))
+
+		msg, err := bgp.ReadMessage(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, bgp.MessageOpen, msg.Type)
+
+		parsed, err := bgp.ParseOpen(msg.Body)
+		require.NoError(t, err)
+
+		open.FourOctetAS = true
+
+		assert.Equal(t, open, parsed)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name        string
+		update      bgp.Update
+		fourOctetAS bool
+
+		// if set, only these prefixes are expected to be announced
+		expectedAnnounced []netip.Prefix
+	}{
+		{
+			name: "announce IPv4",
+			update: bgp.Update{
+				Announced: []netip.Prefix{netip.MustParsePrefix("10.5.0.100/32")},
+				NextHop:   netip.MustParseAddr("10.5.0.2"),
+				ASPath:    []uint32{4200000001},
+			},
+			fourOctetAS: true,
+		},
+		{
+			name: "announce IPv6 iBGP",
+			update: bgp.Update{
+				Announced: []netip.Prefix{netip.MustParsePrefix("2001:db8::100/128")},
+				NextHop:   netip.MustParseAddr("2001:db8::2"),
+				LocalPref: 100,
+			},
+		},
+		{
+			name: "announce dual-stack over IPv4 2-octet AS",
+			update: bgp.Update{
+				Announced: []netip.Prefix{netip.MustParsePrefix("10.5.0.100/32"), netip.MustParsePrefix("2001:db8::100/128")},
+				NextHop:   netip.MustParseAddr("10.5.0.2"),
+				ASPath:    []uint32{65001},
+			},
+			expectedAnnounced: []netip.Prefix{netip.MustParsePrefix("10.5.0.100/32")},
+		},
+		{
+			name: "announce dual-stack over IPv6",
+			update: bgp.Update{
+				Announced: []netip.Prefix{netip.MustParsePrefix("10.5.0.100/32"), netip.MustParsePrefix("2001:db8::100/128")},
+				NextHop:   netip.MustParseAddr("2001:db8::2"),
+				ASPath:    []uint32{65001},
+			},
+			fourOctetAS:       true,
+			expectedAnnounced: []netip.Prefix{netip.MustParsePrefix("2001:db8::100/128")},
+		},
+		{
+			name: "announce IPv4 over IPv4-mapped next hop",
+			update: bgp.Update{
+				Announced: []netip.Prefix{netip.MustParsePrefix("10.5.0.100/32")},
+				NextHop:   netip.MustParseAddr("::ffff:10.5.0.2"),
+				LocalPref: 100,
+			},
+		},
+		{
+			name: "withdraw",
+			update: bgp.Update{
+				Withdrawn: []netip.Prefix{netip.MustParsePrefix("10.5.0.0/24"), netip.MustParsePrefix("2001:db8::/64")},
+			},
+			fourOctetAS: true,
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := bgp.ParseUpdate(test.update.Marshal(test.fourOctetAS), test.fourOctetAS)
+			require.NoError(t, err)
+
+			expected := test.update
+			expected.NextHop = expected.NextHop.Unmap()
+
+			if test.expectedAnnounced != nil {
+				expected.Announced = test.expectedAnnounced
+			}
+
+			assert.Equal(t, expected.Withdrawn, parsed.Withdrawn)
+			assert.ElementsMatch(t, expected.Announced, parsed.Announced)
+			assert.Equal(t, expected.NextHop, parsed.NextHop)
+			assert.Equal(t, expected.ASPath, parsed.ASPath)
+			assert.Equal(t, expected.LocalPref, parsed.LocalPref)
+		})
+	}
+}
+
+func TestSpeaker(t *testing.T) {
+	t.Parallel()
+
+	peer := bgptest.Start(t, 65000)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	prefixes := []netip.Prefix{netip.MustParsePrefix("10.5.0.100/32"), netip.MustParsePrefix("fd00::100/128")}
+
+	speaker := bgp.NewSpeaker(zaptest.NewLogger(t), bgp.Config{ASN: 4200000001}, bgp.Peer{Address: peer.Addr(), ASN: 65000}, prefixes)
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		speaker.Run(ctx)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return len(peer.Routes()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// IPv6 prefix can't be announced over IPv4 session
+	assert.NotContains(t, peer.Routes(), prefixes[1])
+
+	route := peer.Routes()[prefixes[0]]
+
+	assert.Equal(t, netip.MustParseAddr("127.0.0.1"), route.NextHop)
+	assert.Equal(t, []uint32{4200000001}, route.ASPath)
+
+	cancel()
+	wg.Wait()
+
+	assert.Eventually(t, func() bool {
+		return len(peer.Notifications()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Empty(t, peer.Routes())
+	assert.Equal(t, bgp.Notification{Code: bgp.NotificationCease, Subcode: bgp.CeaseAdministrativeShutdown, Data: []byte{}}, peer.Notifications()[0])
+}
+
+func TestSpeakerBadPeerAS(t *testing.T) {
+	t.Parallel()
+
+	peer := bgptest.Start(t, 65000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	t.Cleanup(cancel)
+
+	speaker := bgp.NewSpeaker(zaptest.NewLogger(t), bgp.Config{ASN: 65001}, bgp.Peer{Address: peer.Addr(), ASN: 65002}, []netip.Prefix{netip.MustParsePrefix("10.5.0.100/32")})
+
+	speaker.Run(ctx)
+
+	assert.Empty(t, peer.Routes())
+	assert.Equal(t, []bgp.Notification{{Code: bgp.NotificationOpenError, Subcode: bgp.OpenBadPeerAS, Data: []byte{}}}, peer.Notifications())
+}
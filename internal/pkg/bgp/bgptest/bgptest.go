@@ -0,0 +1,239 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package bgptest provides a BGP peer stand-in for tests.
+package bgptest
+
+import (
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/talos-systems/talos/internal/pkg/bgp"
+)
+
+// Peer is a BGP peer stand-in which accepts sessions and records the routes announced to it.
+type Peer struct {
+	ASN uint32
+
+	listener net.Listener
+
+	mu            sync.Mutex
+	routes        map[netip.Prefix]Route
+	notifications []bgp.Notification
+	sessions      int
+}
+
+// Route is a route received by the peer.
+type Route struct {
+	NextHop   netip.Addr
+	ASPath    []uint32
+	LocalPref uint32
+}
+
+// Start the peer listening on the loopback address.
+func Start(t *testing.T, asn uint32) *Peer {
+	t.Helper()
+
+	return StartOn(t, asn, netip.MustParseAddr("127.0.0.1"))
+}
+
+// StartOn starts the peer listening on the specified address.
+func StartOn(t *testing.T, asn uint32, addr netip.Addr) *Peer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", netip.AddrPortFrom(addr, 0).String())
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+
+	peer := &Peer{
+		ASN:      asn,
+		listener: listener,
+		routes:   map[netip.Prefix]Route{},
+	}
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		peer.serve(t, &wg)
+	}()
+
+	t.Cleanup(func() {
+		listener.Close() //nolint:errcheck
+
+		wg.Wait()
+	})
+
+	return peer
+}
+
+// Addr returns the address the peer is listening on.
+func (peer *Peer) Addr() netip.AddrPort {
+	return peer.listener.Addr().(*net.TCPAddr).AddrPort() //nolint:forcetypeassert
+}
+
+// Routes returns a copy of the routes currently announced to the peer.
+func (peer *Peer) Routes() map[netip.Prefix]Route {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	routes := make(map[netip.Prefix]Route, len(peer.routes))
+
+	for prefix, route := range peer.routes {
+		routes[prefix] = route
+	}
+
+	return routes
+}
+
+// Notifications returns notifications received from the speakers.
+func (peer *Peer) Notifications() []bgp.Notification {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	return append([]bgp.Notification(nil), peer.notifications...)
+}
+
+// Sessions returns the number of sessions established so far.
+func (peer *Peer) Sessions() int {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	return peer.sessions
+}
+
+func (peer *Peer) serve(t *testing.T, wg *sync.WaitGroup) {
+	for {
+		conn, err := peer.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			peer.handle(t, conn)
+		}()
+	}
+}
+
+//nolint:gocyclo
+func (peer *Peer) handle(t *testing.T, conn net.Conn) {
+	defer conn.Close() //nolint:errcheck
+
+	msg, err := bgp.ReadMessage(conn)
+	if err != nil || msg.Type != bgp.MessageOpen {
+		return
+	}
+
+	open, err := bgp.ParseOpen(msg.Body)
+	if err != nil {
+		t.Logf("failed to parse OPEN: %s", err)
+
+		return
+	}
+
+	if err = bgp.WriteMessage(conn, bgp.MessageOpen, bgp.Open{
+		ASN:      peer.ASN,
+		HoldTime: 9 * time.Second,
+		RouterID: netip.MustParseAddr("192.0.2.1"),
+	}.Marshal()); err != nil {
+		return
+	}
+
+	if err = bgp.WriteMessage(conn, bgp.MessageKeepalive, nil); err != nil {
+		return
+	}
+
+	peer.mu.Lock()
+	peer.sessions++
+	peer.mu.Unlock()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(3 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if bgp.WriteMessage(conn, bgp.MessageKeepalive, nil) != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	// routes are dropped when the session goes down
+	announced := map[netip.Prefix]struct{}{}
+
+	defer func() {
+		peer.mu.Lock()
+		defer peer.mu.Unlock()
+
+		for prefix := range announced {
+			delete(peer.routes, prefix)
+		}
+	}()
+
+	for {
+		msg, err = bgp.ReadMessage(conn)
+		if err != nil {
+			return
+		}
+
+		switch msg.Type { //nolint:exhaustive
+		case bgp.MessageUpdate:
+			update, err := bgp.ParseUpdate(msg.Body, open.FourOctetAS)
+			if err != nil {
+				t.Logf("failed to parse UPDATE: %s", err)
+
+				return
+			}
+
+			peer.mu.Lock()
+
+			for _, prefix := range update.Withdrawn {
+				delete(peer.routes, prefix)
+				delete(announced, prefix)
+			}
+
+			for _, prefix := range update.Announced {
+				peer.routes[prefix] = Route{
+					NextHop:   update.NextHop,
+					ASPath:    update.ASPath,
+					LocalPref: update.LocalPref,
+				}
+
+				announced[prefix] = struct{}{}
+			}
+
+			peer.mu.Unlock()
+		case bgp.MessageNotification:
+			notification, err := bgp.ParseNotification(msg.Body)
+			if err != nil {
+				return
+			}
+
+			peer.mu.Lock()
+			peer.notifications = append(peer.notifications, notification)
+			peer.mu.Unlock()
+
+			return
+		}
+	}
+}
@@ -0,0 +1,544 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"time"
+)
+
+// MessageType is a BGP message type.
+type MessageType uint8
+
+// Message types.
+const (
+	MessageOpen         MessageType = 1
+	MessageUpdate       MessageType = 2
+	MessageNotification MessageType = 3
+	MessageKeepalive    MessageType = 4
+)
+
+const (
+	headerLen   = 19
+	maxMsgLen   = 4096
+	version     = 4
+	asTrans     = 23456
+	paramCaps   = 2
+	capMP       = 1
+	capAS4      = 65
+	afiIPv4     = 1
+	afiIPv6     = 2
+	safiUnicast = 1
+)
+
+// Path attribute type codes.
+const (
+	attrOrigin      = 1
+	attrASPath      = 2
+	attrNextHop     = 3
+	attrLocalPref   = 5
+	attrMPReachNLRI = 14
+	attrMPUnreach   = 15
+)
+
+// Path attribute flags.
+const (
+	flagOptional   = 0x80
+	flagTransitive = 0x40
+	flagExtended   = 0x10
+)
+
+const asSequence = 2
+
+// Message is a raw BGP message.
+type Message struct {
+	Type MessageType
+	Body []byte
+}
+
+// ReadMessage reads a single BGP message.
+func ReadMessage(r io.Reader) (Message, error) {
+	var header [headerLen]byte
+
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return Message{}, err
+	}
+
+	for _, b := range header[:16] {
+		if b != 0xff {
+			return Message{}, errors.New("invalid BGP message marker")
+		}
+	}
+
+	length := int(binary.BigEndian.Uint16(header[16:18]))
+	if length < headerLen || length > maxMsgLen {
+		return Message{}, fmt.Errorf("invalid BGP message length %d", length)
+	}
+
+	msg := Message{
+		Type: MessageType(header[18]),
+		Body: make([]byte, length-headerLen),
+	}
+
+	if _, err := io.ReadFull(r, msg.Body); err != nil {
+		return Message{}, err
+	}
+
+	return msg, nil
+}
+
+// WriteMessage writes a single BGP message.
+func WriteMessage(w io.Writer, typ MessageType, body []byte) error {
+	if headerLen+len(body) > maxMsgLen {
+		return fmt.Errorf("BGP message is too long: %d", headerLen+len(body))
+	}
+
+	buf := make([]byte, headerLen, headerLen+len(body))
+
+	for i := 0; i < 16; i++ {
+		buf[i] = 0xff
+	}
+
+	binary.BigEndian.PutUint16(buf[16:18], uint16(headerLen+len(body)))
+	buf[18] = byte(typ)
+
+	_, err := w.Write(append(buf, body...))
+
+	return err
+}
+
+// Open is the BGP OPEN message.
+type Open struct {
+	ASN      uint32
+	HoldTime time.Duration
+	RouterID netip.Addr
+
+	// FourOctetAS is set if the speaker supports 4-octet AS numbers.
+	FourOctetAS bool
+}
+
+// Marshal the OPEN message body.
+func (o Open) Marshal() []byte {
+	myAS := uint16(asTrans)
+	if o.ASN <= 0xffff {
+		myAS = uint16(o.ASN)
+	}
+
+	routerID := o.RouterID.As4()
+
+	var caps []byte
+
+	for _, afi := range []uint16{afiIPv4, afiIPv6} {
+		caps = append(caps, capMP, 4, byte(afi>>8), byte(afi), 0, safiUnicast)
+	}
+
+	caps = append(caps, capAS4, 4)
+	caps = binary.BigEndian.AppendUint32(caps, o.ASN)
+
+	b := []byte{version}
+	b = binary.BigEndian.AppendUint16(b, myAS)
+	b = binary.BigEndian.AppendUint16(b, uint16(o.HoldTime/time.Second))
+	b = append(b, routerID[:]...)
+	b = append(b, byte(len(caps)+2), paramCaps, byte(len(caps)))
+
+	return append(b, caps...)
+}
+
+// ParseOpen parses the OPEN message body.
+func ParseOpen(b []byte) (Open, error) {
+	if len(b) < 10 {
+		return Open{}, errors.New("OPEN message is too short")
+	}
+
+	if b[0] != version {
+		return Open{}, fmt.Errorf("unsupported BGP version %d", b[0])
+	}
+
+	open := Open{
+		ASN:      uint32(binary.BigEndian.Uint16(b[1:3])),
+		HoldTime: time.Duration(binary.BigEndian.Uint16(b[3:5])) * time.Second,
+		RouterID: netip.AddrFrom4(*(*[4]byte)(b[5:9])),
+	}
+
+	params := b[10:]
+	if len(params) != int(b[9]) {
+		return Open{}, errors.New("invalid OPEN optional parameters length")
+	}
+
+	for len(params) > 0 {
+		if len(params) < 2 || len(params) < 2+int(params[1]) {
+			return Open{}, errors.New("truncated OPEN optional parameter")
+		}
+
+		paramType, value := params[0], params[2:2+int(params[1])]
+		params = params[2+int(params[1]):]
+
+		if paramType != paramCaps {
+			continue
+		}
+
+		for len(value) > 0 {
+			if len(value) < 2 || len(value) < 2+int(value[1]) {
+				return Open{}, errors.New("truncated OPEN capability")
+			}
+
+			code, capValue := value[0], value[2:2+int(value[1])]
+			value = value[2+int(value[1]):]
+
+			if code == capAS4 && len(capValue) == 4 {
+				open.FourOctetAS = true
+				open.ASN = binary.BigEndian.Uint32(capValue)
+			}
+		}
+	}
+
+	return open, nil
+}
+
+// Update is the BGP UPDATE message.
+//
+// IPv4 prefixes are encoded in the NLRI and withdrawn routes fields,
+// IPv6 prefixes are encoded with multiprotocol extensions.
+//
+// The next hop is a single address, so only the announced prefixes of the same address family
+// as the next hop are encoded, other prefixes are skipped.
+type Update struct {
+	Withdrawn []netip.Prefix
+	Announced []netip.Prefix
+
+	NextHop   netip.Addr
+	ASPath    []uint32
+	LocalPref uint32
+}
+
+// Marshal the UPDATE message body.
+//
+//nolint:gocyclo
+func (u Update) Marshal(fourOctetAS bool) []byte {
+	var withdrawn4, withdrawn6, announced4, announced6 []byte
+
+	for _, prefix := range u.Withdrawn {
+		if prefix.Addr().Is4() {
+			withdrawn4 = appendPrefix(withdrawn4, prefix)
+		} else {
+			withdrawn6 = appendPrefix(withdrawn6, prefix)
+		}
+	}
+
+	for _, prefix := range u.Announced {
+		if !sameFamily(prefix.Addr(), u.NextHop) {
+			continue
+		}
+
+		if prefix.Addr().Is4() {
+			announced4 = appendPrefix(announced4, prefix)
+		} else {
+			announced6 = appendPrefix(announced6, prefix)
+		}
+	}
+
+	var attrs []byte
+
+	if len(announced4) > 0 || len(announced6) > 0 {
+		attrs = appendAttr(attrs, flagTransitive, attrOrigin, []byte{0}) // IGP
+
+		asPath := []byte{}
+
+		if len(u.ASPath) > 0 {
+			asPath = append(asPath, asSequence, byte(len(u.ASPath)))
+
+			for _, asn := range u.ASPath {
+				if fourOctetAS {
+					asPath = binary.BigEndian.AppendUint32(asPath, asn)
+				} else {
+					if asn > 0xffff {
+						asn = asTrans
+					}
+
+					asPath = binary.BigEndian.AppendUint16(asPath, uint16(asn))
+				}
+			}
+		}
+
+		attrs = appendAttr(attrs, flagTransitive, attrASPath, asPath)
+
+		if len(announced4) > 0 {
+			nextHop := u.NextHop.As4()
+
+			attrs = appendAttr(attrs, flagTransitive, attrNextHop, nextHop[:])
+		}
+
+		if u.LocalPref != 0 {
+			attrs = appendAttr(attrs, flagTransitive, attrLocalPref, binary.BigEndian.AppendUint32(nil, u.LocalPref))
+		}
+
+		if len(announced6) > 0 {
+			nextHop := u.NextHop.As16()
+
+			value := []byte{0, afiIPv6, safiUnicast, byte(len(nextHop))}
+			value = append(value, nextHop[:]...)
+			value = append(value, 0)
+			value = append(value, announced6...)
+
+			attrs = appendAttr(attrs, flagOptional, attrMPReachNLRI, value)
+		}
+	}
+
+	if len(withdrawn6) > 0 {
+		attrs = appendAttr(attrs, flagOptional, attrMPUnreach, append([]byte{0, afiIPv6, safiUnicast}, withdrawn6...))
+	}
+
+	b := binary.BigEndian.AppendUint16(nil, uint16(len(withdrawn4)))
+	b = append(b, withdrawn4...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(attrs)))
+	b = append(b, attrs...)
+
+	return append(b, announced4...)
+}
+
+// sameFamily checks whether the prefix address and the next hop are of the same address family.
+//
+// IPv4-mapped IPv6 next hop is treated as IPv4.
+func sameFamily(addr, nextHop netip.Addr) bool {
+	return nextHop.IsValid() && addr.Is4() == nextHop.Unmap().Is4()
+}
+
+// ParseUpdate parses the UPDATE message body.
+//
+//nolint:gocyclo,cyclop
+func ParseUpdate(b []byte, fourOctetAS bool) (Update, error) {
+	var (
+		update Update
+		err    error
+	)
+
+	if len(b) < 2 {
+		return update, errors.New("UPDATE message is too short")
+	}
+
+	withdrawnLen := int(binary.BigEndian.Uint16(b))
+	b = b[2:]
+
+	if len(b) < withdrawnLen+2 {
+		return update, errors.New("truncated UPDATE withdrawn routes")
+	}
+
+	if update.Withdrawn, err = parsePrefixes(b[:withdrawnLen], afiIPv4); err != nil {
+		return update, err
+	}
+
+	b = b[withdrawnLen:]
+
+	attrsLen := int(binary.BigEndian.Uint16(b))
+	b = b[2:]
+
+	if len(b) < attrsLen {
+		return update, errors.New("truncated UPDATE path attributes")
+	}
+
+	attrs := b[:attrsLen]
+
+	if update.Announced, err = parsePrefixes(b[attrsLen:], afiIPv4); err != nil {
+		return update, err
+	}
+
+	for len(attrs) > 0 {
+		if len(attrs) < 3 {
+			return update, errors.New("truncated path attribute")
+		}
+
+		flags, code := attrs[0], attrs[1]
+		attrs = attrs[2:]
+
+		var length int
+
+		if flags&flagExtended != 0 {
+			if len(attrs) < 2 {
+				return update, errors.New("truncated path attribute")
+			}
+
+			length = int(binary.BigEndian.Uint16(attrs))
+			attrs = attrs[2:]
+		} else {
+			length = int(attrs[0])
+			attrs = attrs[1:]
+		}
+
+		if len(attrs) < length {
+			return update, errors.New("truncated path attribute")
+		}
+
+		value := attrs[:length]
+		attrs = attrs[length:]
+
+		switch code {
+		case attrASPath:
+			asLen := 2
+			if fourOctetAS {
+				asLen = 4
+			}
+
+			for len(value) >= 2 {
+				count := int(value[1])
+				value = value[2:]
+
+				if len(value) < count*asLen {
+					return update, errors.New("truncated AS_PATH")
+				}
+
+				for i := 0; i < count; i++ {
+					if fourOctetAS {
+						update.ASPath = append(update.ASPath, binary.BigEndian.Uint32(value[i*4:]))
+					} else {
+						update.ASPath = append(update.ASPath, uint32(binary.BigEndian.Uint16(value[i*2:])))
+					}
+				}
+
+				value = value[count*asLen:]
+			}
+		case attrNextHop:
+			if len(value) != 4 {
+				return update, errors.New("invalid NEXT_HOP length")
+			}
+
+			update.NextHop = netip.AddrFrom4(*(*[4]byte)(value))
+		case attrLocalPref:
+			if len(value) != 4 {
+				return update, errors.New("invalid LOCAL_PREF length")
+			}
+
+			update.LocalPref = binary.BigEndian.Uint32(value)
+		case attrMPReachNLRI:
+			if len(value) < 5 || len(value) < 5+int(value[3]) {
+				return update, errors.New("truncated MP_REACH_NLRI")
+			}
+
+			afi := binary.BigEndian.Uint16(value)
+			nextHopLen := int(value[3])
+
+			if afi == afiIPv6 && nextHopLen >= 16 {
+				update.NextHop = netip.AddrFrom16(*(*[16]byte)(value[4:20])).Unmap()
+			}
+
+			prefixes, err := parsePrefixes(value[5+nextHopLen:], afi)
+			if err != nil {
+				return update, err
+			}
+
+			update.Announced = append(update.Announced, prefixes...)
+		case attrMPUnreach:
+			if len(value) < 3 {
+				return update, errors.New("truncated MP_UNREACH_NLRI")
+			}
+
+			prefixes, err := parsePrefixes(value[3:], binary.BigEndian.Uint16(value))
+			if err != nil {
+				return update, err
+			}
+
+			update.Withdrawn = append(update.Withdrawn, prefixes...)
+		}
+	}
+
+	return update, nil
+}
+
+// Notification is the BGP NOTIFICATION message.
+type Notification struct {
+	Code    uint8
+	Subcode uint8
+	Data    []byte
+}
+
+// Notification error codes.
+const (
+	NotificationOpenError = 2
+	NotificationHoldTimer = 4
+	NotificationCease     = 6
+
+	// CeaseAdministrativeShutdown is the subcode of the Cease notification.
+	CeaseAdministrativeShutdown = 2
+	// OpenBadPeerAS is the subcode of the OPEN message error notification.
+	OpenBadPeerAS = 2
+)
+
+// Marshal the NOTIFICATION message body.
+func (n Notification) Marshal() []byte {
+	return append([]byte{n.Code, n.Subcode}, n.Data...)
+}
+
+// ParseNotification parses the NOTIFICATION message body.
+func ParseNotification(b []byte) (Notification, error) {
+	if len(b) < 2 {
+		return Notification{}, errors.New("NOTIFICATION message is too short")
+	}
+
+	return Notification{
+		Code:    b[0],
+		Subcode: b[1],
+		Data:    b[2:],
+	}, nil
+}
+
+// Error implements error interface.
+func (n Notification) Error() string {
+	return fmt.Sprintf("BGP notification code %d subcode %d", n.Code, n.Subcode)
+}
+
+func appendAttr(b []byte, flags, code byte, value []byte) []byte {
+	if len(value) > 0xff {
+		b = append(b, flags|flagExtended, code)
+
+		b = binary.BigEndian.AppendUint16(b, uint16(len(value)))
+	} else {
+		b = append(b, flags, code, byte(len(value)))
+	}
+
+	return append(b, value...)
+}
+
+func appendPrefix(b []byte, prefix netip.Prefix) []byte {
+	prefix = prefix.Masked()
+
+	bits := prefix.Bits()
+	addr := prefix.Addr().AsSlice()
+
+	b = append(b, byte(bits))
+
+	return append(b, addr[:(bits+7)/8]...)
+}
+
+func parsePrefixes(b []byte, afi uint16) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+
+	addrLen := 4
+	if afi == afiIPv6 {
+		addrLen = 16
+	}
+
+	for len(b) > 0 {
+		bits := int(b[0])
+		octets := (bits + 7) / 8
+
+		if bits > addrLen*8 || len(b) < 1+octets {
+			return nil, errors.New("invalid prefix encoding")
+		}
+
+		raw := make([]byte, addrLen)
+		copy(raw, b[1:1+octets])
+
+		addr, _ := netip.AddrFromSlice(raw)
+
+		prefixes = append(prefixes, netip.PrefixFrom(addr, bits))
+
+		b = b[1+octets:]
+	}
+
+	return prefixes, nil
+}
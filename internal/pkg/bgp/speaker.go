@@ -0,0 +1,298 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package bgp implements a minimal BGP-4 speaker which announces a set of prefixes to a peer.
+//
+// The speaker doesn't accept any routes from the peer, it only maintains the session
+// and announces (or withdraws) own prefixes.
+package bgp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultHoldTime is the default BGP hold time.
+	DefaultHoldTime = 90 * time.Second
+
+	// DefaultPort is the default BGP TCP port.
+	DefaultPort = 179
+
+	// RetryInterval is the interval between the session establishment attempts.
+	RetryInterval = 5 * time.Second
+
+	dialTimeout  = 10 * time.Second
+	openTimeout  = 30 * time.Second
+	writeTimeout = 5 * time.Second
+	localPref    = 100
+)
+
+// Config of the local speaker.
+type Config struct {
+	ASN uint32
+	// RouterID defaults to the local IPv4 address of the session.
+	RouterID netip.Addr
+	// HoldTime defaults to DefaultHoldTime.
+	HoldTime time.Duration
+}
+
+// Peer describes BGP peer.
+type Peer struct {
+	Address netip.AddrPort
+	ASN     uint32
+}
+
+// Speaker maintains a BGP session with a single peer announcing the prefixes.
+type Speaker struct {
+	logger *zap.Logger
+
+	config   Config
+	peer     Peer
+	prefixes []netip.Prefix
+}
+
+// NewSpeaker creates a new BGP speaker.
+func NewSpeaker(logger *zap.Logger, config Config, peer Peer, prefixes []netip.Prefix) *Speaker {
+	if config.HoldTime == 0 {
+		config.HoldTime = DefaultHoldTime
+	}
+
+	return &Speaker{
+		logger:   logger.With(zap.Stringer("peer", peer.Address)),
+		config:   config,
+		peer:     peer,
+		prefixes: prefixes,
+	}
+}
+
+// Run the speaker until the context is canceled.
+//
+// The session is re-established on failures, once the context is canceled the prefixes are withdrawn
+// and the session is closed.
+func (s *Speaker) Run(ctx context.Context) {
+	for {
+		err := s.session(ctx)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.logger.Warn("BGP session failed", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(RetryInterval):
+		}
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (s *Speaker) session(ctx context.Context) error {
+	dialer := net.Dialer{
+		Timeout: dialTimeout,
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", s.peer.Address.String())
+	if err != nil {
+		return fmt.Errorf("error connecting to the peer: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	localAddr := conn.LocalAddr().(*net.TCPAddr).AddrPort().Addr().Unmap() //nolint:forcetypeassert
+
+	routerID := s.config.RouterID
+	if !routerID.IsValid() {
+		if !localAddr.Is4() {
+			return errors.New("router ID should be set for IPv6 sessions")
+		}
+
+		routerID = localAddr
+	}
+
+	// exchange OPEN messages
+	if err = s.write(conn, MessageOpen, Open{
+		ASN:      s.config.ASN,
+		HoldTime: s.config.HoldTime,
+		RouterID: routerID,
+	}.Marshal()); err != nil {
+		return err
+	}
+
+	conn.SetReadDeadline(time.Now().Add(openTimeout)) //nolint:errcheck
+
+	msg, err := ReadMessage(conn)
+	if err != nil {
+		return fmt.Errorf("error reading OPEN: %w", err)
+	}
+
+	if msg.Type == MessageNotification {
+		return s.notification(msg)
+	}
+
+	if msg.Type != MessageOpen {
+		return fmt.Errorf("unexpected message type %d, expected OPEN", msg.Type)
+	}
+
+	peerOpen, err := ParseOpen(msg.Body)
+	if err != nil {
+		return err
+	}
+
+	if peerOpen.ASN != s.peer.ASN {
+		s.write(conn, MessageNotification, Notification{Code: NotificationOpenError, Subcode: OpenBadPeerAS}.Marshal()) //nolint:errcheck
+
+		return fmt.Errorf("peer ASN mismatch: expected %d, got %d", s.peer.ASN, peerOpen.ASN)
+	}
+
+	holdTime := s.config.HoldTime
+	if peerOpen.HoldTime < holdTime {
+		holdTime = peerOpen.HoldTime
+	}
+
+	fourOctetAS := peerOpen.FourOctetAS
+
+	if err = s.write(conn, MessageKeepalive, nil); err != nil {
+		return err
+	}
+
+	// the session is established once the peer sends KEEPALIVE, it is handled in the main loop
+	msgCh := make(chan Message)
+	errCh := make(chan error, 1)
+
+	readCtx, readCancel := context.WithCancel(ctx)
+	defer readCancel()
+
+	go func() {
+		for {
+			if holdTime > 0 {
+				conn.SetReadDeadline(time.Now().Add(holdTime)) //nolint:errcheck
+			} else {
+				conn.SetReadDeadline(time.Time{}) //nolint:errcheck
+			}
+
+			msg, err := ReadMessage(conn)
+			if err != nil {
+				errCh <- fmt.Errorf("error reading message: %w", err)
+
+				return
+			}
+
+			select {
+			case msgCh <- msg:
+			case <-readCtx.Done():
+				return
+			}
+		}
+	}()
+
+	var keepaliveCh <-chan time.Time
+
+	if holdTime > 0 {
+		ticker := time.NewTicker(holdTime / 3)
+		defer ticker.Stop()
+
+		keepaliveCh = ticker.C
+	}
+
+	established := false
+
+	update := Update{
+		NextHop: localAddr,
+	}
+
+	// the next hop is the local address of the session, so only prefixes of the same family can be announced
+	announced, skipped := splitByFamily(s.prefixes, localAddr)
+	if len(skipped) > 0 {
+		s.logger.Warn("prefixes of a different address family than the BGP session can't be announced", zap.Stringers("prefixes", skipped))
+	}
+
+	if s.peer.ASN != s.config.ASN {
+		update.ASPath = []uint32{s.config.ASN}
+	} else {
+		update.LocalPref = localPref
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if established {
+				if err = s.write(conn, MessageUpdate, Update{Withdrawn: announced}.Marshal(fourOctetAS)); err != nil {
+					return err
+				}
+
+				s.logger.Info("withdrawn BGP prefixes", zap.Stringers("prefixes", announced))
+			}
+
+			return s.write(conn, MessageNotification, Notification{Code: NotificationCease, Subcode: CeaseAdministrativeShutdown}.Marshal())
+		case err = <-errCh:
+			return err
+		case <-keepaliveCh:
+			if err = s.write(conn, MessageKeepalive, nil); err != nil {
+				return err
+			}
+		case msg = <-msgCh:
+			switch msg.Type { //nolint:exhaustive
+			case MessageNotification:
+				return s.notification(msg)
+			case MessageKeepalive:
+				if established {
+					continue
+				}
+
+				established = true
+
+				update.Announced = announced
+
+				if err = s.write(conn, MessageUpdate, update.Marshal(fourOctetAS)); err != nil {
+					return err
+				}
+
+				s.logger.Info("announced BGP prefixes", zap.Stringers("prefixes", announced))
+			default:
+				// routes received from the peer are ignored
+			}
+		}
+	}
+}
+
+// splitByFamily splits the prefixes into the ones matching the address family of the next hop and the rest.
+func splitByFamily(prefixes []netip.Prefix, nextHop netip.Addr) (matching, other []netip.Prefix) {
+	for _, prefix := range prefixes {
+		if sameFamily(prefix.Addr(), nextHop) {
+			matching = append(matching, prefix)
+		} else {
+			other = append(other, prefix)
+		}
+	}
+
+	return matching, other
+}
+
+func (s *Speaker) write(conn net.Conn, typ MessageType, body []byte) error {
+	conn.SetWriteDeadline(time.Now().Add(writeTimeout)) //nolint:errcheck
+
+	if err := WriteMessage(conn, typ, body); err != nil {
+		return fmt.Errorf("error writing message: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Speaker) notification(msg Message) error {
+	notification, err := ParseNotification(msg.Body)
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("received notification from the peer: %w", notification)
+}
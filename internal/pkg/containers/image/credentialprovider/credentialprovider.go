@@ -0,0 +1,213 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package credentialprovider implements fetching registry credentials with kubelet image credential provider plugins.
+package credentialprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeletconfig "k8s.io/kubelet/config/v1beta1"
+	credentialproviderv1beta1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1beta1"
+
+	"github.com/talos-systems/talos/pkg/machinery/kubelet"
+)
+
+// ExecTimeout is the timeout for the credential provider plugin execution.
+const ExecTimeout = time.Minute
+
+// ParseConfig converts kubelet image credential provider configuration from the machine configuration.
+//
+// Missing `apiVersion` and `kind` are filled in with the defaults.
+func ParseConfig(cfg map[string]interface{}) (*kubeletconfig.CredentialProviderConfig, error) {
+	var config kubeletconfig.CredentialProviderConfig
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(cfg, &config, true); err != nil {
+		return nil, fmt.Errorf("error unmarshalling credential provider configuration: %w", err)
+	}
+
+	if err := kubelet.ValidateCredentialProviderConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	config.APIVersion = kubelet.CredentialProviderConfigAPIVersion
+	config.Kind = kubelet.CredentialProviderConfigKind
+
+	return &config, nil
+}
+
+// Provider fetches registry credentials using the credential provider plugins.
+type Provider struct {
+	config *kubeletconfig.CredentialProviderConfig
+	binDir string
+}
+
+// New creates a new Provider with plugin binaries in binDir.
+func New(config *kubeletconfig.CredentialProviderConfig, binDir string) *Provider {
+	return &Provider{
+		config: config,
+		binDir: binDir,
+	}
+}
+
+// Credentials returns credentials for the image.
+//
+// The first provider which matches the image is used, empty credentials are returned if there's no matching provider.
+func (p *Provider) Credentials(ctx context.Context, image string) (username, password string, err error) {
+	for _, provider := range p.config.Providers {
+		if !matchesAny(provider.MatchImages, image) {
+			continue
+		}
+
+		var response *credentialproviderv1beta1.CredentialProviderResponse
+
+		response, err = p.exec(ctx, provider, image)
+		if err != nil {
+			return "", "", fmt.Errorf("error executing credential provider %q: %w", provider.Name, err)
+		}
+
+		// pick the most specific match for determinism
+		keys := make([]string, 0, len(response.Auth))
+
+		for key := range response.Auth {
+			keys = append(keys, key)
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			if len(keys[i]) != len(keys[j]) {
+				return len(keys[i]) > len(keys[j])
+			}
+
+			return keys[i] < keys[j]
+		})
+
+		for _, key := range keys {
+			if URLsMatch(key, image) {
+				return response.Auth[key].Username, response.Auth[key].Password, nil
+			}
+		}
+	}
+
+	return "", "", nil
+}
+
+func (p *Provider) exec(ctx context.Context, provider kubeletconfig.CredentialProvider, image string) (*credentialproviderv1beta1.CredentialProviderResponse, error) {
+	request, err := json.Marshal(credentialproviderv1beta1.CredentialProviderRequest{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: provider.APIVersion,
+			Kind:       "CredentialProviderRequest",
+		},
+		Image: image,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ExecTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, filepath.Join(p.binDir, provider.Name), provider.Args...)
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = os.Environ()
+
+	for _, env := range provider.Env {
+		cmd.Env = append(cmd.Env, env.Name+"="+env.Value)
+	}
+
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var response credentialproviderv1beta1.CredentialProviderResponse
+
+	if err = json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if response.APIVersion != provider.APIVersion || response.Kind != "CredentialProviderResponse" {
+		return nil, fmt.Errorf("unexpected response %s/%s", response.APIVersion, response.Kind)
+	}
+
+	return &response, nil
+}
+
+func matchesAny(patterns []string, image string) bool {
+	for _, pattern := range patterns {
+		if URLsMatch(pattern, image) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// URLsMatch checks whether the image matches the pattern using the kubelet credential provider matching rules.
+//
+// Globs are supported in the domain parts, the pattern port should match the image port,
+// and the pattern path should be a prefix of the image path.
+func URLsMatch(pattern, image string) bool {
+	patternURL, err := parseSchemelessURL(pattern)
+	if err != nil {
+		return false
+	}
+
+	imageURL, err := parseSchemelessURL(image)
+	if err != nil {
+		return false
+	}
+
+	patternHost, patternPort := splitHostPort(patternURL.Host)
+	imageHost, imagePort := splitHostPort(imageURL.Host)
+
+	if patternPort != imagePort {
+		return false
+	}
+
+	patternParts := strings.Split(patternHost, ".")
+	imageParts := strings.Split(imageHost, ".")
+
+	if len(patternParts) != len(imageParts) {
+		return false
+	}
+
+	for i := range patternParts {
+		matched, err := filepath.Match(patternParts[i], imageParts[i])
+		if err != nil || !matched {
+			return false
+		}
+	}
+
+	return strings.HasPrefix(imageURL.Path, patternURL.Path)
+}
+
+func parseSchemelessURL(s string) (*url.URL, error) {
+	// kubelet patterns and images don't have the scheme, add a fake one to parse as URL
+	return url.Parse("https://" + s)
+}
+
+func splitHostPort(hostport string) (host, port string) {
+	host, port, err := net.SplitHostPort(hostport)
+	if err != nil {
+		return hostport, ""
+	}
+
+	return host, port
+}
@@ -0,0 +1,145 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package credentialprovider_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/containers/image/credentialprovider"
+)
+
+func TestURLsMatch(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		pattern  string
+		image    string
+		expected bool
+	}{
+		{"gcr.io", "gcr.io/project/image:v1", true},
+		{"*.azurecr.io", "example.azurecr.io/image", true},
+		{"*.azurecr.io", "azurecr.io/image", false},
+		{"*.io", "registry.k8s.io/image", false},
+		{"*.dkr.ecr.*.amazonaws.com", "123456789.dkr.ecr.us-east-1.amazonaws.com/image", true},
+		{"*.dkr.ecr.*.amazonaws.com", "123456789.dkr.ecr.us-east-1.amazonaws.com.cn/image", false},
+		{"registry.io:8080/path", "registry.io:8080/path/image", true},
+		{"registry.io:8080/path", "registry.io/path/image", false},
+		{"registry.io/path", "registry.io/other/image", false},
+		{"app*.k8s.io", "apps.k8s.io/image", true},
+	} {
+		assert.Equal(t, test.expected, credentialprovider.URLsMatch(test.pattern, test.image), "pattern %q, image %q", test.pattern, test.image)
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+
+	config, err := credentialprovider.ParseConfig(map[string]interface{}{
+		"providers": []interface{}{
+			map[string]interface{}{
+				"name":                 "ecr-credential-provider",
+				"apiVersion":           "credentialprovider.kubelet.k8s.io/v1beta1",
+				"matchImages":          []interface{}{"*.dkr.ecr.*.amazonaws.com"},
+				"defaultCacheDuration": "12h",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "kubelet.config.k8s.io/v1beta1", config.APIVersion)
+	assert.Equal(t, "CredentialProviderConfig", config.Kind)
+	require.Len(t, config.Providers, 1)
+	assert.Equal(t, "ecr-credential-provider", config.Providers[0].Name)
+
+	_, err = credentialprovider.ParseConfig(map[string]interface{}{
+		"providers": []interface{}{
+			map[string]interface{}{
+				"name":       "../bin/sh",
+				"apiVersion": "credentialprovider.kubelet.k8s.io/v1alpha1",
+			},
+		},
+	})
+	assert.EqualError(t, err, `4 errors occurred:
+	* provider 0: invalid name "../bin/sh"
+	* provider 0: matchImages is required
+	* provider 0: defaultCacheDuration is required
+	* provider 0: unsupported apiVersion "credentialprovider.kubelet.k8s.io/v1alpha1"
+
+`)
+
+	_, err = credentialprovider.ParseConfig(map[string]interface{}{
+		"foo": "bar",
+	})
+	assert.Error(t, err)
+}
+
+const fakePlugin = `#!/bin/sh
+set -e
+
+request=$(cat)
+
+case "$request" in
+  *'"image":"registry.example.com/project/image"'*)
+    ;;
+  *)
+    echo "unexpected request: $request" >&2
+    exit 1
+    ;;
+esac
+
+echo '{"apiVersion":"credentialprovider.kubelet.k8s.io/v1beta1","kind":"CredentialProviderResponse","cacheKeyType":"Registry","auth":{"registry.example.com":{"username":"'"$1"'","password":"'"$FAKE_PASSWORD"'"}}}'
+`
+
+func TestProviderCredentials(t *testing.T) {
+	t.Parallel()
+
+	binDir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "fake-provider"), []byte(fakePlugin), 0o755))
+
+	config, err := credentialprovider.ParseConfig(map[string]interface{}{
+		"providers": []interface{}{
+			map[string]interface{}{
+				"name":                 "fake-provider",
+				"apiVersion":           "credentialprovider.kubelet.k8s.io/v1beta1",
+				"matchImages":          []interface{}{"*.example.com"},
+				"defaultCacheDuration": "1h",
+				"args":                 []interface{}{"user"},
+				"env": []interface{}{
+					map[string]interface{}{
+						"name":  "FAKE_PASSWORD",
+						"value": "secret",
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	provider := credentialprovider.New(config, binDir)
+
+	username, password, err := provider.Credentials(context.Background(), "registry.example.com/project/image")
+	require.NoError(t, err)
+
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "secret", password)
+
+	// no matching provider
+	username, password, err = provider.Credentials(context.Background(), "docker.io/library/alpine")
+	require.NoError(t, err)
+
+	assert.Empty(t, username)
+	assert.Empty(t, password)
+
+	// plugin failure
+	_, _, err = provider.Credentials(context.Background(), "registry.example.com/other/image")
+	assert.ErrorContains(t, err, "unexpected request")
+}
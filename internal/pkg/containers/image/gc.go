@@ -0,0 +1,173 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/reference/docker"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// GCImage describes an image which is eligible for garbage collection.
+type GCImage struct {
+	Name      string
+	Digest    string
+	Size      int64
+	CreatedAt time.Time
+}
+
+// SystemReferences returns references of the images which are always kept in the system containerd namespace.
+//
+// The list includes the images of the Talos services and installation configured via the machine config,
+// and the references of the installer images used for the current and previous installation.
+func SystemReferences(cfg config.Provider, installRefs []string) []string {
+	refs := append([]string(nil), installRefs...)
+
+	if cfg == nil {
+		return refs
+	}
+
+	refs = append(refs, cfg.Machine().Kubelet().Image())
+
+	if cfg.Machine().Type().IsControlPlane() {
+		refs = append(refs, cfg.Cluster().Etcd().Image())
+	}
+
+	if installer := cfg.Machine().Install().Image(); installer != "" {
+		refs = append(refs, installer)
+	}
+
+	for _, ext := range cfg.Machine().Install().Extensions() {
+		refs = append(refs, ext.Image())
+	}
+
+	return refs
+}
+
+// GCCandidates returns the images which are not referenced and are older than minAge.
+//
+// Images are matched by the normalized reference and by the digest, so that any image
+// pointing to the same manifest as the referenced one is kept as well.
+func GCCandidates(imgs []images.Image, referenced []string, minAge time.Duration, now time.Time) []images.Image {
+	names := make(map[string]struct{}, len(referenced))
+
+	for _, ref := range referenced {
+		names[normalizeRef(ref)] = struct{}{}
+	}
+
+	digests := map[string]struct{}{}
+
+	for _, img := range imgs {
+		if _, ok := names[normalizeRef(img.Name)]; ok {
+			digests[img.Target.Digest.String()] = struct{}{}
+		}
+	}
+
+	var candidates []images.Image
+
+	for _, img := range imgs {
+		if _, ok := names[normalizeRef(img.Name)]; ok {
+			continue
+		}
+
+		if _, ok := digests[img.Target.Digest.String()]; ok {
+			continue
+		}
+
+		if now.Sub(img.CreatedAt) < minAge {
+			continue
+		}
+
+		candidates = append(candidates, img)
+	}
+
+	return candidates
+}
+
+// GarbageCollect finds images in the containerd namespace of the context which are not
+// referenced and not used by any container, and prunes them unless dryRun is set.
+//
+// The images which are (or would be) pruned are returned.
+func GarbageCollect(ctx context.Context, client *containerd.Client, referenced []string, minAge time.Duration, dryRun bool) ([]GCImage, error) {
+	referenced = append([]string(nil), referenced...)
+
+	containers, err := client.ContainerService().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing containers: %w", err)
+	}
+
+	for _, container := range containers {
+		if container.Image != "" {
+			referenced = append(referenced, container.Image)
+		}
+	}
+
+	imgs, err := client.ImageService().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing images: %w", err)
+	}
+
+	candidates := GCCandidates(imgs, referenced, minAge, time.Now())
+	result := make([]GCImage, 0, len(candidates))
+
+	for i, img := range candidates {
+		size, err := containerd.NewImage(client, img).Size(ctx)
+		if err != nil && !errdefs.IsNotFound(err) {
+			return nil, fmt.Errorf("error calculating size of image %q: %w", img.Name, err)
+		}
+
+		if !dryRun {
+			var opts []images.DeleteOpt
+
+			// wait for the containerd garbage collection on the last image to free up the disk space
+			if i == len(candidates)-1 {
+				opts = append(opts, images.SynchronousDelete())
+			}
+
+			if err = client.ImageService().Delete(ctx, img.Name, opts...); err != nil && !errdefs.IsNotFound(err) {
+				return nil, fmt.Errorf("error deleting image %q: %w", img.Name, err)
+			}
+		}
+
+		result = append(result, GCImage{
+			Name:      img.Name,
+			Digest:    img.Target.Digest.String(),
+			Size:      size,
+			CreatedAt: img.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// GarbageCollectSystem runs GarbageCollect in the system containerd namespace.
+func GarbageCollectSystem(ctx context.Context, referenced []string, minAge time.Duration, dryRun bool) ([]GCImage, error) {
+	client, err := containerd.New(constants.SystemContainerdAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to containerd: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	return GarbageCollect(namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace), client, referenced, minAge, dryRun)
+}
+
+func normalizeRef(ref string) string {
+	named, err := docker.ParseDockerRef(ref)
+	if err != nil {
+		return ref
+	}
+
+	return named.String()
+}
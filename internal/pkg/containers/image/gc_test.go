@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd/images"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/siderolabs/gen/slices"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+)
+
+func TestGCCandidates(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	img := func(name string, target ocispec.Descriptor, age time.Duration) images.Image {
+		return images.Image{
+			Name:      name,
+			Target:    target,
+			CreatedAt: now.Add(-age),
+		}
+	}
+
+	imgs := []images.Image{
+		img("ghcr.io/siderolabs/installer:v1.2.0", ocispec.Descriptor{Digest: "sha256:aaaa"}, 30*24*time.Hour),
+		img("ghcr.io/siderolabs/installer:v1.2.1", ocispec.Descriptor{Digest: "sha256:bbbb"}, 7*24*time.Hour),
+		img("ghcr.io/siderolabs/installer:v1.2.2", ocispec.Descriptor{Digest: "sha256:cccc"}, 48*time.Hour),
+		img("ghcr.io/siderolabs/installer:latest", ocispec.Descriptor{Digest: "sha256:cccc"}, 48*time.Hour),
+		img("gcr.io/etcd-development/etcd:v3.5.4", ocispec.Descriptor{Digest: "sha256:dddd"}, 30*24*time.Hour),
+		img("gcr.io/etcd-development/etcd:v3.5.5", ocispec.Descriptor{Digest: "sha256:eeee"}, 7*24*time.Hour),
+		img("docker.io/library/busybox:latest", ocispec.Descriptor{Digest: "sha256:ffff"}, 30*24*time.Hour),
+		img("ghcr.io/siderolabs/gvisor:20220405.0", ocispec.Descriptor{Digest: "sha256:1111"}, time.Hour),
+	}
+
+	candidates := image.GCCandidates(imgs, []string{
+		// current and previous install
+		"ghcr.io/siderolabs/installer:v1.2.2",
+		"ghcr.io/siderolabs/installer:v1.2.1",
+		"gcr.io/etcd-development/etcd:v3.5.5",
+		// not normalized reference
+		"busybox",
+	}, 24*time.Hour, now)
+
+	assert.Equal(t,
+		[]string{
+			"ghcr.io/siderolabs/installer:v1.2.0",
+			"gcr.io/etcd-development/etcd:v3.5.4",
+		},
+		slices.Map(candidates, func(img images.Image) string { return img.Name }),
+	)
+}
@@ -7,6 +7,7 @@ package image
 import (
 	"context"
 	"fmt"
+	"log"
 	"os"
 	"time"
 
@@ -14,9 +15,11 @@ import (
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/pkg/kmutex"
+	"github.com/containerd/containerd/reference/docker"
 	"github.com/talos-systems/go-retry/retry"
 
 	containerdrunner "github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
+	"github.com/talos-systems/talos/internal/pkg/containers/image/credentialprovider"
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
@@ -39,7 +42,8 @@ type PullOption func(*PullOptions)
 
 // PullOptions configure Pull function.
 type PullOptions struct {
-	SkipIfAlreadyPulled bool
+	SkipIfAlreadyPulled      bool
+	CredentialProviderConfig map[string]interface{}
 }
 
 // WithSkipIfAlreadyPulled skips pulling if image is already pulled and unpacked.
@@ -49,6 +53,15 @@ func WithSkipIfAlreadyPulled() PullOption {
 	}
 }
 
+// WithCredentialProviderConfig fetches registry credentials with the kubelet image credential provider plugins.
+//
+// Plugins are used only for the registries without static authentication config.
+func WithCredentialProviderConfig(cfg map[string]interface{}) PullOption {
+	return func(opts *PullOptions) {
+		opts.CredentialProviderConfig = cfg
+	}
+}
+
 var unpackDuplicationSuppressor = kmutex.New()
 
 // Pull is a convenience function that wraps the containerd image pull func with
@@ -72,7 +85,15 @@ func Pull(ctx context.Context, reg config.Registries, client *containerd.Client,
 		}
 	}
 
-	resolver := NewResolver(reg)
+	var resolverOpts []ResolverOption
+
+	if len(opts.CredentialProviderConfig) > 0 {
+		if credentialsFunc := credentialProviderCredentials(ctx, opts.CredentialProviderConfig, ref); credentialsFunc != nil {
+			resolverOpts = append(resolverOpts, WithFallbackCredentials(credentialsFunc))
+		}
+	}
+
+	resolver := NewResolver(reg, resolverOpts...)
 
 	err = retry.Exponential(PullTimeout, retry.WithUnits(PullRetryInterval), retry.WithErrorLogging(true)).Retry(func() error {
 		if img, err = client.Pull(
@@ -106,6 +127,41 @@ func Pull(ctx context.Context, reg config.Registries, client *containerd.Client,
 	return img, nil
 }
 
+// credentialProviderCredentials builds credentials source for the image ref based on credential provider plugins.
+//
+// Same as the kubelet, credential provider failures are logged and the image is pulled without credentials:
+// Talos pulls its own images (e.g. etcd, installer) before the plugins might be available.
+func credentialProviderCredentials(ctx context.Context, cfg map[string]interface{}, ref string) CredentialsFunc {
+	providerConfig, err := credentialprovider.ParseConfig(cfg)
+	if err != nil {
+		log.Printf("ignoring credential provider configuration for %q: %s", ref, err)
+
+		return nil
+	}
+
+	named, err := docker.ParseDockerRef(ref)
+	if err != nil {
+		// the pull is going to fail anyways
+		return nil
+	}
+
+	provider := credentialprovider.New(providerConfig, constants.KubeletCredentialProviderBinDir)
+
+	return func(host string) (string, string, error) {
+		// the registry host might be a mirror, so use the host the request goes to
+		image := host + "/" + docker.Path(named)
+
+		username, password, err := provider.Credentials(ctx, image)
+		if err != nil {
+			log.Printf("failed to get credentials for %q from credential provider, pulling without credentials: %s", image, err)
+
+			return "", "", nil
+		}
+
+		return username, password, nil
+	}
+}
+
 // Import is a convenience function that wraps containerd image import with retries.
 func Import(ctx context.Context, imagePath, indexName string) error {
 	importer := containerdrunner.NewImporter(constants.SystemContainerdNamespace, containerdrunner.WithContainerdAddress(constants.SystemContainerdAddress))
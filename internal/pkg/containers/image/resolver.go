@@ -19,17 +19,42 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config"
 )
 
+// CredentialsFunc returns credentials for the registry host.
+type CredentialsFunc func(host string) (username, password string, err error)
+
+// ResolverOption configures the registry resolver.
+type ResolverOption func(*ResolverOptions)
+
+// ResolverOptions configure the registry resolver.
+type ResolverOptions struct {
+	// FallbackCredentials are used for the registries without static authentication config.
+	FallbackCredentials CredentialsFunc
+}
+
+// WithFallbackCredentials sets the credentials source for the registries without static authentication config.
+func WithFallbackCredentials(f CredentialsFunc) ResolverOption {
+	return func(opts *ResolverOptions) {
+		opts.FallbackCredentials = f
+	}
+}
+
 // NewResolver builds registry resolver based on Talos configuration.
-func NewResolver(reg config.Registries) remotes.Resolver {
+func NewResolver(reg config.Registries, opt ...ResolverOption) remotes.Resolver {
 	return docker.NewResolver(docker.ResolverOptions{
-		Hosts: RegistryHosts(reg),
+		Hosts: RegistryHosts(reg, opt...),
 	})
 }
 
 // RegistryHosts returns host configuration per registry.
 //
-//nolint:gocyclo
-func RegistryHosts(reg config.Registries) docker.RegistryHosts {
+//nolint:gocyclo,cyclop
+func RegistryHosts(reg config.Registries, opt ...ResolverOption) docker.RegistryHosts {
+	var opts ResolverOptions
+
+	for _, o := range opt {
+		o(&opts)
+	}
+
 	return func(host string) ([]docker.RegistryHost, error) {
 		var registries []docker.RegistryHost
 
@@ -71,7 +96,11 @@ func RegistryHosts(reg config.Registries) docker.RegistryHosts {
 				Authorizer: docker.NewDockerAuthorizer(
 					docker.WithAuthClient(client),
 					docker.WithAuthCreds(func(host string) (string, string, error) {
-						if registryConfig == nil {
+						if registryConfig == nil || registryConfig.Auth() == nil {
+							if opts.FallbackCredentials != nil && host == uu.Host {
+								return opts.FallbackCredentials(host)
+							}
+
 							return "", "", nil
 						}
 
@@ -215,6 +215,54 @@ func (suite *ResolverSuite) TestRegistryHosts() {
 	suite.Assert().Equal("Basic cm9vdDpzZWNyZXQ=", req.Header.Get("Authorization"))
 }
 
+func (suite *ResolverSuite) TestRegistryHostsFallbackCredentials() {
+	cfg := &mockConfig{
+		config: map[string]*v1alpha1.RegistryConfig{
+			"some.host:123": {
+				RegistryAuth: &v1alpha1.RegistryAuthConfig{
+					RegistryUsername: "root",
+					RegistryPassword: "secret",
+				},
+			},
+		},
+	}
+
+	fallback := image.WithFallbackCredentials(func(host string) (string, string, error) {
+		return "fallback", host, nil
+	})
+
+	for _, test := range []struct {
+		host           string
+		expectedHeader string
+	}{
+		{
+			host:           "some.host:123",
+			expectedHeader: "Basic cm9vdDpzZWNyZXQ=", // root:secret
+		},
+		{
+			host:           "other.host",
+			expectedHeader: "Basic ZmFsbGJhY2s6b3RoZXIuaG9zdA==", // fallback:other.host
+		},
+	} {
+		registryHosts, err := image.RegistryHosts(cfg, fallback)(test.host)
+		suite.Require().NoError(err)
+		suite.Require().Len(registryHosts, 1)
+
+		req, err := http.NewRequest(http.MethodGet, "https://"+test.host+"/v2", nil) //nolint:noctx
+		suite.Require().NoError(err)
+
+		resp := &http.Response{}
+		resp.Request = req
+		resp.Header = http.Header{}
+		resp.Header.Add("WWW-Authenticate", "Basic realm=\"Access to the staging site\", charset=\"UTF-8\"")
+
+		suite.Require().NoError(registryHosts[0].Authorizer.AddResponses(context.Background(), []*http.Response{resp}))
+		suite.Require().NoError(registryHosts[0].Authorizer.Authorize(context.Background(), req))
+
+		suite.Assert().Equal(test.expectedHeader, req.Header.Get("Authorization"))
+	}
+}
+
 func TestResolverSuite(t *testing.T) {
 	suite.Run(t, new(ResolverSuite))
 }
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/siderolabs/gen/maps"
 	"github.com/siderolabs/gen/slices"
 	"github.com/siderolabs/gen/value"
 	"go.uber.org/zap"
@@ -36,15 +37,34 @@ type Kubernetes struct {
 	client *kubernetes.Client
 
 	nodes informersv1.NodeInformer
+
+	secrets [][]byte
+
+	legacyAnnotations bool
 }
 
 // NewKubernetes creates new Kubernetes registry.
-func NewKubernetes(client *kubernetes.Client) *Kubernetes {
+//
+// Affiliate data is sealed with the first of the cluster secrets, all secrets are accepted when listing affiliates.
+func NewKubernetes(client *kubernetes.Client, secrets [][]byte) *Kubernetes {
 	return &Kubernetes{
-		client: client,
+		client:  client,
+		secrets: secrets,
 	}
 }
 
+// SetSecrets updates the cluster secrets used to seal and open affiliate data.
+func (r *Kubernetes) SetSecrets(secrets [][]byte) {
+	r.secrets = secrets
+}
+
+// SetLegacyAnnotations enables reading the plain text annotations pushed by the previous versions of Talos.
+//
+// With legacy annotations enabled, the plain text annotations are read from the nodes which don't have sealed data yet.
+func (r *Kubernetes) SetLegacyAnnotations(enabled bool) {
+	r.legacyAnnotations = enabled
+}
+
 // AnnotationsFromAffiliate generates Kubernetes Node annotations from the Affiliate spec.
 func AnnotationsFromAffiliate(affiliate *cluster.Affiliate) map[string]string {
 	var kubeSpanAddress string
@@ -65,21 +85,47 @@ func AnnotationsFromAffiliate(affiliate *cluster.Affiliate) map[string]string {
 
 // AffiliateFromNode converts Kubernetes Node resource to Affiliate.
 //
-// If the Node resource doesn't have cluster discovery annotations, nil is returned.
+// Affiliate data is read from the sealed annotation which is verified with the cluster secrets.
+// If legacyAnnotations is set, plain text annotations are read from the nodes without sealed affiliate data.
+// If the Node resource doesn't have affiliate data, nil is returned.
 //
-//nolint:gocyclo
-func AffiliateFromNode(node *v1.Node) *cluster.AffiliateSpec {
-	nodeID, ok := node.Annotations[constants.ClusterNodeIDAnnotation]
-	if !ok {
-		// skip the node, not part of the cluster discovery process
-		return nil
+//nolint:gocyclo,cyclop
+func AffiliateFromNode(node *v1.Node, secrets [][]byte, legacyAnnotations bool) (*cluster.AffiliateSpec, error) {
+	var annotations map[string]string
+
+	sealed, ok := node.Annotations[constants.ClusterAffiliateDataAnnotation]
+
+	switch {
+	case ok:
+		var err error
+
+		annotations, err = OpenAnnotations(sealed, node.Name, secrets)
+		if err != nil {
+			return nil, err
+		}
+	case legacyAnnotations:
+		// node is not migrated yet
+		annotations = node.Annotations
+	default:
+		// skip the node, not part of the cluster discovery process (or not migrated yet)
+		return nil, nil
+	}
+
+	nodeID, hasNodeID := annotations[constants.ClusterNodeIDAnnotation]
+	if !hasNodeID {
+		if !ok {
+			// skip the node, not part of the cluster discovery process
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("affiliate data is missing node ID")
 	}
 
 	affiliate := &cluster.AffiliateSpec{
 		NodeID: nodeID,
 	}
 
-	if selfIPs, ok := node.Annotations[constants.NetworkSelfIPsAnnotation]; ok {
+	if selfIPs, ok := annotations[constants.NetworkSelfIPsAnnotation]; ok {
 		affiliate.Addresses = parseIPs(selfIPs)
 	}
 
@@ -105,24 +151,24 @@ func AffiliateFromNode(node *v1.Node) *cluster.AffiliateSpec {
 
 	affiliate.OperatingSystem = node.Status.NodeInfo.OSImage
 
-	// Every other field is pulled from node annotations.
-	if publicKey, ok := node.Annotations[constants.KubeSpanPublicKeyAnnotation]; ok {
+	// Every other field is pulled from the affiliate data.
+	if publicKey, ok := annotations[constants.KubeSpanPublicKeyAnnotation]; ok {
 		affiliate.KubeSpan.PublicKey = publicKey
 	}
 
-	if ksIP, ok := node.Annotations[constants.KubeSpanIPAnnotation]; ok {
+	if ksIP, ok := annotations[constants.KubeSpanIPAnnotation]; ok {
 		affiliate.KubeSpan.Address, _ = netip.ParseAddr(ksIP) //nolint:errcheck
 	}
 
-	if additionalAddresses, ok := node.Annotations[constants.KubeSpanAssignedPrefixesAnnotation]; ok {
+	if additionalAddresses, ok := annotations[constants.KubeSpanAssignedPrefixesAnnotation]; ok {
 		affiliate.KubeSpan.AdditionalAddresses = parseIPPrefixes(additionalAddresses)
 	}
 
-	if endpoints, ok := node.Annotations[constants.KubeSpanKnownEndpointsAnnotation]; ok {
+	if endpoints, ok := annotations[constants.KubeSpanKnownEndpointsAnnotation]; ok {
 		affiliate.KubeSpan.Endpoints = parseIPPorts(endpoints)
 	}
 
-	return affiliate
+	return affiliate, nil
 }
 
 func ipsToString(in []netip.Addr) string {
@@ -185,11 +231,40 @@ func (r *Kubernetes) Push(ctx context.Context, affiliate *cluster.Affiliate) err
 		return fmt.Errorf("failed to marshal existing node data: %w", err)
 	}
 
-	for key, value := range AnnotationsFromAffiliate(affiliate) {
-		if value == "" {
-			delete(node.Annotations, key)
-		} else {
+	if len(r.secrets) == 0 {
+		return fmt.Errorf("no cluster secret to seal affiliate data")
+	}
+
+	annotations := AnnotationsFromAffiliate(affiliate)
+
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+
+	// keep the plain text annotations while there are nodes which can't read sealed data yet
+	keepLegacy, err := r.hasLegacyNodes(ctx, node.Name)
+	if err != nil {
+		return err
+	}
+
+	// keep the existing sealed data if it's up to date to avoid updating the Node on every push
+	if !r.sealedUpToDate(node, annotations) {
+		var sealed string
+
+		sealed, err = SealAnnotations(annotations, node.Name, r.secrets[0])
+		if err != nil {
+			return fmt.Errorf("failed to seal affiliate data: %w", err)
+		}
+
+		node.Annotations[constants.ClusterAffiliateDataAnnotation] = sealed
+	}
+
+	for key, value := range annotations {
+		if keepLegacy && value != "" {
 			node.Annotations[key] = value
+		} else {
+			// migrate from the plain text annotations
+			delete(node.Annotations, key)
 		}
 	}
 
@@ -214,10 +289,57 @@ func (r *Kubernetes) Push(ctx context.Context, affiliate *cluster.Affiliate) err
 	return nil
 }
 
+// hasLegacyNodes checks whether there are other nodes which push only the plain text annotations.
+//
+// Such nodes run the previous versions of Talos, and they can't read the sealed data.
+func (r *Kubernetes) hasLegacyNodes(ctx context.Context, localNodeName string) (bool, error) {
+	nodes, err := r.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for _, node := range nodes.Items {
+		if node.Name == localNodeName {
+			continue
+		}
+
+		_, hasNodeID := node.Annotations[constants.ClusterNodeIDAnnotation]
+		_, hasSealed := node.Annotations[constants.ClusterAffiliateDataAnnotation]
+
+		if hasNodeID && !hasSealed {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// sealedUpToDate checks whether the existing sealed data on the Node matches the annotations and the current secret.
+func (r *Kubernetes) sealedUpToDate(node *v1.Node, annotations map[string]string) bool {
+	sealed, ok := node.Annotations[constants.ClusterAffiliateDataAnnotation]
+	if !ok {
+		return false
+	}
+
+	existing, err := OpenAnnotations(sealed, node.Name, r.secrets[:1])
+	if err != nil {
+		return false
+	}
+
+	for key, value := range annotations {
+		if existing[key] != value {
+			return false
+		}
+	}
+
+	return len(existing) == len(maps.Filter(annotations, func(_, value string) bool { return value != "" }))
+}
+
 // List returns list of Affiliates coming from the registry.
 //
 // Watch should be called first for the List to return data.
-func (r *Kubernetes) List(localNodeName string) ([]*cluster.AffiliateSpec, error) {
+// Nodes with affiliate data which can't be verified with the cluster secrets are skipped.
+func (r *Kubernetes) List(localNodeName string, logger *zap.Logger) ([]*cluster.AffiliateSpec, error) {
 	if r.nodes == nil {
 		return nil, fmt.Errorf("List() called without Watch() first")
 	}
@@ -235,7 +357,13 @@ func (r *Kubernetes) List(localNodeName string) ([]*cluster.AffiliateSpec, error
 			continue
 		}
 
-		affiliate := AffiliateFromNode(node)
+		affiliate, err := AffiliateFromNode(node, r.secrets, r.legacyAnnotations)
+		if err != nil {
+			logger.Warn("skipping node with invalid affiliate data", zap.String("node", node.Name), zap.Error(err))
+
+			continue
+		}
+
 		if affiliate == nil {
 			continue
 		}
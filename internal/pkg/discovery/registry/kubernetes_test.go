@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -72,72 +73,135 @@ func TestAnnotationsFromAffiliate(t *testing.T) {
 }
 
 func TestAffiliateFromNode(t *testing.T) {
-	for _, tt := range []struct {
-		name     string
-		node     v1.Node
-		expected *cluster.AffiliateSpec
-	}{
-		{
-			name: "no annotations",
-			node: v1.Node{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:        "worker-1",
-					Annotations: map[string]string{},
+	currentSecret := []byte("0123456789abcdef0123456789abcdef")
+	previousSecret := []byte("fedcba9876543210fedcba9876543210")
+
+	annotations := map[string]string{
+		"cluster.talos.dev/node-id":                "29QQTc97U5ZyFTIX33Dp9NqtwxqQI8QI13scCLzffrZ",
+		"networking.talos.dev/assigned-prefixes":   "10.244.3.1/24",
+		"networking.talos.dev/kubespan-endpoints":  "10.0.0.2:51820,192.168.3.4:51820",
+		"networking.talos.dev/kubespan-ip":         "fd50:8d60:4238:6302:f857:23ff:fe21:d1e0",
+		"networking.talos.dev/kubespan-public-key": "PLPNBddmTgHJhtw0vxltq1ZBdPP9RNOEUd5JjJZzBRY=",
+		"networking.talos.dev/self-ips":            "10.0.0.2,192.168.3.4",
+	}
+
+	seal := func(nodename string, secret []byte) map[string]string {
+		sealed, err := registry.SealAnnotations(annotations, nodename, secret)
+		require.NoError(t, err)
+
+		return map[string]string{
+			constants.ClusterAffiliateDataAnnotation: sealed,
+		}
+	}
+
+	node := func(annotations map[string]string) v1.Node {
+		return v1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bar",
+				Annotations: annotations,
+				Labels: map[string]string{
+					constants.LabelNodeRoleControlPlane: "",
 				},
-				Spec: v1.NodeSpec{},
 			},
-			expected: nil,
-		},
-		{
-			name: "discovered",
-			node: v1.Node{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "bar",
-					Annotations: map[string]string{
-						"cluster.talos.dev/node-id":                "29QQTc97U5ZyFTIX33Dp9NqtwxqQI8QI13scCLzffrZ",
-						"networking.talos.dev/assigned-prefixes":   "10.244.3.1/24",
-						"networking.talos.dev/kubespan-endpoints":  "10.0.0.2:51820,192.168.3.4:51820",
-						"networking.talos.dev/kubespan-ip":         "fd50:8d60:4238:6302:f857:23ff:fe21:d1e0",
-						"networking.talos.dev/kubespan-public-key": "PLPNBddmTgHJhtw0vxltq1ZBdPP9RNOEUd5JjJZzBRY=",
-						"networking.talos.dev/self-ips":            "10.0.0.2,192.168.3.4",
-					},
-					Labels: map[string]string{
-						constants.LabelNodeRoleControlPlane: "",
+			Spec: v1.NodeSpec{},
+			Status: v1.NodeStatus{
+				Addresses: []v1.NodeAddress{
+					{
+						Type:    v1.NodeHostName,
+						Address: "foo.com",
 					},
 				},
-				Spec: v1.NodeSpec{},
-				Status: v1.NodeStatus{
-					Addresses: []v1.NodeAddress{
-						{
-							Type:    v1.NodeHostName,
-							Address: "foo.com",
-						},
-					},
-					NodeInfo: v1.NodeSystemInfo{
-						OSImage: "Talos (v1.0.0)",
-					},
-				},
-			},
-			expected: &cluster.AffiliateSpec{
-				NodeID:          "29QQTc97U5ZyFTIX33Dp9NqtwxqQI8QI13scCLzffrZ",
-				Hostname:        "foo.com",
-				Nodename:        "bar",
-				MachineType:     machine.TypeControlPlane,
-				Addresses:       []netip.Addr{netip.MustParseAddr("10.0.0.2"), netip.MustParseAddr("192.168.3.4")},
-				OperatingSystem: "Talos (v1.0.0)",
-				KubeSpan: cluster.KubeSpanAffiliateSpec{
-					PublicKey:           "PLPNBddmTgHJhtw0vxltq1ZBdPP9RNOEUd5JjJZzBRY=",
-					Address:             netip.MustParseAddr("fd50:8d60:4238:6302:f857:23ff:fe21:d1e0"),
-					AdditionalAddresses: []netip.Prefix{netip.MustParsePrefix("10.244.3.1/24")},
-					Endpoints:           []netip.AddrPort{netip.MustParseAddrPort("10.0.0.2:51820"), netip.MustParseAddrPort("192.168.3.4:51820")},
+				NodeInfo: v1.NodeSystemInfo{
+					OSImage: "Talos (v1.0.0)",
 				},
 			},
+		}
+	}
+
+	discovered := &cluster.AffiliateSpec{
+		NodeID:          "29QQTc97U5ZyFTIX33Dp9NqtwxqQI8QI13scCLzffrZ",
+		Hostname:        "foo.com",
+		Nodename:        "bar",
+		MachineType:     machine.TypeControlPlane,
+		Addresses:       []netip.Addr{netip.MustParseAddr("10.0.0.2"), netip.MustParseAddr("192.168.3.4")},
+		OperatingSystem: "Talos (v1.0.0)",
+		KubeSpan: cluster.KubeSpanAffiliateSpec{
+			PublicKey:           "PLPNBddmTgHJhtw0vxltq1ZBdPP9RNOEUd5JjJZzBRY=",
+			Address:             netip.MustParseAddr("fd50:8d60:4238:6302:f857:23ff:fe21:d1e0"),
+			AdditionalAddresses: []netip.Prefix{netip.MustParsePrefix("10.244.3.1/24")},
+			Endpoints:           []netip.AddrPort{netip.MustParseAddrPort("10.0.0.2:51820"), netip.MustParseAddrPort("192.168.3.4:51820")},
+		},
+	}
+
+	for _, tt := range []struct {
+		name              string
+		node              v1.Node
+		legacyAnnotations bool
+		expected          *cluster.AffiliateSpec
+		expectedError     error
+	}{
+		{
+			name: "no annotations",
+			node: node(map[string]string{}),
+		},
+		{
+			name: "legacy plain text annotations",
+			node: node(annotations),
+		},
+		{
+			name:              "legacy plain text annotations enabled",
+			node:              node(annotations),
+			legacyAnnotations: true,
+			expected:          discovered,
+		},
+		{
+			name:              "legacy plain text annotations enabled, no annotations",
+			node:              node(map[string]string{}),
+			legacyAnnotations: true,
+		},
+		{
+			name: "sealed preferred over legacy",
+			node: node(map[string]string{
+				constants.ClusterAffiliateDataAnnotation: seal("bar", currentSecret)[constants.ClusterAffiliateDataAnnotation],
+				constants.ClusterNodeIDAnnotation:        "spoofed",
+			}),
+			legacyAnnotations: true,
+			expected:          discovered,
+		},
+		{
+			name:     "discovered",
+			node:     node(seal("bar", currentSecret)),
+			expected: discovered,
+		},
+		{
+			name:     "previous secret",
+			node:     node(seal("bar", previousSecret)),
+			expected: discovered,
+		},
+		{
+			name:          "unknown secret",
+			node:          node(seal("bar", []byte("00000000000000000000000000000000"))),
+			expectedError: registry.ErrUnverifiable,
+		},
+		{
+			name:          "copied from another node",
+			node:          node(seal("foo", currentSecret)),
+			expectedError: registry.ErrUnverifiable,
 		},
 	} {
 		tt := tt
 
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.expected, registry.AffiliateFromNode(&tt.node))
+			affiliate, err := registry.AffiliateFromNode(&tt.node, [][]byte{currentSecret, previousSecret}, tt.legacyAnnotations)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, affiliate)
 		})
 	}
 }
@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package registry
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// sealedVersionPrefix marks the format of the sealed affiliate data.
+const sealedVersionPrefix = "v1:"
+
+// keyDerivationContext separates the Kubernetes registry key from other uses of the cluster secret.
+const keyDerivationContext = "talos kubernetes discovery registry"
+
+// ErrUnverifiable is returned when the sealed affiliate data can't be decrypted with any of the keys.
+var ErrUnverifiable = errors.New("affiliate data can't be verified with any of the cluster secrets")
+
+func newAEAD(secret []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(keyDerivationContext)) //nolint:errcheck
+
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// SealAnnotations encrypts and authenticates the affiliate annotations with the cluster secret.
+//
+// The sealed data is bound to the Node name, so it can't be copied to another Node.
+func SealAnnotations(annotations map[string]string, nodename string, secret []byte) (string, error) {
+	payload := make(map[string]string, len(annotations))
+
+	for key, value := range annotations {
+		if value != "" {
+			payload[key] = value
+		}
+	}
+
+	plaintext, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := newAEAD(secret)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	return sealedVersionPrefix + base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, plaintext, []byte(nodename))), nil
+}
+
+// OpenAnnotations decrypts and verifies the affiliate annotations sealed with SealAnnotations.
+//
+// Each of the cluster secrets is tried in order, which allows to roll over the cluster secret.
+func OpenAnnotations(sealed, nodename string, secrets [][]byte) (map[string]string, error) {
+	if !strings.HasPrefix(sealed, sealedVersionPrefix) {
+		return nil, fmt.Errorf("unsupported affiliate data format")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(sealed, sealedVersionPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding affiliate data: %w", err)
+	}
+
+	for _, secret := range secrets {
+		aead, err := newAEAD(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) < aead.NonceSize() {
+			return nil, fmt.Errorf("affiliate data is too short")
+		}
+
+		plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(nodename))
+		if err != nil {
+			continue
+		}
+
+		var annotations map[string]string
+
+		if err = json.Unmarshal(plaintext, &annotations); err != nil {
+			return nil, fmt.Errorf("error unmarshaling affiliate data: %w", err)
+		}
+
+		return annotations, nil
+	}
+
+	return nil, ErrUnverifiable
+}
@@ -6,6 +6,8 @@
 package encryption
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -15,6 +17,7 @@ import (
 	"github.com/siderolabs/go-blockdevice/blockdevice/encryption"
 	"github.com/siderolabs/go-blockdevice/blockdevice/encryption/luks"
 	"github.com/siderolabs/go-blockdevice/blockdevice/partition/gpt"
+	"github.com/talos-systems/go-cmd/pkg/cmd"
 
 	"github.com/talos-systems/talos/internal/pkg/encryption/keys"
 	"github.com/talos-systems/talos/pkg/machinery/config"
@@ -154,6 +157,29 @@ func (h *Handler) Close() error {
 	return nil
 }
 
+// Resize grows the opened encrypted mapping to the size of the underlying partition.
+//
+// Resize does nothing if the encrypted partition is not opened, as the mapping
+// is created with the full partition size on open.
+func (h *Handler) Resize() error {
+	if h.encryptedPath == "" {
+		return nil
+	}
+
+	var err error
+
+	// LUKS2 keeps the volume key in the kernel keyring, so the passphrase is required to resize the mapping
+	for _, k := range h.keys {
+		if _, err = cmd.RunContext(cmd.WithStdin(context.Background(), bytes.NewReader(k.Value)), "cryptsetup", "resize", h.encryptedPath, "--key-file=-"); err == nil {
+			log.Printf("resized encrypted partition %s", h.encryptedPath)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("failed to resize encrypted device %s: %w", h.encryptedPath, err)
+}
+
 func (h *Handler) formatAndEncrypt(path string) error {
 	log.Printf("encrypting the partition %s (%s)", path, h.partition.Name)
 
@@ -51,11 +51,13 @@ func RunInstallerContainer(disk, platform, ref string, cfg config.Provider, opts
 	var (
 		registriesConfig config.Registries
 		extensionsConfig []config.Extension
+		pullOptions      []image.PullOption
 	)
 
 	if cfg != nil {
 		registriesConfig = cfg.Machine().Registries()
 		extensionsConfig = cfg.Machine().Install().Extensions()
+		pullOptions = append(pullOptions, image.WithCredentialProviderConfig(cfg.Machine().Kubelet().CredentialProviderConfig()))
 	} else {
 		registriesConfig = &v1alpha1.RegistriesConfig{}
 	}
@@ -86,7 +88,7 @@ func RunInstallerContainer(disk, platform, ref string, cfg config.Provider, opts
 	if img == nil || err != nil && errdefs.IsNotFound(err) {
 		log.Printf("pulling %q", ref)
 
-		img, err = image.Pull(ctx, registriesConfig, client, ref)
+		img, err = image.Pull(ctx, registriesConfig, client, ref, pullOptions...)
 	}
 
 	if err != nil {
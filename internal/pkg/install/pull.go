@@ -22,7 +22,7 @@ import (
 // PullAndValidateInstallerImage pulls down the installer and validates that it can run.
 //
 //nolint:gocyclo
-func PullAndValidateInstallerImage(ctx context.Context, reg config.Registries, ref string) error {
+func PullAndValidateInstallerImage(ctx context.Context, reg config.Registries, ref string, opt ...image.PullOption) error {
 	// Pull down specified installer image early so we can bail if it doesn't exist in the upstream registry
 	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
 
@@ -35,7 +35,7 @@ func PullAndValidateInstallerImage(ctx context.Context, reg config.Registries, r
 
 	defer client.Close() //nolint:errcheck
 
-	img, err := image.Pull(containerdctx, reg, client, ref, image.WithSkipIfAlreadyPulled())
+	img, err := image.Pull(containerdctx, reg, client, ref, append([]image.PullOption{image.WithSkipIfAlreadyPulled()}, opt...)...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,270 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lvm provides a wrapper around LVM command line tools.
+package lvm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/talos-systems/go-cmd/pkg/cmd"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// Path is the path to the LVM binary.
+const Path = "/sbin/lvm"
+
+// PhysicalVolume describes an LVM physical volume.
+type PhysicalVolume struct {
+	Name        string
+	VolumeGroup string
+	Size        uint64
+}
+
+// VolumeGroup describes an LVM volume group.
+type VolumeGroup struct {
+	Name    string
+	UUID    string
+	Size    uint64
+	Free    uint64
+	PVCount int
+	LVCount int
+}
+
+// LogicalVolume describes an LVM logical volume.
+type LogicalVolume struct {
+	Name         string
+	VolumeGroup  string
+	UUID         string
+	Path         string
+	Size         uint64
+	SegmentType  string
+	Active       bool
+	HealthStatus string
+	SyncPercent  string
+}
+
+// Report is the state of LVM physical volumes, volume groups and logical volumes.
+type Report struct {
+	PhysicalVolumes []PhysicalVolume
+	VolumeGroups    []VolumeGroup
+	LogicalVolumes  []LogicalVolume
+}
+
+// PhysicalVolume returns the physical volume by name.
+func (r *Report) PhysicalVolume(name string) (PhysicalVolume, bool) {
+	for _, pv := range r.PhysicalVolumes {
+		if pv.Name == name {
+			return pv, true
+		}
+	}
+
+	return PhysicalVolume{}, false
+}
+
+// VolumeGroup returns the volume group by name.
+func (r *Report) VolumeGroup(name string) (VolumeGroup, bool) {
+	for _, vg := range r.VolumeGroups {
+		if vg.Name == name {
+			return vg, true
+		}
+	}
+
+	return VolumeGroup{}, false
+}
+
+// LogicalVolume returns the logical volume by volume group and logical volume name.
+func (r *Report) LogicalVolume(vgName, lvName string) (LogicalVolume, bool) {
+	for _, lv := range r.LogicalVolumes {
+		if lv.VolumeGroup == vgName && lv.Name == lvName {
+			return lv, true
+		}
+	}
+
+	return LogicalVolume{}, false
+}
+
+var reportArgs = []string{"--reportformat", "json", "--units", "b", "--nosuffix"}
+
+func run(ctx context.Context, args ...string) (string, error) {
+	out, err := cmd.RunContext(ctx, Path, args...)
+	if err != nil {
+		return "", fmt.Errorf("lvm %s: %w", args[0], err)
+	}
+
+	return out, nil
+}
+
+// ReadReport runs LVM reporting commands and returns the current state.
+func ReadReport(ctx context.Context) (*Report, error) {
+	pvs, err := run(ctx, append([]string{"pvs", "-o", "pv_name,vg_name,pv_size"}, reportArgs...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	vgs, err := run(ctx, append([]string{"vgs", "-o", "vg_name,vg_uuid,vg_size,vg_free,pv_count,lv_count"}, reportArgs...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	lvs, err := run(ctx, append([]string{"lvs", "-o", "lv_name,vg_name,lv_uuid,lv_path,lv_size,segtype,lv_active,lv_health_status,sync_percent"}, reportArgs...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseReport([]byte(pvs), []byte(vgs), []byte(lvs))
+}
+
+// parseRows extracts rows of the JSON report of the specified kind (pv, vg, lv).
+func parseRows(data []byte, kind string) ([]map[string]string, error) {
+	var report struct {
+		Report []map[string][]map[string]string `json:"report"`
+	}
+
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("error parsing %s report: %w", kind, err)
+	}
+
+	var rows []map[string]string
+
+	for _, r := range report.Report {
+		rows = append(rows, r[kind]...)
+	}
+
+	return rows, nil
+}
+
+func parseUint(s string) uint64 {
+	v, _ := strconv.ParseUint(strings.TrimSpace(s), 10, 64) //nolint:errcheck
+
+	return v
+}
+
+// ParseReport parses JSON output of pvs, vgs and lvs commands.
+func ParseReport(pvs, vgs, lvs []byte) (*Report, error) {
+	report := &Report{}
+
+	rows, err := parseRows(pvs, "pv")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		report.PhysicalVolumes = append(report.PhysicalVolumes, PhysicalVolume{
+			Name:        row["pv_name"],
+			VolumeGroup: row["vg_name"],
+			Size:        parseUint(row["pv_size"]),
+		})
+	}
+
+	if rows, err = parseRows(vgs, "vg"); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		report.VolumeGroups = append(report.VolumeGroups, VolumeGroup{
+			Name:    row["vg_name"],
+			UUID:    row["vg_uuid"],
+			Size:    parseUint(row["vg_size"]),
+			Free:    parseUint(row["vg_free"]),
+			PVCount: int(parseUint(row["pv_count"])),
+			LVCount: int(parseUint(row["lv_count"])),
+		})
+	}
+
+	if rows, err = parseRows(lvs, "lv"); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		report.LogicalVolumes = append(report.LogicalVolumes, LogicalVolume{
+			Name:         row["lv_name"],
+			VolumeGroup:  row["vg_name"],
+			UUID:         row["lv_uuid"],
+			Path:         row["lv_path"],
+			Size:         parseUint(row["lv_size"]),
+			SegmentType:  row["segtype"],
+			Active:       row["lv_active"] == "active",
+			HealthStatus: row["lv_health_status"],
+			SyncPercent:  row["sync_percent"],
+		})
+	}
+
+	return report, nil
+}
+
+// CreatePhysicalVolume initializes the device as an LVM physical volume.
+//
+// LVM refuses to initialize devices with existing partition tables or filesystem signatures.
+func CreatePhysicalVolume(ctx context.Context, device string) error {
+	_, err := run(ctx, "pvcreate", device)
+
+	return err
+}
+
+// CreateVolumeGroup creates a volume group from the physical volumes.
+func CreateVolumeGroup(ctx context.Context, name string, pvs []string) error {
+	_, err := run(ctx, append([]string{"vgcreate", name}, pvs...)...)
+
+	return err
+}
+
+// ExtendVolumeGroup adds physical volumes to the volume group.
+func ExtendVolumeGroup(ctx context.Context, name string, pvs []string) error {
+	_, err := run(ctx, append([]string{"vgextend", name}, pvs...)...)
+
+	return err
+}
+
+// ActivateVolumeGroup activates all logical volumes in the volume group.
+func ActivateVolumeGroup(ctx context.Context, name string) error {
+	_, err := run(ctx, "vgchange", "-ay", name)
+
+	return err
+}
+
+// LogicalVolumeOptions describes a logical volume to create.
+type LogicalVolumeOptions struct {
+	Name string
+	// Type is one of linear, raid1, raid10.
+	Type    string
+	Mirrors int
+	// Stripes is the number of stripes for raid10.
+	Stripes int
+	// Size in bytes, zero means all free space in the volume group.
+	Size uint64
+}
+
+// CreateLogicalVolumeArgs returns lvcreate arguments for the logical volume.
+func CreateLogicalVolumeArgs(vgName string, opts LogicalVolumeOptions) []string {
+	// logical volume is freshly created, so wiping stale signatures is safe
+	args := []string{"lvcreate", "--yes", "--wipesignatures", "y", "--name", opts.Name}
+
+	switch opts.Type {
+	case constants.LogicalVolumeTypeRAID1:
+		args = append(args, "--type", opts.Type, "--mirrors", strconv.Itoa(opts.Mirrors))
+	case constants.LogicalVolumeTypeRAID10:
+		args = append(args, "--type", opts.Type, "--mirrors", strconv.Itoa(opts.Mirrors), "--stripes", strconv.Itoa(opts.Stripes))
+	}
+
+	if opts.Size == 0 {
+		args = append(args, "--extents", "100%FREE")
+	} else {
+		args = append(args, "--size", strconv.FormatUint(opts.Size, 10)+"b")
+	}
+
+	return append(args, vgName)
+}
+
+// CreateLogicalVolume creates a logical volume in the volume group.
+func CreateLogicalVolume(ctx context.Context, vgName string, opts LogicalVolumeOptions) error {
+	_, err := run(ctx, CreateLogicalVolumeArgs(vgName, opts)...)
+
+	return err
+}
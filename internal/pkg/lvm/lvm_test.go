@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lvm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/lvm"
+)
+
+const (
+	pvsReport = `  {
+      "report": [
+          {
+              "pv": [
+                  {"pv_name":"/dev/sdb", "vg_name":"data", "pv_size":"10733223936"},
+                  {"pv_name":"/dev/sdc", "vg_name":"data", "pv_size":"10733223936"},
+                  {"pv_name":"/dev/sdd", "vg_name":"", "pv_size":"10737418240"}
+              ]
+          }
+      ]
+  }
+`
+
+	vgsReport = `  {
+      "report": [
+          {
+              "vg": [
+                  {"vg_name":"data", "vg_uuid":"3xGsl5-Ue8d-mXbO-P2ns-Xm3f-VCcD-zZ8rC4", "vg_size":"21466447872", "vg_free":"0", "pv_count":"2", "lv_count":"2"}
+              ]
+          }
+      ]
+  }
+`
+
+	lvsReport = `  {
+      "report": [
+          {
+              "lv": [
+                  {"lv_name":"mirror", "vg_name":"data", "lv_uuid":"fJ2R1w-9hd0-Vqaj-eg1Q-3M0l-ae3E-Ivsbh3", "lv_path":"/dev/data/mirror", "lv_size":"4294967296",
+                   "segtype":"raid1", "lv_active":"active", "lv_health_status":"", "sync_percent":"42.17"},
+                  {"lv_name":"scratch", "vg_name":"data", "lv_uuid":"0GU9Bx-4A0g-lbQT-2Kkg-Xg0e-F6yp-mt1Cia", "lv_path":"/dev/data/scratch", "lv_size":"12868124672",
+                   "segtype":"linear", "lv_active":"", "lv_health_status":"", "sync_percent":""}
+              ]
+          }
+      ]
+  }
+`
+)
+
+func TestParseReport(t *testing.T) {
+	t.Parallel()
+
+	report, err := lvm.ParseReport([]byte(pvsReport), []byte(vgsReport), []byte(lvsReport))
+	require.NoError(t, err)
+
+	assert.Equal(t, []lvm.PhysicalVolume{
+		{Name: "/dev/sdb", VolumeGroup: "data", Size: 10733223936},
+		{Name: "/dev/sdc", VolumeGroup: "data", Size: 10733223936},
+		{Name: "/dev/sdd", Size: 10737418240},
+	}, report.PhysicalVolumes)
+
+	assert.Equal(t, []lvm.VolumeGroup{
+		{Name: "data", UUID: "3xGsl5-Ue8d-mXbO-P2ns-Xm3f-VCcD-zZ8rC4", Size: 21466447872, PVCount: 2, LVCount: 2},
+	}, report.VolumeGroups)
+
+	mirror, ok := report.LogicalVolume("data", "mirror")
+	require.True(t, ok)
+
+	assert.Equal(t, lvm.LogicalVolume{
+		Name:        "mirror",
+		VolumeGroup: "data",
+		UUID:        "fJ2R1w-9hd0-Vqaj-eg1Q-3M0l-ae3E-Ivsbh3",
+		Path:        "/dev/data/mirror",
+		Size:        4294967296,
+		SegmentType: "raid1",
+		Active:      true,
+		SyncPercent: "42.17",
+	}, mirror)
+
+	scratch, ok := report.LogicalVolume("data", "scratch")
+	require.True(t, ok)
+	assert.False(t, scratch.Active)
+
+	_, ok = report.LogicalVolume("other", "scratch")
+	assert.False(t, ok)
+
+	pv, ok := report.PhysicalVolume("/dev/sdd")
+	require.True(t, ok)
+	assert.Empty(t, pv.VolumeGroup)
+
+	_, err = lvm.ParseReport([]byte("WARNING: foo"), []byte(vgsReport), []byte(lvsReport))
+	assert.Error(t, err)
+}
+
+func TestCreateLogicalVolumeArgs(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		[]string{"lvcreate", "--yes", "--wipesignatures", "y", "--name", "scratch", "--extents", "100%FREE", "data"},
+		lvm.CreateLogicalVolumeArgs("data", lvm.LogicalVolumeOptions{Name: "scratch", Type: "linear"}),
+	)
+
+	assert.Equal(t,
+		[]string{"lvcreate", "--yes", "--wipesignatures", "y", "--name", "mirror", "--type", "raid1", "--mirrors", "1", "--size", "4294967296b", "data"},
+		lvm.CreateLogicalVolumeArgs("data", lvm.LogicalVolumeOptions{Name: "mirror", Type: "raid1", Mirrors: 1, Size: 4294967296}),
+	)
+
+	assert.Equal(t,
+		[]string{"lvcreate", "--yes", "--wipesignatures", "y", "--name", "fast", "--type", "raid10", "--mirrors", "1", "--stripes", "2", "--extents", "100%FREE", "data"},
+		lvm.CreateLogicalVolumeArgs("data", lvm.LogicalVolumeOptions{Name: "fast", Type: "raid10", Mirrors: 1, Stripes: 2}),
+	)
+}
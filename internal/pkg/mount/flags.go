@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import (
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// mountFlags maps generic mount options to the mount(2) flags.
+var mountFlags = map[string]uintptr{
+	"ro":          unix.MS_RDONLY,
+	"nosuid":      unix.MS_NOSUID,
+	"nodev":       unix.MS_NODEV,
+	"noexec":      unix.MS_NOEXEC,
+	"sync":        unix.MS_SYNCHRONOUS,
+	"dirsync":     unix.MS_DIRSYNC,
+	"noatime":     unix.MS_NOATIME,
+	"nodiratime":  unix.MS_NODIRATIME,
+	"relatime":    unix.MS_RELATIME,
+	"strictatime": unix.MS_STRICTATIME,
+	"lazytime":    unix.MS_LAZYTIME,
+}
+
+// ParseOptions converts mount options in fstab format into mount(2) flags and
+// filesystem-specific data.
+//
+// Generic options (e.g. `noatime`) are converted to flags, all other options (e.g. `prjquota`)
+// are passed to the filesystem as data.
+func ParseOptions(options []string) (flags uintptr, data string) {
+	var dataOptions []string
+
+	for _, option := range options {
+		for _, opt := range strings.Split(option, ",") {
+			opt = strings.TrimSpace(opt)
+
+			if opt == "" || opt == "defaults" || opt == "rw" {
+				continue
+			}
+
+			if flag, ok := mountFlags[opt]; ok {
+				flags |= flag
+
+				continue
+			}
+
+			dataOptions = append(dataOptions, opt)
+		}
+	}
+
+	return flags, strings.Join(dataOptions, ",")
+}
@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/pkg/mount"
+)
+
+func TestParseOptions(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		options []string
+
+		expectedFlags uintptr
+		expectedData  string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:          "flags only",
+			options:       []string{"noatime", "nodev"},
+			expectedFlags: unix.MS_NOATIME | unix.MS_NODEV,
+		},
+		{
+			name:          "flags and data",
+			options:       []string{"noatime", "prjquota"},
+			expectedFlags: unix.MS_NOATIME,
+			expectedData:  "prjquota",
+		},
+		{
+			name:          "comma separated",
+			options:       []string{"defaults,noatime,prjquota", "discard", "rw"},
+			expectedFlags: unix.MS_NOATIME,
+			expectedData:  "prjquota,discard",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			flags, data := mount.ParseOptions(test.options)
+
+			assert.Equal(t, test.expectedFlags, flags)
+			assert.Equal(t, test.expectedData, data)
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	humanize "github.com/dustin/go-humanize"
+	"github.com/siderolabs/go-blockdevice/blockdevice"
+	"github.com/siderolabs/go-blockdevice/blockdevice/util"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// Growth describes the result of growing a partition to the free space after it.
+type Growth struct {
+	Partition    string
+	DevPath      string
+	MountPoint   string
+	Encrypted    bool
+	PreviousSize uint64
+	Size         uint64
+}
+
+// Grown returns true if the partition size changed.
+func (g *Growth) Grown() bool {
+	return g.Size > g.PreviousSize
+}
+
+// GrowPartition grows the partition to occupy the free space after it.
+//
+// The kernel is notified about the new partition size, so the partition might be in use.
+// Partition sizes in bytes before and after growing are returned.
+func GrowPartition(partPath string) (previousSize, size uint64, err error) {
+	devname, err := util.DevnameFromPartname(partPath)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	bd, err := blockdevice.Open("/dev/"+devname, blockdevice.WithExclusiveLock(true))
+	if err != nil {
+		return 0, 0, fmt.Errorf("error opening block device %q: %w", devname, err)
+	}
+
+	//nolint:errcheck
+	defer bd.Close()
+
+	pt, err := bd.PartitionTable()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// move the backup GPT header to the end of the disk, as the disk might have been enlarged
+	if err = pt.Repair(); err != nil {
+		return 0, 0, err
+	}
+
+	blockSize := uint64(pt.Header().LBA.LogicalBlockSize)
+
+	for _, partition := range pt.Partitions().Items() {
+		path, err := partition.Path()
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if path != partPath {
+			continue
+		}
+
+		previousSize = partition.Length() * blockSize
+
+		resized, err := pt.Resize(partition)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if !resized {
+			return previousSize, previousSize, nil
+		}
+
+		if err = pt.Write(); err != nil {
+			return 0, 0, err
+		}
+
+		return previousSize, partition.Length() * blockSize, nil
+	}
+
+	return 0, 0, fmt.Errorf("partition %q not found", partPath)
+}
+
+// Grow grows the mounted partition and its filesystem online.
+func (p *Point) Grow() (*Growth, error) {
+	if _, err := p.ResizePartition(); err != nil {
+		return nil, fmt.Errorf("error resizing partition: %w", err)
+	}
+
+	if err := p.GrowFilesystem(); err != nil {
+		return nil, fmt.Errorf("error resizing filesystem: %w", err)
+	}
+
+	return p.Growth(), nil
+}
+
+// UserDiskPoints returns mount points for the partitions of the user disks.
+//
+// If resize is set, the last partition of each disk which is sized to occupy the full disk
+// is marked to be grown on mount.
+func UserDiskPoints(disks []config.Disk, resize bool) (*Points, error) {
+	mountpoints := NewMountPoints()
+
+	for _, disk := range disks {
+		partitions := disk.Partitions()
+
+		for i, part := range partitions {
+			partname, err := util.PartPath(disk.Device(), i+1)
+			if err != nil {
+				return nil, err
+			}
+
+			var opts []Option
+
+			if resize && i == len(partitions)-1 && part.Size() == 0 {
+				opts = append(opts, WithFlags(Resize))
+			}
+
+			flags, data := ParseOptions(part.MountOptions())
+
+			mountpoints.Set(partname, NewMountPoint(partname, part.MountPoint(), part.Filesystem(), flags, data, opts...))
+		}
+	}
+
+	return mountpoints, nil
+}
+
+// GrowPartitions grows the mounted EPHEMERAL partition and user disk partitions online.
+//
+// Only the last partition of each user disk which is sized to occupy the full disk is grown.
+func GrowPartitions(ctx context.Context, r runtime.Runtime) ([]*Growth, error) {
+	var result []*Growth
+
+	mountpointsMutex.RLock()
+	ephemeral, ok := mountpoints[constants.EphemeralPartitionLabel]
+	mountpointsMutex.RUnlock()
+
+	if ok {
+		growth, err := ephemeral.Grow()
+		if err != nil {
+			return nil, fmt.Errorf("error growing %s: %w", constants.EphemeralPartitionLabel, err)
+		}
+
+		if err = ReportGrowth(ctx, r, growth); err != nil {
+			return nil, err
+		}
+
+		result = append(result, growth)
+	}
+
+	if r.Config() == nil {
+		return result, nil
+	}
+
+	userDisks, err := UserDiskPoints(r.Config().Machine().Disks(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	iter := userDisks.Iter()
+
+	for iter.Next() {
+		p := iter.Value()
+
+		if !p.MountFlags.Check(Resize) {
+			continue
+		}
+
+		mounted, err := p.IsMounted()
+		if err != nil {
+			return nil, err
+		}
+
+		// xfs_growfs requires the filesystem to be mounted
+		if !mounted {
+			continue
+		}
+
+		growth, err := p.Grow()
+		if err != nil {
+			return nil, fmt.Errorf("error growing %s: %w", p.Source(), err)
+		}
+
+		if err = ReportGrowth(ctx, r, growth); err != nil {
+			return nil, err
+		}
+
+		result = append(result, growth)
+	}
+
+	return result, iter.Err()
+}
+
+// ReportGrowth records the result of growing the partition as the PartitionGrowth resource
+// and publishes an event if the partition was grown.
+func ReportGrowth(ctx context.Context, r runtime.Runtime, growth *Growth) error {
+	if growth == nil {
+		return nil
+	}
+
+	res := block.NewPartitionGrowth(block.NamespaceName, growth.Partition)
+
+	update := func(res *block.PartitionGrowth) error {
+		*res.TypedSpec() = block.PartitionGrowthSpec{
+			DevPath:      growth.DevPath,
+			MountPoint:   growth.MountPoint,
+			Encrypted:    growth.Encrypted,
+			PreviousSize: growth.PreviousSize,
+			Size:         growth.Size,
+			PrettySize:   humanize.Bytes(growth.Size),
+			Grown:        growth.Grown(),
+		}
+
+		return nil
+	}
+
+	st := r.State().V1Alpha2().Resources()
+
+	if err := update(res); err != nil {
+		return err
+	}
+
+	if err := st.Create(ctx, res); err != nil {
+		if !state.IsConflictError(err) {
+			return fmt.Errorf("error creating partition growth resource: %w", err)
+		}
+
+		if _, err = safe.StateUpdateWithConflicts(ctx, st, res.Metadata(), update); err != nil {
+			return fmt.Errorf("error updating partition growth resource: %w", err)
+		}
+	}
+
+	if growth.Grown() {
+		r.Events().Publish(ctx, &machine.PartitionGrowthEvent{
+			Partition:    growth.Partition,
+			DevPath:      growth.DevPath,
+			MountPoint:   growth.MountPoint,
+			Encrypted:    growth.Encrypted,
+			PreviousSize: growth.PreviousSize,
+			Size:         growth.Size,
+		})
+	}
+
+	return nil
+}
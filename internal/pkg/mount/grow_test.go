@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/gen/slices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestUserDiskPoints(t *testing.T) {
+	t.Parallel()
+
+	disks := slices.Map([]*v1alpha1.MachineDisk{
+		{
+			DeviceName: "/dev/sdb",
+			DiskPartitions: []*v1alpha1.DiskPartition{
+				{
+					DiskSize:       1024 * 1024 * 1024,
+					DiskMountPoint: "/var/mnt/first",
+				},
+				{
+					DiskMountPoint:   "/var/mnt/rest",
+					DiskFilesystem:   "ext4",
+					DiskMountOptions: []string{"noatime", "prjquota"},
+				},
+			},
+		},
+		{
+			DeviceName: "/dev/nvme0n1",
+			DiskPartitions: []*v1alpha1.DiskPartition{
+				{
+					DiskSize:       1024 * 1024 * 1024,
+					DiskMountPoint: "/var/mnt/sized",
+				},
+			},
+		},
+	}, func(d *v1alpha1.MachineDisk) config.Disk { return d })
+
+	for _, resize := range []bool{false, true} {
+		points, err := mount.UserDiskPoints(disks, resize)
+		require.NoError(t, err)
+
+		first, ok := points.Get("/dev/sdb1")
+		require.True(t, ok)
+
+		assert.Equal(t, "/var/mnt/first", first.Target())
+		assert.Equal(t, "xfs", first.Fstype())
+		assert.Equal(t, uintptr(unix.MS_NOATIME), first.Flags())
+		assert.False(t, first.MountFlags.Check(mount.Resize))
+
+		rest, ok := points.Get("/dev/sdb2")
+		require.True(t, ok)
+
+		assert.Equal(t, "/var/mnt/rest", rest.Target())
+		assert.Equal(t, "ext4", rest.Fstype())
+		assert.Equal(t, "prjquota", rest.Data())
+		assert.Equal(t, resize, rest.MountFlags.Check(mount.Resize))
+
+		// partition with explicit size is never grown
+		sized, ok := points.Get("/dev/nvme0n1p1")
+		require.True(t, ok)
+
+		assert.False(t, sized.MountFlags.Check(mount.Resize))
+	}
+}
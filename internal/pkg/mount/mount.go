@@ -12,9 +12,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/siderolabs/go-blockdevice/blockdevice"
 	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem"
-	"github.com/siderolabs/go-blockdevice/blockdevice/util"
 	"github.com/talos-systems/go-retry/retry"
 	"golang.org/x/sys/unix"
 
@@ -176,6 +174,10 @@ type Point struct {
 	flags  uintptr
 	data   string
 	*Options
+
+	// partition is the original source, source might be replaced with the encrypted mapping by the hooks
+	partition string
+	growth    *Growth
 }
 
 // PointMap represents a unique set of mount points.
@@ -192,12 +194,13 @@ func NewMountPoint(source, target, fstype string, flags uintptr, data string, se
 	opts := NewDefaultOptions(setters...)
 
 	p := &Point{
-		source:  source,
-		target:  target,
-		fstype:  fstype,
-		flags:   flags,
-		data:    data,
-		Options: opts,
+		source:    source,
+		target:    target,
+		fstype:    fstype,
+		flags:     flags,
+		data:      data,
+		Options:   opts,
+		partition: source,
 	}
 
 	if p.Prefix != "" {
@@ -342,53 +345,52 @@ func (p *Point) Move(prefix string) (err error) {
 }
 
 // ResizePartition resizes a partition to the maximum size allowed.
+//
+// The result is available via the Growth method.
 func (p *Point) ResizePartition() (resized bool, err error) {
-	var devname string
-
-	if devname, err = util.DevnameFromPartname(p.Source()); err != nil {
-		return false, err
-	}
-
-	bd, err := blockdevice.Open("/dev/"+devname, blockdevice.WithExclusiveLock(true))
-	if err != nil {
-		return false, fmt.Errorf("error opening block device %q: %w", devname, err)
-	}
-
-	//nolint:errcheck
-	defer bd.Close()
-
-	pt, err := bd.PartitionTable()
+	previousSize, size, err := GrowPartition(p.partition)
 	if err != nil {
 		return false, err
 	}
 
-	if err := pt.Repair(); err != nil {
-		return false, err
+	p.growth = &Growth{
+		Partition:    filepath.Base(p.partition),
+		DevPath:      p.partition,
+		MountPoint:   p.target,
+		Encrypted:    p.Encryption != nil,
+		PreviousSize: previousSize,
+		Size:         size,
 	}
 
-	for _, partition := range pt.Partitions().Items() {
-		if partition.Name == constants.EphemeralPartitionLabel {
-			resized, err := pt.Resize(partition)
-			if err != nil {
-				return false, err
-			}
-
-			if !resized {
-				return false, nil
-			}
-		}
+	if !p.growth.Grown() {
+		return false, nil
 	}
 
-	if err := pt.Write(); err != nil {
-		return false, err
+	for _, hook := range p.Options.PostResizeHooks {
+		if err = hook(p); err != nil {
+			return true, err
+		}
 	}
 
 	return true, nil
 }
 
+// Growth returns the result of the last partition resize, if any.
+func (p *Point) Growth() *Growth {
+	return p.growth
+}
+
 // GrowFilesystem grows a partition's filesystem to the maximum size allowed.
 // NB: An XFS partition MUST be mounted, or this will fail.
 func (p *Point) GrowFilesystem() (err error) {
+	if p.Fstype() == makefs.FilesystemTypeExt4 {
+		if err = makefs.Ext4Grow(p.Source()); err != nil {
+			return fmt.Errorf("resize2fs: %w", err)
+		}
+
+		return nil
+	}
+
 	if err = makefs.XFSGrow(p.Target()); err != nil {
 		return fmt.Errorf("xfs_growfs: %w", err)
 	}
@@ -44,6 +44,7 @@ type Options struct {
 	MountFlags       Flags
 	PreMountHooks    []Hook
 	PostUnmountHooks []Hook
+	PostResizeHooks  []Hook
 	Encryption       config.Encryption
 	Logger           *log.Logger
 }
@@ -89,6 +90,13 @@ func WithPostUnmountHooks(hooks ...Hook) Option {
 	}
 }
 
+// WithPostResizeHooks adds functions to be called after the partition is resized.
+func WithPostResizeHooks(hooks ...Hook) Option {
+	return func(args *Options) {
+		args.PostResizeHooks = hooks
+	}
+}
+
 // WithEncryptionConfig partition encryption configuration.
 func WithEncryptionConfig(cfg config.Encryption) Option {
 	return func(args *Options) {
@@ -114,6 +122,7 @@ func NewDefaultOptions(setters ...Option) *Options {
 		MountFlags:       0,
 		PreMountHooks:    []Hook{},
 		PostUnmountHooks: []Hook{},
+		PostResizeHooks:  []Hook{},
 	}
 
 	for _, setter := range setters {
@@ -139,6 +139,11 @@ func SystemMountPointForLabel(device *blockdevice.BlockDevice, label string, opt
 					return encryptionHandler.Close()
 				},
 			),
+			WithPostResizeHooks(
+				func(p *Point) error {
+					return encryptionHandler.Resize()
+				},
+			),
 		)
 	}
 
@@ -224,6 +229,10 @@ func SystemPartitionMount(r runtime.Runtime, logger *log.Logger, label string, o
 		return
 	}
 
+	if err = ReportGrowth(context.Background(), r, mountpoint.Growth()); err != nil {
+		return err
+	}
+
 	// record mount as the resource
 	mountStatus := runtimeres.NewMountStatus(v1alpha1.NamespaceName, label)
 	mountStatus.TypedSpec().Source = mountpoint.Source()
@@ -16,10 +16,38 @@ type Device struct {
 
 	Vendor  string
 	Product string
+
+	// Fields below are filled in only by List.
+
+	// Address is the PCI bus address, e.g. 0000:00:1f.2.
+	Address string
+
+	// ClassCode is the 24-bit class code: class, subclass and programming interface.
+	ClassCode uint32
+	Class     string
+	Subclass  string
+
+	Driver     string
+	IOMMUGroup string
+}
+
+// ClassID returns PCI class ID.
+func (d *Device) ClassID() uint8 {
+	return uint8(d.ClassCode >> 16)
+}
+
+// SubclassID returns PCI subclass ID.
+func (d *Device) SubclassID() uint8 {
+	return uint8(d.ClassCode >> 8)
 }
 
 // LookupDB looks up device info in the PCI database.
 func (d *Device) LookupDB() {
 	d.Vendor, _ = pcidb.LookupVendor(d.VendorID)
 	d.Product, _ = pcidb.LookupProduct(d.VendorID, d.ProductID)
+
+	if d.Address != "" {
+		d.Class, _ = pcidb.LookupClass(d.ClassID())
+		d.Subclass, _ = pcidb.LookupSubclass(d.ClassID(), d.SubclassID())
+	}
 }
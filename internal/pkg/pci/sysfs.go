@@ -10,30 +10,45 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"path/filepath"
 	"strconv"
 )
 
-const sysfsPath = "/sys/bus/pci/devices/%s/%s"
+// DevicesPath is the path to the PCI devices in sysfs.
+const DevicesPath = "/sys/bus/pci/devices"
 
-func readID(busPath, name string) (uint16, error) {
-	contents, err := os.ReadFile(fmt.Sprintf(sysfsPath, busPath, name))
+func readUint(path string, bitSize int) (uint64, error) {
+	contents, err := os.ReadFile(path)
 	if err != nil {
 		return 0, err
 	}
 
-	v, err := strconv.ParseUint(string(bytes.TrimSpace(contents)), 0, 16)
+	return strconv.ParseUint(string(bytes.TrimSpace(contents)), 0, bitSize)
+}
+
+func readID(devicePath, name string) (uint16, error) {
+	v, err := readUint(filepath.Join(devicePath, name), 16)
 
 	return uint16(v), err
 }
 
-// SysfsDeviceInfo looks up vendor and product ID from sysfs.
-func SysfsDeviceInfo(busPath string) (*Device, error) {
+// linkName returns the base name of the symlink target, or empty string if the link doesn't exist.
+func linkName(path string) string {
+	target, err := os.Readlink(path)
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Base(target)
+}
+
+func sysfsDeviceInfo(devicePath string) (*Device, error) {
 	var (
 		d   Device
 		err error
 	)
 
-	d.ProductID, err = readID(busPath, "device")
+	d.ProductID, err = readID(devicePath, "device")
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
 			return nil, nil
@@ -42,7 +57,7 @@ func SysfsDeviceInfo(busPath string) (*Device, error) {
 		return nil, err
 	}
 
-	d.VendorID, err = readID(busPath, "vendor")
+	d.VendorID, err = readID(devicePath, "vendor")
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
 			return nil, nil
@@ -53,3 +68,49 @@ func SysfsDeviceInfo(busPath string) (*Device, error) {
 
 	return &d, err
 }
+
+// SysfsDeviceInfo looks up vendor and product ID from sysfs.
+func SysfsDeviceInfo(busPath string) (*Device, error) {
+	return sysfsDeviceInfo(filepath.Join(DevicesPath, busPath))
+}
+
+// List all PCI devices found in the sysfs devices path (usually DevicesPath).
+//
+// Devices are returned with the names looked up in the PCI database.
+func List(devicesPath string) ([]Device, error) {
+	entries, err := os.ReadDir(devicesPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading PCI devices: %w", err)
+	}
+
+	devices := make([]Device, 0, len(entries))
+
+	for _, entry := range entries {
+		devicePath := filepath.Join(devicesPath, entry.Name())
+
+		d, err := sysfsDeviceInfo(devicePath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading PCI device %q: %w", entry.Name(), err)
+		}
+
+		if d == nil {
+			continue
+		}
+
+		class, err := readUint(filepath.Join(devicePath, "class"), 32)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("error reading PCI device %q class: %w", entry.Name(), err)
+		}
+
+		d.Address = entry.Name()
+		d.ClassCode = uint32(class)
+		d.Driver = linkName(filepath.Join(devicePath, "driver"))
+		d.IOMMUGroup = linkName(filepath.Join(devicePath, "iommu_group"))
+
+		d.LookupDB()
+
+		devices = append(devices, *d)
+	}
+
+	return devices, nil
+}
@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pci_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/pci"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	devicesPath := t.TempDir()
+
+	write := func(device, name, contents string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(devicesPath, device), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(devicesPath, device, name), []byte(contents+"\n"), 0o644))
+	}
+
+	link := func(device, name, target string) {
+		require.NoError(t, os.Symlink(target, filepath.Join(devicesPath, device, name)))
+	}
+
+	write("0000:03:00.0", "vendor", "0x8086")
+	write("0000:03:00.0", "device", "0x1533")
+	write("0000:03:00.0", "class", "0x020000")
+	link("0000:03:00.0", "driver", "../../../bus/pci/drivers/igb")
+	link("0000:03:00.0", "iommu_group", "../../../kernel/iommu_groups/15")
+
+	write("0000:04:00.0", "vendor", "0x144d")
+	write("0000:04:00.0", "device", "0xffff")
+	write("0000:04:00.0", "class", "0x010802")
+
+	// not a device
+	require.NoError(t, os.MkdirAll(filepath.Join(devicesPath, "power"), 0o755))
+
+	devices, err := pci.List(devicesPath)
+	require.NoError(t, err)
+
+	require.Len(t, devices, 2)
+
+	assert.Equal(t, pci.Device{
+		VendorID:   0x8086,
+		ProductID:  0x1533,
+		Vendor:     "Intel Corporation",
+		Product:    "I210 Gigabit Network Connection",
+		Address:    "0000:03:00.0",
+		ClassCode:  0x020000,
+		Class:      "Network controller",
+		Subclass:   "Ethernet controller",
+		Driver:     "igb",
+		IOMMUGroup: "15",
+	}, devices[0])
+
+	assert.Equal(t, "0000:04:00.0", devices[1].Address)
+	assert.Equal(t, uint8(0x01), devices[1].ClassID())
+	assert.Equal(t, uint8(0x08), devices[1].SubclassID())
+	assert.Equal(t, "Mass storage controller", devices[1].Class)
+	assert.Equal(t, "Non-Volatile memory controller", devices[1].Subclass)
+	assert.Empty(t, devices[1].Product)
+	assert.Empty(t, devices[1].Driver)
+	assert.Empty(t, devices[1].IOMMUGroup)
+}
@@ -0,0 +1,246 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smart
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	sgIO           = 0x2285
+	sgDxferFromDev = -3
+	sgTimeoutMsec  = 5000
+
+	ataPassThrough16 = 0x85
+	ataProtocolPIOIn = 4
+	ataCmdSMART      = 0xb0
+
+	ataSMARTReadData       = 0xd0
+	ataSMARTReadThresholds = 0xd1
+
+	ataSMARTDataSize       = 512
+	ataSMARTAttributeCount = 30
+	ataSMARTAttributeSize  = 12
+)
+
+// ATA attribute IDs Talos is interested in.
+const (
+	AttributeReallocatedSectors   = 5
+	AttributePowerOnHours         = 9
+	AttributePowerCycles          = 12
+	AttributeAirflowTemperature   = 190
+	AttributeTemperature          = 194
+	AttributePendingSectors       = 197
+	AttributeOfflineUncorrectable = 198
+)
+
+var attributeNames = map[uint8]string{
+	AttributeReallocatedSectors:   "Reallocated_Sector_Ct",
+	AttributePowerOnHours:         "Power_On_Hours",
+	AttributePowerCycles:          "Power_Cycle_Count",
+	AttributeAirflowTemperature:   "Airflow_Temperature_Cel",
+	AttributeTemperature:          "Temperature_Celsius",
+	AttributePendingSectors:       "Current_Pending_Sector",
+	AttributeOfflineUncorrectable: "Offline_Uncorrectable",
+}
+
+// Attribute is an ATA S.M.A.R.T. attribute.
+type Attribute struct {
+	ID        uint8
+	Value     uint8
+	Worst     uint8
+	Threshold uint8
+	Raw       uint64
+}
+
+// Name returns the well-known name of the attribute.
+func (attr Attribute) Name() string {
+	if name, ok := attributeNames[attr.ID]; ok {
+		return name
+	}
+
+	return "Unknown_Attribute"
+}
+
+// Failing returns true if the normalized value of the attribute is at or below the threshold.
+func (attr Attribute) Failing() bool {
+	return attr.Threshold != 0 && attr.Value <= attr.Threshold
+}
+
+// sgIOHdr is struct sg_io_hdr from scsi/sg.h.
+type sgIOHdr struct {
+	InterfaceID    int32
+	DxferDirection int32
+	CmdLen         uint8
+	MxSbLen        uint8
+	IovecCount     uint16
+	DxferLen       uint32
+	Dxferp         *byte
+	Cmdp           *byte
+	Sbp            *byte
+	Timeout        uint32
+	Flags          uint32
+	PackID         int32
+	UsrPtr         *byte
+	Status         uint8
+	MaskedStatus   uint8
+	MsgStatus      uint8
+	SbLenWr        uint8
+	HostStatus     uint16
+	DriverStatus   uint16
+	Resid          int32
+	Duration       uint32
+	Info           uint32
+}
+
+// ataSMARTCommand issues SMART command with a single sector data-in transfer via ATA PASS-THROUGH(16).
+func ataSMARTCommand(f *os.File, feature uint8) ([]byte, error) {
+	buf := make([]byte, ataSMARTDataSize)
+	sense := make([]byte, 32)
+
+	cdb := []byte{
+		ataPassThrough16,
+		ataProtocolPIOIn << 1,
+		0x0e, // T_DIR = from device, BYT_BLOK = 1, T_LENGTH = sector count field
+		0, feature,
+		0, 1, // sector count
+		0, 0, // LBA low
+		0, 0x4f, // LBA mid
+		0, 0xc2, // LBA high
+		0, // device
+		ataCmdSMART,
+		0, // control
+	}
+
+	hdr := sgIOHdr{
+		InterfaceID:    'S',
+		DxferDirection: sgDxferFromDev,
+		CmdLen:         uint8(len(cdb)),
+		MxSbLen:        uint8(len(sense)),
+		DxferLen:       uint32(len(buf)),
+		Dxferp:         &buf[0],
+		Cmdp:           &cdb[0],
+		Sbp:            &sense[0],
+		Timeout:        sgTimeoutMsec,
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), sgIO, uintptr(unsafe.Pointer(&hdr))); errno != 0 {
+		return nil, fmt.Errorf("SG_IO failed: %w", errno)
+	}
+
+	if hdr.Status != 0 || hdr.HostStatus != 0 || hdr.DriverStatus != 0 {
+		return nil, fmt.Errorf("%w: SMART command failed: status 0x%02x, host status 0x%02x, driver status 0x%02x",
+			ErrNotSupported, hdr.Status, hdr.HostStatus, hdr.DriverStatus)
+	}
+
+	return buf, nil
+}
+
+func readATA(devPath string) (*Health, error) {
+	f, err := os.OpenFile(devPath, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	data, err := ataSMARTCommand(f, ataSMARTReadData)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholds, err := ataSMARTCommand(f, ataSMARTReadThresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseATASMART(data, thresholds)
+}
+
+// ParseATASMART parses ATA SMART READ DATA and SMART READ THRESHOLDS responses.
+//
+//nolint:gocyclo
+func ParseATASMART(data, thresholds []byte) (*Health, error) {
+	for _, buf := range [][]byte{data, thresholds} {
+		if len(buf) < ataSMARTDataSize {
+			return nil, fmt.Errorf("SMART data is too short: %d bytes", len(buf))
+		}
+
+		var sum uint8
+
+		for _, b := range buf[:ataSMARTDataSize] {
+			sum += b
+		}
+
+		if sum != 0 {
+			return nil, errors.New("SMART data checksum mismatch")
+		}
+	}
+
+	limits := map[uint8]uint8{}
+
+	for i := 0; i < ataSMARTAttributeCount; i++ {
+		entry := thresholds[2+i*ataSMARTAttributeSize:]
+
+		if entry[0] != 0 {
+			limits[entry[0]] = entry[1]
+		}
+	}
+
+	health := &Health{
+		Protocol: ProtocolATA,
+	}
+
+	for i := 0; i < ataSMARTAttributeCount; i++ {
+		entry := data[2+i*ataSMARTAttributeSize:]
+
+		if entry[0] == 0 {
+			continue
+		}
+
+		raw := make([]byte, 8)
+		copy(raw, entry[5:11])
+
+		attr := Attribute{
+			ID:        entry[0],
+			Value:     entry[3],
+			Worst:     entry[4],
+			Threshold: limits[entry[0]],
+			Raw:       binary.LittleEndian.Uint64(raw),
+		}
+
+		switch attr.ID {
+		case AttributeReallocatedSectors:
+			health.ReallocatedSectors = attr.Raw & 0xffffffff
+		case AttributePowerOnHours:
+			health.PowerOnHours = attr.Raw & 0xffffffff
+		case AttributePowerCycles:
+			health.PowerCycles = attr.Raw & 0xffffffff
+		case AttributeAirflowTemperature:
+			// prefer Temperature_Celsius if both are reported
+			if health.Temperature == 0 {
+				health.Temperature = int(attr.Raw & 0xff)
+			}
+		case AttributeTemperature:
+			health.Temperature = int(attr.Raw & 0xff)
+		case AttributePendingSectors:
+			health.PendingSectors = attr.Raw & 0xffffffff
+		case AttributeOfflineUncorrectable:
+			health.OfflineUncorrectable = attr.Raw & 0xffffffff
+		}
+
+		if attr.Failing() {
+			health.FailingAttributes = append(health.FailingAttributes, attr)
+		}
+	}
+
+	return health, nil
+}
@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smart
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// NVME_IOCTL_ADMIN_CMD = _IOWR('N', 0x41, struct nvme_admin_cmd).
+	nvmeIoctlAdminCmd = 0xc0484e41
+
+	nvmeAdminGetLogPage  = 0x02
+	nvmeLogSMARTHealth   = 0x02
+	nvmeNamespaceAll     = 0xffffffff
+	nvmeSMARTLogSize     = 512
+	nvmeAdminTimeoutMsec = 5000
+)
+
+// nvmeAdminCmd is struct nvme_admin_cmd from linux/nvme_ioctl.h.
+type nvmeAdminCmd struct {
+	Opcode      uint8
+	Flags       uint8
+	Rsvd1       uint16
+	NSID        uint32
+	Cdw2        uint32
+	Cdw3        uint32
+	Metadata    uint64
+	Addr        uint64
+	MetadataLen uint32
+	DataLen     uint32
+	Cdw10       uint32
+	Cdw11       uint32
+	Cdw12       uint32
+	Cdw13       uint32
+	Cdw14       uint32
+	Cdw15       uint32
+	TimeoutMsec uint32
+	Result      uint32
+}
+
+func readNVMe(devPath string) (*Health, error) {
+	f, err := os.OpenFile(devPath, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	buf := make([]byte, nvmeSMARTLogSize)
+
+	cmd := nvmeAdminCmd{
+		Opcode:      nvmeAdminGetLogPage,
+		NSID:        nvmeNamespaceAll,
+		Addr:        uint64(uintptr(unsafe.Pointer(&buf[0]))),
+		DataLen:     uint32(len(buf)),
+		Cdw10:       nvmeLogSMARTHealth | (uint32(len(buf)/4-1) << 16),
+		TimeoutMsec: nvmeAdminTimeoutMsec,
+	}
+
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), nvmeIoctlAdminCmd, uintptr(unsafe.Pointer(&cmd)))
+
+	runtime.KeepAlive(buf)
+
+	if errno != 0 {
+		return nil, fmt.Errorf("error reading NVMe SMART log: %w", errno)
+	}
+
+	return ParseNVMeSMARTLog(buf)
+}
+
+// ParseNVMeSMARTLog parses NVMe SMART/health information log page (log identifier 02h).
+func ParseNVMeSMARTLog(buf []byte) (*Health, error) {
+	if len(buf) < nvmeSMARTLogSize {
+		return nil, fmt.Errorf("NVMe SMART log is too short: %d bytes", len(buf))
+	}
+
+	health := &Health{
+		Protocol:                ProtocolNVMe,
+		CriticalWarning:         buf[0],
+		AvailableSpare:          buf[3],
+		AvailableSpareThreshold: buf[4],
+		PercentageUsed:          buf[5],
+		PowerCycles:             uint128(buf[112:128]),
+		PowerOnHours:            uint128(buf[128:144]),
+		UnsafeShutdowns:         uint128(buf[144:160]),
+		MediaErrors:             uint128(buf[160:176]),
+	}
+
+	// composite temperature is reported in Kelvin
+	if kelvin := binary.LittleEndian.Uint16(buf[1:3]); kelvin > 0 {
+		health.Temperature = int(kelvin) - 273
+	}
+
+	return health, nil
+}
+
+// uint128 decodes 128-bit little-endian counter saturating it to 64 bits.
+func uint128(b []byte) uint64 {
+	if binary.LittleEndian.Uint64(b[8:16]) != 0 {
+		return math.MaxUint64
+	}
+
+	return binary.LittleEndian.Uint64(b[0:8])
+}
@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package smart reads ATA S.M.A.R.T. attributes and NVMe SMART/health information log.
+package smart
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Protocol is the protocol used to query the device health.
+type Protocol string
+
+// Supported protocols.
+const (
+	ProtocolATA  Protocol = "ata"
+	ProtocolNVMe Protocol = "nvme"
+)
+
+// ErrNotSupported is returned when the device doesn't support health reporting.
+var ErrNotSupported = errors.New("health reporting is not supported for the device")
+
+// Health describes device health as reported by the device itself.
+//
+// Fields which are not reported by the device (or not applicable to the protocol) are left zero.
+type Health struct {
+	Protocol Protocol
+
+	// Temperature in degrees Celsius.
+	Temperature  int
+	PowerOnHours uint64
+	PowerCycles  uint64
+
+	// ATA attributes.
+	ReallocatedSectors   uint64
+	PendingSectors       uint64
+	OfflineUncorrectable uint64
+	// FailingAttributes is the list of attributes with the normalized value at or below the threshold.
+	FailingAttributes []Attribute
+
+	// NVMe SMART/health information.
+	CriticalWarning         uint8
+	AvailableSpare          uint8
+	AvailableSpareThreshold uint8
+	PercentageUsed          uint8
+	MediaErrors             uint64
+	UnsafeShutdowns         uint64
+}
+
+// Thresholds configure when health warnings are raised in addition to the thresholds reported by the device.
+type Thresholds struct {
+	// Temperature in degrees Celsius, zero disables the check.
+	Temperature int
+	// PercentageUsed of the NVMe device endurance, zero disables the check.
+	PercentageUsed uint8
+}
+
+// DefaultThresholds are used by Talos unless overridden.
+var DefaultThresholds = Thresholds{
+	Temperature:    70,
+	PercentageUsed: 90,
+}
+
+// NVMe critical warning bits.
+var nvmeCriticalWarnings = []string{
+	"available spare is below threshold",
+	"temperature is outside of the threshold",
+	"reliability is degraded due to media errors",
+	"media is in read-only mode",
+	"volatile memory backup device has failed",
+	"persistent memory region is read-only",
+}
+
+// Warning describes a single health warning.
+type Warning struct {
+	// Key identifies the warning (attribute or threshold), it doesn't change when the reported values change.
+	Key string
+	// Message is the human-readable description of the warning with the current values.
+	Message string
+}
+
+// Warnings returns the list of health warnings.
+//
+// Empty list means the device is healthy.
+func (h *Health) Warnings(thresholds Thresholds) []Warning {
+	var warnings []Warning
+
+	for bit, warning := range nvmeCriticalWarnings {
+		if h.CriticalWarning&(1<<bit) != 0 {
+			warnings = append(warnings, Warning{fmt.Sprintf("critical_warning_%d", bit), "critical warning: " + warning})
+		}
+	}
+
+	for _, attr := range h.FailingAttributes {
+		warnings = append(warnings, Warning{
+			fmt.Sprintf("attribute_%d", attr.ID),
+			fmt.Sprintf("attribute %d (%s) is failing: value %d, threshold %d", attr.ID, attr.Name(), attr.Value, attr.Threshold),
+		})
+	}
+
+	if h.ReallocatedSectors > 0 {
+		warnings = append(warnings, Warning{"reallocated_sectors", fmt.Sprintf("%d reallocated sectors", h.ReallocatedSectors)})
+	}
+
+	if h.PendingSectors > 0 {
+		warnings = append(warnings, Warning{"pending_sectors", fmt.Sprintf("%d pending sectors", h.PendingSectors)})
+	}
+
+	if h.OfflineUncorrectable > 0 {
+		warnings = append(warnings, Warning{"offline_uncorrectable", fmt.Sprintf("%d offline uncorrectable sectors", h.OfflineUncorrectable)})
+	}
+
+	if h.MediaErrors > 0 {
+		warnings = append(warnings, Warning{"media_errors", fmt.Sprintf("%d media errors", h.MediaErrors)})
+	}
+
+	if thresholds.PercentageUsed > 0 && h.PercentageUsed >= thresholds.PercentageUsed {
+		warnings = append(warnings, Warning{"percentage_used", fmt.Sprintf("%d%% of endurance used", h.PercentageUsed)})
+	}
+
+	if thresholds.Temperature > 0 && h.Temperature >= thresholds.Temperature {
+		warnings = append(warnings, Warning{"temperature", fmt.Sprintf("temperature %d°C", h.Temperature)})
+	}
+
+	return warnings
+}
+
+// Read the health information of the block device using the specified protocol.
+func Read(devPath string, protocol Protocol) (*Health, error) {
+	switch protocol {
+	case ProtocolNVMe:
+		return readNVMe(devPath)
+	case ProtocolATA:
+		return readATA(devPath)
+	default:
+		return nil, ErrNotSupported
+	}
+}
@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smart_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/smart"
+)
+
+func TestParseNVMeSMARTLog(t *testing.T) {
+	t.Parallel()
+
+	buf := make([]byte, 512)
+
+	buf[0] = 0x04 // reliability degraded
+	binary.LittleEndian.PutUint16(buf[1:3], 318)
+	buf[3] = 100
+	buf[4] = 10
+	buf[5] = 93
+	binary.LittleEndian.PutUint64(buf[112:], 150)
+	binary.LittleEndian.PutUint64(buf[128:], 12345)
+	binary.LittleEndian.PutUint64(buf[144:], 7)
+	binary.LittleEndian.PutUint64(buf[160:], 3)
+
+	health, err := smart.ParseNVMeSMARTLog(buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, &smart.Health{
+		Protocol:                smart.ProtocolNVMe,
+		Temperature:             45,
+		PowerOnHours:            12345,
+		PowerCycles:             150,
+		CriticalWarning:         0x04,
+		AvailableSpare:          100,
+		AvailableSpareThreshold: 10,
+		PercentageUsed:          93,
+		MediaErrors:             3,
+		UnsafeShutdowns:         7,
+	}, health)
+
+	assert.Equal(t, []smart.Warning{
+		{Key: "critical_warning_2", Message: "critical warning: reliability is degraded due to media errors"},
+		{Key: "media_errors", Message: "3 media errors"},
+		{Key: "percentage_used", Message: "93% of endurance used"},
+	}, health.Warnings(smart.DefaultThresholds))
+
+	_, err = smart.ParseNVMeSMARTLog(buf[:64])
+	assert.Error(t, err)
+}
+
+func ataAttribute(buf []byte, idx int, id, value, worst uint8, raw uint64) {
+	entry := buf[2+idx*12:]
+
+	entry[0] = id
+	entry[3] = value
+	entry[4] = worst
+
+	rawBuf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(rawBuf, raw)
+	copy(entry[5:11], rawBuf)
+}
+
+func ataThreshold(buf []byte, idx int, id, threshold uint8) {
+	entry := buf[2+idx*12:]
+
+	entry[0] = id
+	entry[1] = threshold
+}
+
+func ataChecksum(buf []byte) {
+	var sum uint8
+
+	for _, b := range buf[:511] {
+		sum += b
+	}
+
+	buf[511] = -sum
+}
+
+func TestParseATASMART(t *testing.T) {
+	t.Parallel()
+
+	data := make([]byte, 512)
+	thresholds := make([]byte, 512)
+
+	ataAttribute(data, 0, smart.AttributeReallocatedSectors, 100, 100, 0)
+	ataAttribute(data, 1, smart.AttributePowerOnHours, 71, 71, 25630)
+	ataAttribute(data, 2, smart.AttributePowerCycles, 100, 100, 42)
+	ataAttribute(data, 3, smart.AttributeAirflowTemperature, 62, 50, 38)
+	// temperature attribute encodes min/max in the upper raw bytes
+	ataAttribute(data, 4, smart.AttributeTemperature, 38, 50, 0x0012_0028_0027)
+	ataAttribute(data, 5, smart.AttributePendingSectors, 100, 100, 8)
+	ataAttribute(data, 6, 184, 1, 1, 12) // End-to-End_Error
+
+	ataThreshold(thresholds, 0, smart.AttributeReallocatedSectors, 10)
+	ataThreshold(thresholds, 1, smart.AttributePowerOnHours, 0)
+	ataThreshold(thresholds, 2, smart.AttributePowerCycles, 20)
+	ataThreshold(thresholds, 3, smart.AttributeAirflowTemperature, 40)
+	ataThreshold(thresholds, 4, smart.AttributeTemperature, 0)
+	ataThreshold(thresholds, 5, smart.AttributePendingSectors, 0)
+	ataThreshold(thresholds, 6, 184, 97)
+
+	ataChecksum(data)
+	ataChecksum(thresholds)
+
+	health, err := smart.ParseATASMART(data, thresholds)
+	require.NoError(t, err)
+
+	assert.Equal(t, smart.ProtocolATA, health.Protocol)
+	assert.Equal(t, 39, health.Temperature)
+	assert.EqualValues(t, 25630, health.PowerOnHours)
+	assert.EqualValues(t, 42, health.PowerCycles)
+	assert.EqualValues(t, 0, health.ReallocatedSectors)
+	assert.EqualValues(t, 8, health.PendingSectors)
+
+	require.Len(t, health.FailingAttributes, 1)
+	assert.EqualValues(t, 184, health.FailingAttributes[0].ID)
+
+	assert.Equal(t, []smart.Warning{
+		{Key: "attribute_184", Message: "attribute 184 (Unknown_Attribute) is failing: value 1, threshold 97"},
+		{Key: "pending_sectors", Message: "8 pending sectors"},
+	}, health.Warnings(smart.DefaultThresholds))
+
+	data[100]++
+
+	_, err = smart.ParseATASMART(data, thresholds)
+	assert.Error(t, err)
+}
+
+func TestWarningsThresholds(t *testing.T) {
+	t.Parallel()
+
+	health := smart.Health{
+		Temperature:    72,
+		PercentageUsed: 50,
+	}
+
+	assert.Equal(t, []smart.Warning{{Key: "temperature", Message: "temperature 72°C"}}, health.Warnings(smart.DefaultThresholds))
+	assert.Empty(t, health.Warnings(smart.Thresholds{}))
+	assert.Equal(t, []smart.Warning{{Key: "percentage_used", Message: "50% of endurance used"}}, health.Warnings(smart.Thresholds{PercentageUsed: 50}))
+}
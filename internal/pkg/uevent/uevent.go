@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package uevent implements watching for device events (uevents) broadcast by the kernel and udev.
+package uevent
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+// Netlink multicast groups of NETLINK_KOBJECT_UEVENT.
+const (
+	// GroupKernel receives events as soon as the kernel emits them.
+	GroupKernel = 1
+	// GroupUdev receives events once udev has processed them (udev database is updated).
+	GroupUdev = 2
+)
+
+const (
+	libudevPrefix = "libudev\x00"
+	libudevMagic  = 0xfeedcafe
+)
+
+// Event is a parsed uevent as a set of properties (ACTION, SUBSYSTEM, DEVNAME, etc.).
+type Event map[string]string
+
+// Action of the event (add, remove, change, ...).
+func (e Event) Action() string {
+	return e["ACTION"]
+}
+
+// Subsystem of the device.
+func (e Event) Subsystem() string {
+	return e["SUBSYSTEM"]
+}
+
+// Parse the uevent message in either kernel or libudev format.
+func Parse(msg []byte) (Event, error) {
+	var properties []byte
+
+	switch {
+	case bytes.HasPrefix(msg, []byte(libudevPrefix)):
+		// struct udev_monitor_netlink_header: prefix, magic (big-endian),
+		// header_size, properties_off, properties_len (host byte order), ...
+		if len(msg) < 24 {
+			return nil, errors.New("libudev message is too short")
+		}
+
+		if binary.BigEndian.Uint32(msg[8:12]) != libudevMagic {
+			return nil, errors.New("libudev message magic mismatch")
+		}
+
+		off := binary.LittleEndian.Uint32(msg[16:20])
+		length := binary.LittleEndian.Uint32(msg[20:24])
+
+		if uint64(off)+uint64(length) > uint64(len(msg)) {
+			return nil, errors.New("libudev message properties out of bounds")
+		}
+
+		properties = msg[off : off+length]
+	default:
+		// kernel message: "<action>@<devpath>\0" followed by properties
+		idx := bytes.IndexByte(msg, 0)
+		if idx < 0 || !bytes.ContainsRune(msg[:idx], '@') {
+			return nil, errors.New("unknown uevent message format")
+		}
+
+		properties = msg[idx+1:]
+	}
+
+	event := Event{}
+
+	for _, prop := range bytes.Split(properties, []byte{0}) {
+		key, value, ok := strings.Cut(string(prop), "=")
+		if !ok {
+			continue
+		}
+
+		event[key] = value
+	}
+
+	return event, nil
+}
+
+// Trigger is used by the watcher to notify about the events.
+type Trigger interface {
+	QueueReconcile()
+}
+
+// Watcher watches uevents.
+type Watcher struct {
+	wg sync.WaitGroup
+	f  *os.File
+}
+
+// NewWatcher starts watching uevents for the specified multicast group.
+//
+// Trigger is called for each event which matches any of the subsystems (or for any event, if no subsystems are given).
+func NewWatcher(trigger Trigger, group uint32, subsystems ...string) (*Watcher, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK, unix.NETLINK_KOBJECT_UEVENT)
+	if err != nil {
+		return nil, fmt.Errorf("error creating uevent socket: %w", err)
+	}
+
+	if err = unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: group}); err != nil {
+		unix.Close(fd) //nolint:errcheck
+
+		return nil, fmt.Errorf("error binding uevent socket: %w", err)
+	}
+
+	watcher := &Watcher{
+		// non-blocking file is handled by the runtime poller, so that Close() interrupts pending reads
+		f: os.NewFile(uintptr(fd), "uevent"),
+	}
+
+	watcher.wg.Add(1)
+
+	go func() {
+		defer watcher.wg.Done()
+
+		buf := make([]byte, 64*1024)
+
+		for {
+			n, readErr := watcher.f.Read(buf)
+			if readErr != nil {
+				return
+			}
+
+			event, parseErr := Parse(buf[:n])
+			if parseErr != nil {
+				continue
+			}
+
+			if matchSubsystem(event, subsystems) {
+				trigger.QueueReconcile()
+			}
+		}
+	}()
+
+	return watcher, nil
+}
+
+// Done stops the watcher.
+func (watcher *Watcher) Done() {
+	watcher.f.Close() //nolint:errcheck
+
+	watcher.wg.Wait()
+}
+
+func matchSubsystem(event Event, subsystems []string) bool {
+	if len(subsystems) == 0 {
+		return true
+	}
+
+	for _, subsystem := range subsystems {
+		if event.Subsystem() == subsystem {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package uevent_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/uevent"
+)
+
+func TestParseKernel(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("add@/devices/virtual/block/loop0\x00ACTION=add\x00DEVPATH=/devices/virtual/block/loop0\x00SUBSYSTEM=block\x00DEVNAME=loop0\x00SEQNUM=1234\x00")
+
+	event, err := uevent.Parse(msg)
+	require.NoError(t, err)
+
+	assert.Equal(t, "add", event.Action())
+	assert.Equal(t, "block", event.Subsystem())
+	assert.Equal(t, "loop0", event["DEVNAME"])
+	assert.Equal(t, "1234", event["SEQNUM"])
+}
+
+func TestParseLibudev(t *testing.T) {
+	t.Parallel()
+
+	properties := []byte("ACTION=change\x00SUBSYSTEM=block\x00DEVNAME=/dev/sda1\x00ID_FS_TYPE=xfs\x00")
+
+	header := make([]byte, 40)
+	copy(header, "libudev\x00")
+	binary.BigEndian.PutUint32(header[8:], 0xfeedcafe)
+	binary.LittleEndian.PutUint32(header[12:], 40)
+	binary.LittleEndian.PutUint32(header[16:], 40)
+	binary.LittleEndian.PutUint32(header[20:], uint32(len(properties)))
+
+	event, err := uevent.Parse(append(header, properties...))
+	require.NoError(t, err)
+
+	assert.Equal(t, uevent.Event{
+		"ACTION":     "change",
+		"SUBSYSTEM":  "block",
+		"DEVNAME":    "/dev/sda1",
+		"ID_FS_TYPE": "xfs",
+	}, event)
+
+	// broken magic
+	binary.BigEndian.PutUint32(header[8:], 0xcafe)
+
+	_, err = uevent.Parse(append(header, properties...))
+	assert.Error(t, err)
+
+	// properties out of bounds
+	binary.BigEndian.PutUint32(header[8:], 0xfeedcafe)
+	binary.LittleEndian.PutUint32(header[20:], 1000)
+
+	_, err = uevent.Parse(append(header, properties...))
+	assert.Error(t, err)
+}
+
+func TestParseGarbage(t *testing.T) {
+	t.Parallel()
+
+	_, err := uevent.Parse([]byte("foo"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package usb
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:generate curl -sSfL -o usb.ids http://www.linux-usb.org/usb.ids
+
+//go:embed usb.ids
+var usbIDs []byte
+
+// DB is the USB ID database which maps vendor and product IDs to names.
+type DB struct {
+	vendors  map[uint16]string
+	products map[uint32]string
+}
+
+var (
+	defaultDB     *DB
+	defaultDBOnce sync.Once
+)
+
+// DefaultDB returns the USB ID database embedded into the binary.
+//
+// The database is parsed on first use.
+func DefaultDB() *DB {
+	defaultDBOnce.Do(func() {
+		defaultDB = ParseDB(usbIDs)
+	})
+
+	return defaultDB
+}
+
+// ParseDB parses the USB ID database in the usb.ids format.
+//
+// Only vendors and products are parsed, interfaces and other sections (classes, HID usages, etc.) are skipped.
+func ParseDB(data []byte) *DB {
+	db := &DB{
+		vendors:  map[uint16]string{},
+		products: map[uint32]string{},
+	}
+
+	var (
+		vendorID uint16
+		inVendor bool
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		switch {
+		case !strings.HasPrefix(line, "\t"):
+			// vendor line, or the start of other section (e.g. 'C 09  Hub') which ends the vendor list
+			id, name, ok := parseIDLine(line)
+
+			inVendor = ok
+			if !ok {
+				continue
+			}
+
+			vendorID = id
+			db.vendors[id] = name
+		case inVendor && !strings.HasPrefix(line, "\t\t"):
+			id, name, ok := parseIDLine(line[1:])
+			if !ok {
+				continue
+			}
+
+			db.products[uint32(vendorID)<<16|uint32(id)] = name
+		}
+	}
+
+	return db
+}
+
+// parseIDLine parses lines like '1d6b  Linux Foundation'.
+func parseIDLine(line string) (uint16, string, bool) {
+	idStr, name, ok := strings.Cut(line, "  ")
+	if !ok || len(idStr) != 4 {
+		return 0, "", false
+	}
+
+	id, err := strconv.ParseUint(idStr, 16, 16)
+	if err != nil {
+		return 0, "", false
+	}
+
+	return uint16(id), strings.TrimSpace(name), true
+}
+
+// LookupVendor by ID.
+func (db *DB) LookupVendor(vendorID uint16) (string, bool) {
+	name, ok := db.vendors[vendorID]
+
+	return name, ok
+}
+
+// LookupProduct by vendor and product IDs.
+func (db *DB) LookupProduct(vendorID, productID uint16) (string, bool) {
+	name, ok := db.products[uint32(vendorID)<<16|uint32(productID)]
+
+	return name, ok
+}
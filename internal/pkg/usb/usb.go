@@ -0,0 +1,236 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package usb provides methods to access USB-related data.
+package usb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/gen/slices"
+)
+
+// DevicesPath is the path to the USB devices in sysfs.
+const DevicesPath = "/sys/bus/usb/devices"
+
+// Device describes USB device.
+type Device struct {
+	// Name is the sysfs device name, e.g. 1-1.2 or usb1 for root hubs.
+	Name string
+
+	BusNum uint32
+	DevNum uint32
+
+	VendorID  uint16
+	ProductID uint16
+
+	// Vendor and Product are looked up in the USB ID database (see LookupDB),
+	// falling back to the device string descriptors.
+	Vendor  string
+	Product string
+	// Serial comes from the device string descriptor.
+	Serial string
+
+	ClassCode uint8
+	Class     string
+
+	// Speed in Mbit/s.
+	Speed   string
+	Version string
+
+	// Drivers bound to the device interfaces.
+	Drivers []string
+}
+
+// classes are the USB base class codes as defined by USB-IF.
+var classes = map[uint8]string{
+	0x00: "Defined at Interface level",
+	0x01: "Audio",
+	0x02: "Communications",
+	0x03: "Human Interface Device",
+	0x05: "Physical",
+	0x06: "Image",
+	0x07: "Printer",
+	0x08: "Mass Storage",
+	0x09: "Hub",
+	0x0a: "CDC Data",
+	0x0b: "Smart Card",
+	0x0d: "Content Security",
+	0x0e: "Video",
+	0x0f: "Personal Healthcare",
+	0x10: "Audio/Video",
+	0x11: "Billboard",
+	0x12: "Type-C Bridge",
+	0xdc: "Diagnostic",
+	0xe0: "Wireless Controller",
+	0xef: "Miscellaneous",
+	0xfe: "Application Specific",
+	0xff: "Vendor Specific",
+}
+
+// LookupClass by USB base class code.
+func LookupClass(classCode uint8) (string, bool) {
+	name, ok := classes[classCode]
+
+	return name, ok
+}
+
+// LookupDB looks up device info in the USB ID database.
+//
+// Names which are not found in the database are kept as is.
+func (d *Device) LookupDB(db *DB) {
+	if vendor, ok := db.LookupVendor(d.VendorID); ok {
+		d.Vendor = vendor
+	}
+
+	if product, ok := db.LookupProduct(d.VendorID, d.ProductID); ok {
+		d.Product = product
+	}
+}
+
+// List all USB devices found in the sysfs devices path (usually DevicesPath).
+func List(devicesPath string) ([]Device, error) {
+	entries, err := os.ReadDir(devicesPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading USB devices: %w", err)
+	}
+
+	devices := map[string]*Device{}
+
+	for _, entry := range entries {
+		// interfaces are named <device>:<config>.<interface>, they are processed below
+		if strings.Contains(entry.Name(), ":") {
+			continue
+		}
+
+		d, err := readDevice(filepath.Join(devicesPath, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading USB device %q: %w", entry.Name(), err)
+		}
+
+		if d == nil {
+			continue
+		}
+
+		d.Name = entry.Name()
+		devices[d.Name] = d
+	}
+
+	for _, entry := range entries {
+		deviceName, _, isInterface := strings.Cut(entry.Name(), ":")
+		if !isInterface {
+			continue
+		}
+
+		// root hub usbN interfaces are named N-0:<config>.<interface>
+		if strings.HasSuffix(deviceName, "-0") {
+			deviceName = "usb" + strings.TrimSuffix(deviceName, "-0")
+		}
+
+		d, ok := devices[deviceName]
+		if !ok {
+			continue
+		}
+
+		interfacePath := filepath.Join(devicesPath, entry.Name())
+
+		if driver := linkName(filepath.Join(interfacePath, "driver")); driver != "" && !slices.Contains(d.Drivers, func(s string) bool { return s == driver }) {
+			d.Drivers = append(d.Drivers, driver)
+		}
+
+		// class is defined at the interface level, use the first interface class
+		if d.ClassCode == 0 {
+			if class, err := readHex(filepath.Join(interfacePath, "bInterfaceClass"), 8); err == nil {
+				d.ClassCode = uint8(class)
+			}
+		}
+	}
+
+	result := make([]Device, 0, len(devices))
+
+	for _, d := range devices {
+		d.LookupDB(DefaultDB())
+		d.Class, _ = LookupClass(d.ClassCode)
+		sort.Strings(d.Drivers)
+
+		result = append(result, *d)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result, nil
+}
+
+func readDevice(devicePath string) (*Device, error) {
+	vendorID, err := readHex(filepath.Join(devicePath, "idVendor"), 16)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	productID, err := readHex(filepath.Join(devicePath, "idProduct"), 16)
+	if err != nil {
+		return nil, err
+	}
+
+	class, _ := readHex(filepath.Join(devicePath, "bDeviceClass"), 8) //nolint:errcheck
+	busNum, _ := readDec(filepath.Join(devicePath, "busnum"))         //nolint:errcheck
+	devNum, _ := readDec(filepath.Join(devicePath, "devnum"))         //nolint:errcheck
+
+	return &Device{
+		BusNum:    uint32(busNum),
+		DevNum:    uint32(devNum),
+		VendorID:  uint16(vendorID),
+		ProductID: uint16(productID),
+		Vendor:    readString(filepath.Join(devicePath, "manufacturer")),
+		Product:   readString(filepath.Join(devicePath, "product")),
+		Serial:    readString(filepath.Join(devicePath, "serial")),
+		ClassCode: uint8(class),
+		Speed:     readString(filepath.Join(devicePath, "speed")),
+		Version:   readString(filepath.Join(devicePath, "version")),
+	}, nil
+}
+
+func readString(path string) string {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	return string(bytes.TrimSpace(contents))
+}
+
+// readHex reads sysfs USB IDs and class codes, which are hex without the 0x prefix.
+func readHex(path string, bitSize int) (uint64, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(string(bytes.TrimSpace(contents)), 16, bitSize)
+}
+
+func readDec(path string) (uint64, error) {
+	return strconv.ParseUint(readString(path), 10, 32)
+}
+
+func linkName(path string) string {
+	target, err := os.Readlink(path)
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Base(target)
+}
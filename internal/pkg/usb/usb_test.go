@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package usb_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/usb"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	devicesPath := t.TempDir()
+
+	write := func(device, name, contents string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(devicesPath, device), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(devicesPath, device, name), []byte(contents+"\n"), 0o644))
+	}
+
+	link := func(device, name, target string) {
+		require.NoError(t, os.Symlink(target, filepath.Join(devicesPath, device, name)))
+	}
+
+	// root hub, names are taken from the USB ID database
+	write("usb1", "idVendor", "1d6b")
+	write("usb1", "idProduct", "0002")
+	write("usb1", "bDeviceClass", "09")
+	write("usb1", "busnum", "1")
+	write("usb1", "devnum", "1")
+	write("usb1", "manufacturer", "Linux 5.15.0 xhci-hcd")
+	write("usb1", "product", "xHCI Host Controller")
+	write("usb1", "speed", "480")
+	write("usb1", "version", " 2.00")
+	write("1-0:1.0", "bInterfaceClass", "09")
+	link("1-0:1.0", "driver", "../../../../../bus/usb/drivers/hub")
+
+	// composite keyboard with two HID interfaces
+	write("1-1", "idVendor", "046d")
+	write("1-1", "idProduct", "c52b")
+	write("1-1", "bDeviceClass", "00")
+	write("1-1", "busnum", "1")
+	write("1-1", "devnum", "2")
+	write("1-1", "product", "USB Receiver")
+	write("1-1", "serial", "ABC123")
+	write("1-1", "speed", "12")
+	write("1-1:1.0", "bInterfaceClass", "03")
+	link("1-1:1.0", "driver", "../../../../../../bus/usb/drivers/usbhid")
+	write("1-1:1.1", "bInterfaceClass", "03")
+	link("1-1:1.1", "driver", "../../../../../../bus/usb/drivers/usbhid")
+
+	// device is not in the USB ID database, string descriptors are used
+	write("1-2", "idVendor", "1d6b")
+	write("1-2", "idProduct", "fffe")
+	write("1-2", "bDeviceClass", "ff")
+	write("1-2", "busnum", "1")
+	write("1-2", "devnum", "3")
+	write("1-2", "manufacturer", "ACME")
+	write("1-2", "product", "Widget")
+	write("1-2", "speed", "12")
+
+	devices, err := usb.List(devicesPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, []usb.Device{
+		{
+			Name:      "1-1",
+			BusNum:    1,
+			DevNum:    2,
+			VendorID:  0x046d,
+			ProductID: 0xc52b,
+			Vendor:    "Logitech, Inc.",
+			Product:   "Unifying Receiver",
+			Serial:    "ABC123",
+			ClassCode: 0x03,
+			Class:     "Human Interface Device",
+			Speed:     "12",
+			Drivers:   []string{"usbhid"},
+		},
+		{
+			Name:      "1-2",
+			BusNum:    1,
+			DevNum:    3,
+			VendorID:  0x1d6b,
+			ProductID: 0xfffe,
+			Vendor:    "Linux Foundation",
+			Product:   "Widget",
+			ClassCode: 0xff,
+			Class:     "Vendor Specific",
+			Speed:     "12",
+		},
+		{
+			Name:      "usb1",
+			BusNum:    1,
+			DevNum:    1,
+			VendorID:  0x1d6b,
+			ProductID: 0x0002,
+			Vendor:    "Linux Foundation",
+			Product:   "2.0 root hub",
+			ClassCode: 0x09,
+			Class:     "Hub",
+			Speed:     "480",
+			Version:   "2.00",
+			Drivers:   []string{"hub"},
+		},
+	}, devices)
+}
+
+func TestParseDB(t *testing.T) {
+	t.Parallel()
+
+	db := usb.ParseDB([]byte(`#
+# comment
+#	1234  not a vendor
+
+1d6b  Linux Foundation
+	0002  2.0 root hub
+		00  interface
+
+abcd  Unknown
+C 09  Hub
+	00  Unused
+`))
+
+	vendor, ok := db.LookupVendor(0x1d6b)
+	assert.True(t, ok)
+	assert.Equal(t, "Linux Foundation", vendor)
+
+	product, ok := db.LookupProduct(0x1d6b, 0x0002)
+	assert.True(t, ok)
+	assert.Equal(t, "2.0 root hub", product)
+
+	_, ok = db.LookupProduct(0x1d6b, 0x0000)
+	assert.False(t, ok)
+
+	_, ok = db.LookupVendor(0x1234)
+	assert.False(t, ok)
+
+	// class section is not parsed as products of the last vendor
+	_, ok = db.LookupProduct(0xabcd, 0x0000)
+	assert.False(t, ok)
+}
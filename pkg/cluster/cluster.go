@@ -95,6 +95,11 @@ func IPToNodeInfo(ip string) (*NodeInfo, error) {
 	}, nil
 }
 
+// NodeAddress returns the address which is used to access the node via Talos API.
+func NodeAddress(node NodeInfo) string {
+	return node.InternalIP.String()
+}
+
 // NodesMatch asserts that the provided expected set of nodes match the actual set of nodes.
 //
 // Each expectedNode IPs should have a non-empty intersection with actualNode IPs.
@@ -111,10 +111,18 @@ func (k *KubernetesClient) K8sHelper(ctx context.Context) (*k8s.Client, error) {
 }
 
 // K8sClose closes Kubernetes client.
+//
+// Cached kubeconfig and clients are dropped, so they are fetched again on next use.
 func (k *KubernetesClient) K8sClose() error {
+	k.kubeconfig = nil
+	k.clientset = nil
+
 	if k.KubeHelper == nil {
 		return nil
 	}
 
-	return k.KubeHelper.Close()
+	helper := k.KubeHelper
+	k.KubeHelper = nil
+
+	return helper.Close()
 }
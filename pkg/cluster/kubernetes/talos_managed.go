@@ -394,14 +394,7 @@ func getManifests(ctx context.Context, cluster UpgradeProvider) ([]*unstructured
 
 	for it.Next() {
 		for _, o := range it.Value().TypedSpec().Items {
-			obj := &unstructured.Unstructured{Object: o.Object}
-
-			// kubeproxy daemon set is updated as part of a different flow
-			if obj.GetName() == kubeProxy && obj.GetKind() == "DaemonSet" {
-				continue
-			}
-
-			objects = append(objects, obj)
+			objects = append(objects, &unstructured.Unstructured{Object: o.Object})
 		}
 	}
 
@@ -492,6 +485,11 @@ func syncManifests(ctx context.Context, objects []*unstructured.Unstructured, cl
 	options.Log("updating manifests")
 
 	for _, obj := range objects {
+		// kubeproxy daemon set is updated as part of a different flow
+		if obj.GetName() == kubeProxy && obj.GetKind() == "DaemonSet" {
+			continue
+		}
+
 		options.Log(" > processing manifest %s %s", obj.GetKind(), obj.GetName())
 
 		var (
@@ -536,6 +534,10 @@ func syncManifests(ctx context.Context, objects []*unstructured.Unstructured, cl
 		options.Log(" < update applied, diff:\n%s", diff)
 	}
 
+	if err = pruneManifests(ctx, dc, k8sClient, objects, options); err != nil {
+		return err
+	}
+
 	if len(deployments) == 0 {
 		return nil
 	}
@@ -572,6 +574,36 @@ func syncManifests(ctx context.Context, objects []*unstructured.Unstructured, cl
 	return nil
 }
 
+// pruneManifests removes the objects from the Talos manifest inventory which are no longer present in the manifests.
+func pruneManifests(ctx context.Context, dc discovery.DiscoveryInterface, k8sClient dynamic.Interface, objects []*unstructured.Unstructured, options UpgradeOptions) error {
+	candidates, err := kubernetes.InventoryPruneCandidates(ctx, dc, k8sClient, objects)
+	if err != nil {
+		return err
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	options.Log("pruning removed manifests")
+
+	for _, obj := range candidates {
+		if options.DryRun {
+			options.Log(" > would prune %s", obj)
+
+			continue
+		}
+
+		if err = obj.Delete(ctx, k8sClient); err != nil {
+			return err
+		}
+
+		options.Log(" > pruned %s", obj)
+	}
+
+	return nil
+}
+
 func getResourceDiff(ctx context.Context, dr dynamic.ResourceInterface, obj *unstructured.Unstructured) (string, error) {
 	current, err := dr.Get(ctx, obj.GetName(), metav1.GetOptions{})
 	if err != nil {
@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/slices"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	v1alpha1config "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+)
+
+// ReadNodeConfig fetches current node configuration.
+func ReadNodeConfig(ctx context.Context, cluster ClientProvider, node string) (*v1alpha1config.Config, error) {
+	c, err := cluster.Client()
+	if err != nil {
+		return nil, fmt.Errorf("error building Talos API client: %w", err)
+	}
+
+	ctx = client.WithNode(ctx, node)
+
+	mc, err := safe.StateGet[*config.MachineConfig](ctx, c.COSI, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching config resource: %w", err)
+	}
+
+	cfg, ok := mc.Config().Raw().(*v1alpha1config.Config)
+	if !ok {
+		return nil, fmt.Errorf("config is not v1alpha1 config")
+	}
+
+	return cfg, nil
+}
+
+// PatchNodeConfig updates node configuration by means of patch function.
+func PatchNodeConfig(ctx context.Context, cluster ClientProvider, node string, patchFunc func(config *v1alpha1config.Config) error) error {
+	c, err := cluster.Client()
+	if err != nil {
+		return fmt.Errorf("error building Talos API client: %w", err)
+	}
+
+	ctx = client.WithNode(ctx, node)
+
+	cfg, err := ReadNodeConfig(ctx, cluster, node)
+	if err != nil {
+		return err
+	}
+
+	if !cfg.Persist() {
+		return fmt.Errorf("config persistence is disabled, patching is not supported")
+	}
+
+	if err = patchFunc(cfg); err != nil {
+		return fmt.Errorf("error patching config: %w", err)
+	}
+
+	cfgBytes, err := cfg.Bytes()
+	if err != nil {
+		return fmt.Errorf("error serializing config: %w", err)
+	}
+
+	_, err = c.ApplyConfiguration(ctx, &machine.ApplyConfigurationRequest{
+		Data:      cfgBytes,
+		Mode:      machine.ApplyConfigurationRequest_NO_REBOOT,
+		Immediate: true, // keeping that for backward compatibility
+	})
+	if err != nil {
+		return fmt.Errorf("error applying config: %w", err)
+	}
+
+	return nil
+}
+
+// AddAcceptedCA appends the certificate to the list of accepted CAs, if it's not there yet.
+func AddAcceptedCA(cas []*x509.PEMEncodedCertificateAndKey, crt []byte) []*x509.PEMEncodedCertificateAndKey {
+	for _, ca := range cas {
+		if bytes.Equal(ca.Crt, crt) {
+			return cas
+		}
+	}
+
+	return append(cas, &x509.PEMEncodedCertificateAndKey{Crt: crt})
+}
+
+// RemoveAcceptedCA removes the certificate from the list of accepted CAs.
+func RemoveAcceptedCA(cas []*x509.PEMEncodedCertificateAndKey, crt []byte) []*x509.PEMEncodedCertificateAndKey {
+	return slices.Filter(cas, func(ca *x509.PEMEncodedCertificateAndKey) bool {
+		return !bytes.Equal(ca.Crt, crt)
+	})
+}
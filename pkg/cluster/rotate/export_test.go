@@ -0,0 +1,37 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotate
+
+import (
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	v1alpha1config "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TalosRotationPatches(cfg *v1alpha1config.Config, options TalosCAOptions) ([]func(*v1alpha1config.Config, bool) error, error) {
+	r, err := newTalosRotation(cfg, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return []func(*v1alpha1config.Config, bool) error{r.accept, r.swap, r.drop}, nil
+}
+
+func TalosClientContext(cfg *v1alpha1config.Config, options TalosCAOptions, transitional bool) (*clientconfig.Context, error) {
+	r, err := newTalosRotation(cfg, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.clientContext(options.TalosConfigContext, transitional)
+}
+
+func KubernetesRotationPatches(cfg *v1alpha1config.Config, options KubernetesCAOptions) ([]func(*v1alpha1config.Config, bool) error, error) {
+	r, err := newKubernetesRotation(cfg, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return []func(*v1alpha1config.Config, bool) error{r.accept, r.swap, r.drop}, nil
+}
@@ -0,0 +1,244 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/slices"
+
+	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/cluster/check"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	v1alpha1config "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
+)
+
+// KubernetesCAOptions represents Kubernetes CA and service account key rotation settings.
+type KubernetesCAOptions struct {
+	// RotateCA enables rotation of the Kubernetes CA (cluster.ca).
+	RotateCA bool
+	// RotateServiceAccount enables rotation of the service account key (cluster.serviceAccount).
+	RotateServiceAccount bool
+
+	// NewCA is the new Kubernetes CA, it is generated if not set.
+	NewCA *x509.PEMEncodedCertificateAndKey
+	// NewServiceAccountKey is the new service account key, it is generated if not set.
+	NewServiceAccountKey *x509.PEMEncodedKey
+
+	Options
+}
+
+// kubernetesRotation describes the Kubernetes secrets being rotated.
+//
+// Fields are nil if the corresponding secret is not rotated.
+type kubernetesRotation struct {
+	oldCA *x509.PEMEncodedCertificateAndKey
+	newCA *x509.PEMEncodedCertificateAndKey
+
+	oldServiceAccountKey *x509.PEMEncodedKey
+	newServiceAccountKey *x509.PEMEncodedKey
+}
+
+// KubernetesCA rotates the Kubernetes CA and/or the service account key in the Talos-managed cluster.
+//
+// Rotation is performed in three phases, and the cluster health is verified after each phase:
+//
+//  1. The new CA and service account key are added as accepted on all nodes.
+//  2. The new CA and service account key become current, the old ones are kept as accepted.
+//  3. The old CA and service account key are dropped.
+//
+//nolint:gocyclo
+func KubernetesCA(ctx context.Context, state check.ClusterInfo, options KubernetesCAOptions) error {
+	if !options.RotateCA && !options.RotateServiceAccount {
+		return fmt.Errorf("nothing to rotate")
+	}
+
+	nodes, err := discoverNodes(state, &options.Options)
+	if err != nil {
+		return err
+	}
+
+	currentConfig, err := cluster.ReadNodeConfig(ctx, state, nodes.controlPlane[0])
+	if err != nil {
+		return fmt.Errorf("error reading config from node %q: %w", nodes.controlPlane[0], err)
+	}
+
+	r, err := newKubernetesRotation(currentConfig, options)
+	if err != nil {
+		return err
+	}
+
+	phases := []rotationPhase{
+		{
+			name:  "adding new secrets as accepted",
+			patch: r.accept,
+		},
+		{
+			name:  "making new secrets current",
+			patch: r.swap,
+		},
+		{
+			name:  "dropping old secrets",
+			patch: r.drop,
+		},
+	}
+
+	for i, phase := range phases {
+		options.Log("> phase %d/%d: %s", i+1, len(phases), phase.name)
+
+		if err = patchNodes(ctx, state, nodes, phase, &options.Options); err != nil {
+			return err
+		}
+
+		if options.DryRun {
+			continue
+		}
+
+		if err = waitForRotation(ctx, state, &options.Options); err != nil {
+			return fmt.Errorf("cluster is not healthy after phase %q: %w", phase.name, err)
+		}
+	}
+
+	if !options.DryRun && options.RotateCA {
+		options.Log("rotation is done, please fetch new kubeconfig with `talosctl kubeconfig`")
+	}
+
+	return nil
+}
+
+func newKubernetesRotation(cfg *v1alpha1config.Config, options KubernetesCAOptions) (*kubernetesRotation, error) {
+	if cfg.ClusterConfig == nil {
+		return nil, fmt.Errorf("cluster config is missing")
+	}
+
+	r := &kubernetesRotation{}
+
+	if options.RotateCA {
+		r.oldCA = cfg.ClusterConfig.ClusterCA
+		if r.oldCA == nil || len(r.oldCA.Key) == 0 {
+			return nil, fmt.Errorf("current Kubernetes CA is missing")
+		}
+
+		r.newCA = options.NewCA
+
+		if r.newCA == nil {
+			ca, err := generate.NewKubernetesCA(time.Now(), talosconfig.TalosVersionCurrent)
+			if err != nil {
+				return nil, fmt.Errorf("error generating new Kubernetes CA: %w", err)
+			}
+
+			r.newCA = x509.NewCertificateAndKeyFromCertificateAuthority(ca)
+		}
+	}
+
+	if options.RotateServiceAccount {
+		r.oldServiceAccountKey = cfg.ClusterConfig.ClusterServiceAccount
+		if r.oldServiceAccountKey == nil {
+			return nil, fmt.Errorf("current service account key is missing")
+		}
+
+		r.newServiceAccountKey = options.NewServiceAccountKey
+
+		if r.newServiceAccountKey == nil {
+			key, err := x509.NewECDSAKey()
+			if err != nil {
+				return nil, fmt.Errorf("error generating new service account key: %w", err)
+			}
+
+			r.newServiceAccountKey = &x509.PEMEncodedKey{
+				Key: key.KeyPEM,
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// accept adds new secrets as accepted.
+func (r *kubernetesRotation) accept(cfg *v1alpha1config.Config, controlPlane bool) error {
+	if cfg.ClusterConfig == nil {
+		return fmt.Errorf("cluster config is missing")
+	}
+
+	if r.newCA != nil {
+		cfg.ClusterConfig.ClusterAcceptedCAs = cluster.AddAcceptedCA(cfg.ClusterConfig.ClusterAcceptedCAs, r.newCA.Crt)
+	}
+
+	// service account key is only present on control plane nodes
+	if r.newServiceAccountKey != nil && controlPlane {
+		cfg.ClusterConfig.ClusterAcceptedServiceAccountKeys = addAcceptedKey(cfg.ClusterConfig.ClusterAcceptedServiceAccountKeys, r.newServiceAccountKey.Key)
+	}
+
+	return nil
+}
+
+// swap makes new secrets current, keeping old secrets as accepted.
+func (r *kubernetesRotation) swap(cfg *v1alpha1config.Config, controlPlane bool) error {
+	if cfg.ClusterConfig == nil {
+		return fmt.Errorf("cluster config is missing")
+	}
+
+	if r.newCA != nil {
+		// worker nodes don't have the CA key
+		if controlPlane {
+			cfg.ClusterConfig.ClusterCA = r.newCA
+		} else {
+			cfg.ClusterConfig.ClusterCA = &x509.PEMEncodedCertificateAndKey{
+				Crt: r.newCA.Crt,
+			}
+		}
+
+		cfg.ClusterConfig.ClusterAcceptedCAs = cluster.AddAcceptedCA(cluster.RemoveAcceptedCA(cfg.ClusterConfig.ClusterAcceptedCAs, r.newCA.Crt), r.oldCA.Crt)
+	}
+
+	if r.newServiceAccountKey != nil && controlPlane {
+		cfg.ClusterConfig.ClusterServiceAccount = r.newServiceAccountKey
+
+		cfg.ClusterConfig.ClusterAcceptedServiceAccountKeys = addAcceptedKey(
+			removeAcceptedKey(cfg.ClusterConfig.ClusterAcceptedServiceAccountKeys, r.newServiceAccountKey.Key),
+			r.oldServiceAccountKey.Key,
+		)
+	}
+
+	return nil
+}
+
+// drop removes old secrets.
+func (r *kubernetesRotation) drop(cfg *v1alpha1config.Config, controlPlane bool) error {
+	if cfg.ClusterConfig == nil {
+		return fmt.Errorf("cluster config is missing")
+	}
+
+	if r.oldCA != nil {
+		cfg.ClusterConfig.ClusterAcceptedCAs = cluster.RemoveAcceptedCA(cfg.ClusterConfig.ClusterAcceptedCAs, r.oldCA.Crt)
+	}
+
+	if r.oldServiceAccountKey != nil && controlPlane {
+		cfg.ClusterConfig.ClusterAcceptedServiceAccountKeys = removeAcceptedKey(cfg.ClusterConfig.ClusterAcceptedServiceAccountKeys, r.oldServiceAccountKey.Key)
+	}
+
+	return nil
+}
+
+func addAcceptedKey(keys []*x509.PEMEncodedKey, key []byte) []*x509.PEMEncodedKey {
+	for _, k := range keys {
+		if bytes.Equal(k.Key, key) {
+			return keys
+		}
+	}
+
+	return append(keys, &x509.PEMEncodedKey{Key: key})
+}
+
+func removeAcceptedKey(keys []*x509.PEMEncodedKey, key []byte) []*x509.PEMEncodedKey {
+	return slices.Filter(keys, func(k *x509.PEMEncodedKey) bool {
+		return !bytes.Equal(k.Key, key)
+	})
+}
@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotate_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/slices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/cluster/rotate"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func newKey(t *testing.T) *x509.PEMEncodedKey {
+	key, err := x509.NewECDSAKey()
+	require.NoError(t, err)
+
+	return &x509.PEMEncodedKey{Key: key.KeyPEM}
+}
+
+func acceptedKubernetesCAs(cfg *v1alpha1.Config) [][]byte {
+	return slices.Map(cfg.ClusterConfig.ClusterAcceptedCAs, func(ca *x509.PEMEncodedCertificateAndKey) []byte { return ca.Crt })
+}
+
+func acceptedKeys(cfg *v1alpha1.Config) [][]byte {
+	return slices.Map(cfg.ClusterConfig.ClusterAcceptedServiceAccountKeys, func(key *x509.PEMEncodedKey) []byte { return key.Key })
+}
+
+func TestKubernetesRotationPatches(t *testing.T) {
+	t.Parallel()
+
+	oldCA, newCA := newCA(t), newCA(t)
+	oldKey, newKey := newKey(t), newKey(t)
+
+	controlPlane := &v1alpha1.Config{
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ClusterCA:             oldCA,
+			ClusterServiceAccount: oldKey,
+		},
+	}
+
+	worker := &v1alpha1.Config{
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ClusterCA: &x509.PEMEncodedCertificateAndKey{Crt: oldCA.Crt},
+		},
+	}
+
+	patches, err := rotate.KubernetesRotationPatches(controlPlane, rotate.KubernetesCAOptions{
+		RotateCA:             true,
+		RotateServiceAccount: true,
+		NewCA:                newCA,
+		NewServiceAccountKey: newKey,
+	})
+	require.NoError(t, err)
+	require.Len(t, patches, 3)
+
+	// phase 1: new secrets are accepted
+	require.NoError(t, patches[0](controlPlane, true))
+	require.NoError(t, patches[0](worker, false))
+
+	assert.Equal(t, oldCA, controlPlane.ClusterConfig.ClusterCA)
+	assert.Equal(t, [][]byte{newCA.Crt}, acceptedKubernetesCAs(controlPlane))
+	assert.Equal(t, oldKey, controlPlane.ClusterConfig.ClusterServiceAccount)
+	assert.Equal(t, [][]byte{newKey.Key}, acceptedKeys(controlPlane))
+
+	assert.Equal(t, oldCA.Crt, worker.ClusterConfig.ClusterCA.Crt)
+	assert.Equal(t, [][]byte{newCA.Crt}, acceptedKubernetesCAs(worker))
+	assert.Nil(t, worker.ClusterConfig.ClusterServiceAccount)
+	assert.Empty(t, acceptedKeys(worker))
+
+	// phase 1 is idempotent
+	require.NoError(t, patches[0](controlPlane, true))
+	assert.Equal(t, [][]byte{newCA.Crt}, acceptedKubernetesCAs(controlPlane))
+	assert.Equal(t, [][]byte{newKey.Key}, acceptedKeys(controlPlane))
+
+	// phase 2: new secrets are current, old secrets are accepted
+	require.NoError(t, patches[1](controlPlane, true))
+	require.NoError(t, patches[1](worker, false))
+
+	assert.Equal(t, newCA, controlPlane.ClusterConfig.ClusterCA)
+	assert.Equal(t, [][]byte{oldCA.Crt}, acceptedKubernetesCAs(controlPlane))
+	assert.Equal(t, newKey, controlPlane.ClusterConfig.ClusterServiceAccount)
+	assert.Equal(t, [][]byte{oldKey.Key}, acceptedKeys(controlPlane))
+
+	assert.Equal(t, &x509.PEMEncodedCertificateAndKey{Crt: newCA.Crt}, worker.ClusterConfig.ClusterCA)
+	assert.Equal(t, [][]byte{oldCA.Crt}, acceptedKubernetesCAs(worker))
+	assert.Nil(t, worker.ClusterConfig.ClusterServiceAccount)
+
+	// phase 3: old secrets are dropped
+	require.NoError(t, patches[2](controlPlane, true))
+	require.NoError(t, patches[2](worker, false))
+
+	assert.Equal(t, newCA, controlPlane.ClusterConfig.ClusterCA)
+	assert.Empty(t, acceptedKubernetesCAs(controlPlane))
+	assert.Equal(t, newKey, controlPlane.ClusterConfig.ClusterServiceAccount)
+	assert.Empty(t, acceptedKeys(controlPlane))
+
+	assert.Equal(t, newCA.Crt, worker.ClusterConfig.ClusterCA.Crt)
+	assert.Empty(t, acceptedKubernetesCAs(worker))
+}
+
+func TestKubernetesRotationPatchesServiceAccountOnly(t *testing.T) {
+	t.Parallel()
+
+	ca := newCA(t)
+	oldKey := newKey(t)
+
+	cfg := &v1alpha1.Config{
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ClusterCA:             ca,
+			ClusterServiceAccount: oldKey,
+		},
+	}
+
+	patches, err := rotate.KubernetesRotationPatches(cfg, rotate.KubernetesCAOptions{
+		RotateServiceAccount: true,
+	})
+	require.NoError(t, err)
+
+	for _, patch := range patches {
+		require.NoError(t, patch(cfg, true))
+	}
+
+	assert.Equal(t, ca, cfg.ClusterConfig.ClusterCA)
+	assert.Empty(t, acceptedKubernetesCAs(cfg))
+	assert.NotEqual(t, oldKey, cfg.ClusterConfig.ClusterServiceAccount)
+	assert.Empty(t, acceptedKeys(cfg))
+
+	_, err = cfg.ClusterConfig.ClusterServiceAccount.GetKey()
+	assert.NoError(t, err)
+}
+
+func TestKubernetesRotationPatchesMissingCA(t *testing.T) {
+	t.Parallel()
+
+	_, err := rotate.KubernetesRotationPatches(&v1alpha1.Config{
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ClusterCA: &x509.PEMEncodedCertificateAndKey{Crt: newCA(t).Crt},
+		},
+	}, rotate.KubernetesCAOptions{
+		RotateCA: true,
+	})
+	assert.EqualError(t, err, "current Kubernetes CA is missing")
+}
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package rotate implements Talos API CA and Kubernetes CA rotation.
+package rotate
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/siderolabs/gen/slices"
+
+	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/cluster/check"
+	v1alpha1config "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	machinetype "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// Options represents the settings common to all rotation flows.
+type Options struct {
+	LogOutput io.Writer
+	Reporter  check.Reporter
+	DryRun    bool
+}
+
+// Log writes the line to logger or to stdout if no logger was provided.
+func (options *Options) Log(line string, args ...interface{}) {
+	if options.LogOutput != nil {
+		options.LogOutput.Write([]byte(fmt.Sprintf(line, args...))) //nolint:errcheck
+
+		return
+	}
+
+	fmt.Printf(line+"\n", args...)
+}
+
+type rotationPhase struct {
+	name  string
+	patch func(cfg *v1alpha1config.Config, controlPlane bool) error
+	// switchClient is set for the phase which should be performed with the client using the new CA
+	switchClient bool
+}
+
+// rotationNodes is the list of node addresses to be patched during the rotation.
+type rotationNodes struct {
+	controlPlane []string
+	workers      []string
+}
+
+func discoverNodes(info cluster.Info, options *Options) (rotationNodes, error) {
+	nodes := rotationNodes{
+		controlPlane: slices.Map(
+			append(info.NodesByType(machinetype.TypeInit), info.NodesByType(machinetype.TypeControlPlane)...),
+			cluster.NodeAddress,
+		),
+		workers: slices.Map(info.NodesByType(machinetype.TypeWorker), cluster.NodeAddress),
+	}
+
+	if len(nodes.controlPlane) == 0 {
+		return nodes, fmt.Errorf("no controlplane nodes discovered")
+	}
+
+	options.Log("discovered controlplane nodes %q", nodes.controlPlane)
+	options.Log("discovered worker nodes %q", nodes.workers)
+
+	return nodes, nil
+}
+
+// patchNodes applies the phase patch to the nodes.
+//
+// Control plane nodes go first, so that the control plane components trust the new secrets before they are used by the workers.
+func patchNodes(ctx context.Context, provider cluster.ClientProvider, nodes rotationNodes, phase rotationPhase, options *Options) error {
+	for _, group := range []struct {
+		addresses    []string
+		controlPlane bool
+	}{
+		{nodes.controlPlane, true},
+		{nodes.workers, false},
+	} {
+		for _, node := range group.addresses {
+			options.Log(" > %q: patching machine configuration", node)
+
+			if options.DryRun {
+				continue
+			}
+
+			if err := cluster.PatchNodeConfig(ctx, provider, node, func(cfg *v1alpha1config.Config) error {
+				return phase.patch(cfg, group.controlPlane)
+			}); err != nil {
+				return fmt.Errorf("error patching node %q: %w", node, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// waitForRotation drops cached Kubernetes clients (as the kubeconfig might change during the rotation)
+// and waits for the cluster to become healthy.
+func waitForRotation(ctx context.Context, state check.ClusterInfo, options *Options) error {
+	if err := state.K8sClose(); err != nil {
+		return fmt.Errorf("error closing Kubernetes client: %w", err)
+	}
+
+	reporter := options.Reporter
+	if reporter == nil {
+		reporter = check.StderrReporter()
+	}
+
+	return check.Wait(ctx, state, check.DefaultClusterChecks(), reporter)
+}
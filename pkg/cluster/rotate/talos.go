@@ -0,0 +1,267 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotate
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+
+	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/cluster/check"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	v1alpha1config "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+// TalosCAOptions represents Talos API CA rotation settings.
+type TalosCAOptions struct {
+	// CurrentClient is a Talos API client which uses the current CA.
+	CurrentClient *client.Client
+	// TalosConfigContext is the current client configuration, it is used as a template for the new one.
+	TalosConfigContext *clientconfig.Context
+	// ClusterInfo provides the list of cluster nodes.
+	ClusterInfo cluster.Info
+
+	// NewCA is the new Talos API CA, it is generated if not set.
+	NewCA *x509.PEMEncodedCertificateAndKey
+	// Endpoints overrides the Talos API endpoints from the client configuration.
+	Endpoints []string
+	// ForceEndpoint overrides the Kubernetes API endpoint used for the cluster health checks.
+	ForceEndpoint string
+
+	Options
+}
+
+// talosRotation describes the Talos API CA being rotated.
+type talosRotation struct {
+	oldCA *x509.PEMEncodedCertificateAndKey
+	newCA *x509.PEMEncodedCertificateAndKey
+}
+
+// TalosCA rotates the Talos API CA (os:admin) in the cluster.
+//
+// Rotation is performed in three phases, and the cluster health is verified after each phase:
+//
+//  1. The new CA is added as accepted on all nodes, so that the client certificates issued by the new CA are accepted.
+//  2. The new CA becomes current (apid and trustd certificates are re-issued), the old CA is kept as accepted.
+//  3. The old CA is dropped.
+//
+// Phases 2 and 3 are performed with the client certificate issued by the new CA.
+// The returned client configuration context uses the new CA and the new client certificate.
+//
+//nolint:gocyclo
+func TalosCA(ctx context.Context, options TalosCAOptions) (*clientconfig.Context, error) {
+	nodes, err := discoverNodes(options.ClusterInfo, &options.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	currentProvider := &cluster.ConfigClientProvider{
+		DefaultClient: options.CurrentClient,
+	}
+
+	currentConfig, err := cluster.ReadNodeConfig(ctx, currentProvider, nodes.controlPlane[0])
+	if err != nil {
+		return nil, fmt.Errorf("error reading config from node %q: %w", nodes.controlPlane[0], err)
+	}
+
+	r, err := newTalosRotation(currentConfig, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// transitional client configuration trusts both CAs, as the server certificates are re-issued during the rotation
+	transitionalContext, err := r.clientContext(options.TalosConfigContext, true)
+	if err != nil {
+		return nil, err
+	}
+
+	phases := []rotationPhase{
+		{
+			name:  "adding new CA as accepted",
+			patch: r.accept,
+		},
+		{
+			name:         "making new CA current",
+			patch:        r.swap,
+			switchClient: true,
+		},
+		{
+			name:  "dropping old CA",
+			patch: r.drop,
+		},
+	}
+
+	provider := currentProvider
+
+	for i, phase := range phases {
+		options.Log("> phase %d/%d: %s", i+1, len(phases), phase.name)
+
+		if phase.switchClient {
+			options.Log(" > switching to the client certificate issued by the new CA")
+
+			if !options.DryRun {
+				if provider, err = newClientProvider(ctx, transitionalContext, options.Endpoints); err != nil {
+					return nil, err
+				}
+
+				defer provider.DefaultClient.Close() //nolint:errcheck
+			}
+		}
+
+		if err = patchNodes(ctx, provider, nodes, phase, &options.Options); err != nil {
+			return nil, err
+		}
+
+		if options.DryRun {
+			continue
+		}
+
+		if err = waitForRotation(ctx, options.clusterState(provider), &options.Options); err != nil {
+			return nil, fmt.Errorf("cluster is not healthy after phase %q: %w", phase.name, err)
+		}
+	}
+
+	return r.clientContext(options.TalosConfigContext, false)
+}
+
+// newClientProvider builds a client provider with the client for the given client configuration context.
+func newClientProvider(ctx context.Context, configContext *clientconfig.Context, endpoints []string) (*cluster.ConfigClientProvider, error) {
+	opts := []client.OptionFunc{
+		client.WithConfigContext(configContext),
+	}
+
+	if len(endpoints) > 0 {
+		opts = append(opts, client.WithEndpoints(endpoints...))
+	}
+
+	c, err := client.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error building Talos API client: %w", err)
+	}
+
+	return &cluster.ConfigClientProvider{
+		DefaultClient: c,
+	}, nil
+}
+
+// clusterState builds the cluster state for the health checks with the given client provider.
+func (options *TalosCAOptions) clusterState(provider cluster.ClientProvider) check.ClusterInfo {
+	return &struct {
+		cluster.ClientProvider
+		cluster.K8sProvider
+		cluster.Info
+	}{
+		ClientProvider: provider,
+		K8sProvider: &cluster.KubernetesClient{
+			ClientProvider: provider,
+			ForceEndpoint:  options.ForceEndpoint,
+		},
+		Info: options.ClusterInfo,
+	}
+}
+
+func newTalosRotation(cfg *v1alpha1config.Config, options TalosCAOptions) (*talosRotation, error) {
+	if cfg.MachineConfig == nil {
+		return nil, fmt.Errorf("machine config is missing")
+	}
+
+	r := &talosRotation{
+		oldCA: cfg.MachineConfig.MachineCA,
+		newCA: options.NewCA,
+	}
+
+	if r.oldCA == nil || len(r.oldCA.Key) == 0 {
+		return nil, fmt.Errorf("current Talos API CA is missing")
+	}
+
+	if r.newCA == nil {
+		ca, err := generate.NewTalosCA(time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("error generating new Talos API CA: %w", err)
+		}
+
+		r.newCA = x509.NewCertificateAndKeyFromCertificateAuthority(ca)
+	}
+
+	return r, nil
+}
+
+// clientContext builds the client configuration context with the admin client certificate issued by the new CA.
+//
+// If transitional is set, the context trusts both the old and the new CA.
+func (r *talosRotation) clientContext(template *clientconfig.Context, transitional bool) (*clientconfig.Context, error) {
+	clientCert, err := generate.NewAdminCertificateAndKey(time.Now(), r.newCA, role.MakeSet(role.Admin), 87600*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("error generating client certificate: %w", err)
+	}
+
+	ca := r.newCA.Crt
+
+	if transitional {
+		ca = bytes.Join([][]byte{r.oldCA.Crt, r.newCA.Crt}, []byte("\n"))
+	}
+
+	configContext := &clientconfig.Context{}
+
+	if template != nil {
+		*configContext = *template
+	}
+
+	configContext.CA = base64.StdEncoding.EncodeToString(ca)
+	configContext.Crt = base64.StdEncoding.EncodeToString(clientCert.Crt)
+	configContext.Key = base64.StdEncoding.EncodeToString(clientCert.Key)
+
+	return configContext, nil
+}
+
+// accept adds the new CA as accepted.
+func (r *talosRotation) accept(cfg *v1alpha1config.Config, controlPlane bool) error {
+	if cfg.MachineConfig == nil {
+		return fmt.Errorf("machine config is missing")
+	}
+
+	cfg.MachineConfig.MachineAcceptedCAs = cluster.AddAcceptedCA(cfg.MachineConfig.MachineAcceptedCAs, r.newCA.Crt)
+
+	return nil
+}
+
+// swap makes the new CA current, keeping the old CA as accepted.
+func (r *talosRotation) swap(cfg *v1alpha1config.Config, controlPlane bool) error {
+	if cfg.MachineConfig == nil {
+		return fmt.Errorf("machine config is missing")
+	}
+
+	// worker nodes don't have the CA key
+	if controlPlane {
+		cfg.MachineConfig.MachineCA = r.newCA
+	} else {
+		cfg.MachineConfig.MachineCA = &x509.PEMEncodedCertificateAndKey{
+			Crt: r.newCA.Crt,
+		}
+	}
+
+	cfg.MachineConfig.MachineAcceptedCAs = cluster.AddAcceptedCA(cluster.RemoveAcceptedCA(cfg.MachineConfig.MachineAcceptedCAs, r.newCA.Crt), r.oldCA.Crt)
+
+	return nil
+}
+
+// drop removes the old CA.
+func (r *talosRotation) drop(cfg *v1alpha1config.Config, controlPlane bool) error {
+	if cfg.MachineConfig == nil {
+		return fmt.Errorf("machine config is missing")
+	}
+
+	cfg.MachineConfig.MachineAcceptedCAs = cluster.RemoveAcceptedCA(cfg.MachineConfig.MachineAcceptedCAs, r.oldCA.Crt)
+
+	return nil
+}
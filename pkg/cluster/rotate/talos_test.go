@@ -0,0 +1,176 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotate_test
+
+import (
+	stdlibx509 "crypto/x509"
+	"encoding/base64"
+	"testing"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/slices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/cluster/rotate"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func newCA(t *testing.T) *x509.PEMEncodedCertificateAndKey {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.ECDSA(true))
+	require.NoError(t, err)
+
+	return x509.NewCertificateAndKeyFromCertificateAuthority(ca)
+}
+
+func acceptedTalosCAs(cfg *v1alpha1.Config) [][]byte {
+	return slices.Map(cfg.MachineConfig.MachineAcceptedCAs, func(ca *x509.PEMEncodedCertificateAndKey) []byte { return ca.Crt })
+}
+
+func TestTalosRotationPatches(t *testing.T) {
+	t.Parallel()
+
+	oldCA, newCA := newCA(t), newCA(t)
+
+	controlPlane := &v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineCA: oldCA,
+		},
+	}
+
+	worker := &v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineCA: &x509.PEMEncodedCertificateAndKey{Crt: oldCA.Crt},
+		},
+	}
+
+	patches, err := rotate.TalosRotationPatches(controlPlane, rotate.TalosCAOptions{
+		NewCA: newCA,
+	})
+	require.NoError(t, err)
+	require.Len(t, patches, 3)
+
+	// phase 1: new CA is accepted
+	require.NoError(t, patches[0](controlPlane, true))
+	require.NoError(t, patches[0](worker, false))
+
+	assert.Equal(t, oldCA, controlPlane.MachineConfig.MachineCA)
+	assert.Equal(t, [][]byte{newCA.Crt}, acceptedTalosCAs(controlPlane))
+	assert.Equal(t, oldCA.Crt, worker.MachineConfig.MachineCA.Crt)
+	assert.Equal(t, [][]byte{newCA.Crt}, acceptedTalosCAs(worker))
+
+	// phase 1 is idempotent
+	require.NoError(t, patches[0](controlPlane, true))
+	assert.Equal(t, [][]byte{newCA.Crt}, acceptedTalosCAs(controlPlane))
+
+	// phase 2: new CA is current, old CA is accepted
+	require.NoError(t, patches[1](controlPlane, true))
+	require.NoError(t, patches[1](worker, false))
+
+	assert.Equal(t, newCA, controlPlane.MachineConfig.MachineCA)
+	assert.Equal(t, [][]byte{oldCA.Crt}, acceptedTalosCAs(controlPlane))
+	assert.Equal(t, &x509.PEMEncodedCertificateAndKey{Crt: newCA.Crt}, worker.MachineConfig.MachineCA)
+	assert.Equal(t, [][]byte{oldCA.Crt}, acceptedTalosCAs(worker))
+
+	// phase 3: old CA is dropped
+	require.NoError(t, patches[2](controlPlane, true))
+	require.NoError(t, patches[2](worker, false))
+
+	assert.Equal(t, newCA, controlPlane.MachineConfig.MachineCA)
+	assert.Empty(t, acceptedTalosCAs(controlPlane))
+	assert.Equal(t, newCA.Crt, worker.MachineConfig.MachineCA.Crt)
+	assert.Empty(t, acceptedTalosCAs(worker))
+}
+
+func TestTalosRotationPatchesMissingCA(t *testing.T) {
+	t.Parallel()
+
+	_, err := rotate.TalosRotationPatches(&v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineCA: &x509.PEMEncodedCertificateAndKey{Crt: newCA(t).Crt},
+		},
+	}, rotate.TalosCAOptions{})
+	assert.EqualError(t, err, "current Talos API CA is missing")
+}
+
+func TestTalosClientContext(t *testing.T) {
+	t.Parallel()
+
+	oldCA, newCA := newCA(t), newCA(t)
+
+	cfg := &v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineCA: oldCA,
+		},
+	}
+
+	template := &clientconfig.Context{
+		Endpoints: []string{"10.5.0.2"},
+		Nodes:     []string{"10.5.0.3"},
+		CA:        base64.StdEncoding.EncodeToString(oldCA.Crt),
+	}
+
+	for _, test := range []struct {
+		name         string
+		transitional bool
+		expectedCAs  [][]byte
+	}{
+		{
+			name:         "transitional",
+			transitional: true,
+			expectedCAs:  [][]byte{oldCA.Crt, newCA.Crt},
+		},
+		{
+			name:        "final",
+			expectedCAs: [][]byte{newCA.Crt},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			configContext, err := rotate.TalosClientContext(cfg, rotate.TalosCAOptions{
+				NewCA:              newCA,
+				TalosConfigContext: template,
+			}, test.transitional)
+			require.NoError(t, err)
+
+			assert.Equal(t, template.Endpoints, configContext.Endpoints)
+			assert.Equal(t, template.Nodes, configContext.Nodes)
+
+			caPEM, err := base64.StdEncoding.DecodeString(configContext.CA)
+			require.NoError(t, err)
+
+			for _, ca := range test.expectedCAs {
+				assert.Contains(t, string(caPEM), string(ca))
+			}
+
+			if !test.transitional {
+				assert.NotContains(t, string(caPEM), string(oldCA.Crt))
+			}
+
+			// client certificate is issued by the new CA
+			crtPEM, err := base64.StdEncoding.DecodeString(configContext.Crt)
+			require.NoError(t, err)
+
+			crt, err := (&x509.PEMEncodedCertificateAndKey{Crt: crtPEM}).GetCert()
+			require.NoError(t, err)
+
+			roots := stdlibx509.NewCertPool()
+			require.True(t, roots.AppendCertsFromPEM(newCA.Crt))
+
+			_, err = crt.Verify(stdlibx509.VerifyOptions{
+				Roots:     roots,
+				KeyUsages: []stdlibx509.ExtKeyUsage{stdlibx509.ExtKeyUsageClientAuth},
+			})
+			assert.NoError(t, err)
+
+			// template is not modified
+			assert.Equal(t, base64.StdEncoding.EncodeToString(oldCA.Crt), template.CA)
+		})
+	}
+}
@@ -40,6 +40,37 @@ contexts:
 current-context: {{ .ContextName }}@{{ .ClusterName }}
 `
 
+const oidcKubeConfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: {{ .ClusterName }}
+  cluster:
+    server: {{ .Endpoint }}
+    certificate-authority-data: {{ .CACert | base64Encode }}
+users:
+- name: {{ .Username }}@{{ .ClusterName }}
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: kubectl
+      args:
+      - oidc-login
+      - get-token
+      - --oidc-issuer-url={{ .IssuerURL }}
+      - --oidc-client-id={{ .ClientID }}
+{{- range .ExtraScopes }}
+      - --oidc-extra-scope={{ . }}
+{{- end }}
+      interactiveMode: IfAvailable
+contexts:
+- context:
+    cluster: {{ .ClusterName }}
+    namespace: default
+    user: {{ .Username }}@{{ .ClusterName }}
+  name: {{ .ContextName }}@{{ .ClusterName }}
+current-context: {{ .ContextName }}@{{ .ClusterName }}
+`
+
 // GenerateAdminInput is the interface for the GenerateAdmin function.
 //
 // This interface is implemented by config.Cluster().
@@ -47,6 +78,7 @@ type GenerateAdminInput interface {
 	Name() string
 	Endpoint() *url.URL
 	CA() *x509.PEMEncodedCertificateAndKey
+	AcceptedCAs() []*x509.PEMEncodedCertificateAndKey
 	AdminKubeconfig() config.AdminKubeconfig
 }
 
@@ -56,6 +88,7 @@ func GenerateAdmin(config GenerateAdminInput, out io.Writer) error {
 		&GenerateInput{
 			ClusterName:         config.Name(),
 			CA:                  config.CA(),
+			AcceptedCAs:         config.AcceptedCAs(),
 			CertificateLifetime: config.AdminKubeconfig().CertLifetime(),
 
 			CommonName:   config.AdminKubeconfig().CommonName(),
@@ -74,6 +107,7 @@ type GenerateInput struct {
 	ClusterName string
 
 	CA                  *x509.PEMEncodedCertificateAndKey
+	AcceptedCAs         []*x509.PEMEncodedCertificateAndKey
 	CertificateLifetime time.Duration
 
 	CommonName   string
@@ -113,6 +147,10 @@ func Generate(in *GenerateInput, out io.Writer) error {
 
 	clientCertPEM := x509.NewCertificateAndKeyFromKeyPair(clientCert)
 
+	// the kubeconfig trusts both the current CA and the accepted CAs, so that the
+	// client keeps working while the server certificate is being rotated
+	caCert := CABundle(in.CA, in.AcceptedCAs)
+
 	return tpl.Execute(out, struct {
 		GenerateInput
 
@@ -121,12 +159,68 @@ func Generate(in *GenerateInput, out io.Writer) error {
 		ClientKey  string
 	}{
 		GenerateInput: *in,
-		CACert:        string(in.CA.Crt),
+		CACert:        string(caCert),
 		ClientCert:    string(clientCertPEM.Crt),
 		ClientKey:     string(clientCertPEM.Key),
 	})
 }
 
+// GenerateOIDCInput are input parameters for GenerateOIDC.
+type GenerateOIDCInput struct {
+	ClusterName string
+	Endpoint    string
+
+	// CACert is the PEM-encoded CA bundle to verify the API server certificate.
+	CACert []byte
+
+	IssuerURL   string
+	ClientID    string
+	ExtraScopes []string
+
+	Username    string
+	ContextName string
+}
+
+// GenerateOIDC generates a kubeconfig which authenticates to the cluster via OpenID Connect.
+//
+// The token is obtained with the `kubectl oidc-login` exec credential plugin (kubelogin).
+func GenerateOIDC(in *GenerateOIDCInput, out io.Writer) error {
+	tpl, err := template.New("kubeconfig").Funcs(template.FuncMap{
+		"base64Encode": base64Encode,
+	}).Parse(oidcKubeConfigTemplate)
+	if err != nil {
+		return fmt.Errorf("error parsing kubeconfig template: %w", err)
+	}
+
+	return tpl.Execute(out, struct {
+		GenerateOIDCInput
+
+		CACert string
+	}{
+		GenerateOIDCInput: *in,
+		CACert:            string(in.CACert),
+	})
+}
+
+// CABundle builds a PEM bundle of the current CA and accepted CAs certificates.
+func CABundle(ca *x509.PEMEncodedCertificateAndKey, acceptedCAs []*x509.PEMEncodedCertificateAndKey) []byte {
+	bundle := append([]byte(nil), ca.Crt...)
+
+	for _, acceptedCA := range acceptedCAs {
+		if acceptedCA == nil || len(acceptedCA.Crt) == 0 {
+			continue
+		}
+
+		if len(bundle) > 0 && bundle[len(bundle)-1] != '\n' {
+			bundle = append(bundle, '\n')
+		}
+
+		bundle = append(bundle, acceptedCA.Crt...)
+	}
+
+	return bundle
+}
+
 func base64Encode(content interface{}) (string, error) {
 	str, ok := content.(string)
 	if !ok {
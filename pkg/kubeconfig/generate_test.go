@@ -94,6 +94,85 @@ func (suite *GenerateSuite) TestGenerate() {
 	suite.Assert().NoError(clientcmd.ConfirmUsable(*config, "kube-controller-manager@foo"))
 }
 
+func (suite *GenerateSuite) TestGenerateAcceptedCAs() {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.RSA(false))
+	suite.Require().NoError(err)
+
+	acceptedCA, err := x509.NewSelfSignedCertificateAuthority(x509.RSA(false))
+	suite.Require().NoError(err)
+
+	input := kubeconfig.GenerateInput{
+		ClusterName: "foo",
+
+		CA:                  x509.NewCertificateAndKeyFromCertificateAuthority(ca),
+		AcceptedCAs:         []*x509.PEMEncodedCertificateAndKey{{Crt: acceptedCA.CrtPEM}},
+		CertificateLifetime: time.Hour,
+
+		CommonName:   "system:kube-scheduler",
+		Organization: "system:kube-scheduler",
+
+		Endpoint:    "https://localhost:6443/",
+		Username:    "kube-scheduler",
+		ContextName: "kube-scheduler",
+	}
+
+	var buf bytes.Buffer
+
+	suite.Require().NoError(kubeconfig.Generate(&input, &buf))
+
+	config, err := clientcmd.Load(buf.Bytes())
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(clientcmd.ConfirmUsable(*config, "kube-scheduler@foo"))
+
+	caData := config.Clusters["foo"].CertificateAuthorityData
+
+	suite.Assert().Contains(string(caData), string(ca.CrtPEM))
+	suite.Assert().Contains(string(caData), string(acceptedCA.CrtPEM))
+}
+
+func (suite *GenerateSuite) TestGenerateOIDC() {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.RSA(false))
+	suite.Require().NoError(err)
+
+	input := kubeconfig.GenerateOIDCInput{
+		ClusterName: "foo",
+		Endpoint:    "https://localhost:6443/",
+		CACert:      ca.CrtPEM,
+
+		IssuerURL:   "https://accounts.example.com",
+		ClientID:    "kubernetes",
+		ExtraScopes: []string{"email", "groups"},
+
+		Username:    "oidc",
+		ContextName: "oidc",
+	}
+
+	var buf bytes.Buffer
+
+	suite.Require().NoError(kubeconfig.GenerateOIDC(&input, &buf))
+
+	config, err := clientcmd.Load(buf.Bytes())
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(clientcmd.ConfirmUsable(*config, "oidc@foo"))
+
+	suite.Assert().Equal(ca.CrtPEM, config.Clusters["foo"].CertificateAuthorityData)
+
+	exec := config.AuthInfos["oidc@foo"].Exec
+	suite.Require().NotNil(exec)
+
+	suite.Assert().Equal("kubectl", exec.Command)
+	suite.Assert().Equal([]string{
+		"oidc-login",
+		"get-token",
+		"--oidc-issuer-url=https://accounts.example.com",
+		"--oidc-client-id=kubernetes",
+		"--oidc-extra-scope=email",
+		"--oidc-extra-scope=groups",
+	}, exec.Args)
+}
+
 func TestGenerateSuite(t *testing.T) {
 	suite.Run(t, new(GenerateSuite))
 }
@@ -0,0 +1,185 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/siderolabs/gen/slices"
+	"github.com/siderolabs/go-pointer"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// InventoryObject is an object in the cluster labeled with the Talos manifest inventory label.
+type InventoryObject struct {
+	Resource   schema.GroupVersionResource
+	Namespaced bool
+	Object     *unstructured.Unstructured
+}
+
+// String implements fmt.Stringer.
+func (o InventoryObject) String() string {
+	gvk := o.Object.GroupVersionKind()
+
+	if o.Namespaced {
+		return fmt.Sprintf("%s/%s/%s/%s/%s", gvk.Group, gvk.Version, gvk.Kind, o.Object.GetNamespace(), o.Object.GetName())
+	}
+
+	return fmt.Sprintf("%s/%s/%s/%s", gvk.Group, gvk.Version, gvk.Kind, o.Object.GetName())
+}
+
+// Delete the object from the cluster.
+func (o InventoryObject) Delete(ctx context.Context, dyn dynamic.Interface) error {
+	var dr dynamic.ResourceInterface = dyn.Resource(o.Resource)
+
+	if o.Namespaced {
+		dr = dyn.Resource(o.Resource).Namespace(o.Object.GetNamespace())
+	}
+
+	propagation := metav1.DeletePropagationBackground
+
+	err := dr.Delete(ctx, o.Object.GetName(), metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+		Preconditions: &metav1.Preconditions{
+			UID: pointer.To(o.Object.GetUID()),
+		},
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting %s: %w", o, err)
+	}
+
+	return nil
+}
+
+// InventoryLabelSelector returns the label selector matching the objects in the Talos manifest inventory.
+func InventoryLabelSelector() string {
+	return fmt.Sprintf("%s=%s", constants.KubernetesManifestInventoryLabel, constants.KubernetesManifestInventory)
+}
+
+// InventoryPruneCandidates returns the objects in the Talos manifest inventory which are not in the list of desired objects.
+//
+// Only the objects labeled with the inventory label are considered, so objects created by other means are never pruned.
+// The returned list is sorted in the order of deletion: namespaces and CRDs come last.
+//
+//nolint:gocyclo
+func InventoryPruneCandidates(ctx context.Context, dc discovery.DiscoveryInterface, dyn dynamic.Interface, desired []*unstructured.Unstructured) ([]InventoryObject, error) {
+	desiredKeys := slices.ToSetFunc(desired, func(obj *unstructured.Unstructured) string {
+		return inventoryKey(obj.GroupVersionKind().GroupKind(), obj.GetNamespace(), obj.GetName())
+	})
+
+	resourceLists, err := dc.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("error discovering API resources: %w", err)
+	}
+
+	// objects might be served via several API groups (e.g. legacy groups), so the object is kept
+	// if it is desired under any of the groups
+	var (
+		candidates  []InventoryObject
+		desiredUIDs = map[types.UID]struct{}{}
+		seenUIDs    = map[types.UID]struct{}{}
+	)
+
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		for _, apiResource := range resourceList.APIResources {
+			if !hasVerb(apiResource.Verbs, "list") || !hasVerb(apiResource.Verbs, "delete") {
+				continue
+			}
+
+			gvr := gv.WithResource(apiResource.Name)
+
+			list, err := dyn.Resource(gvr).List(ctx, metav1.ListOptions{
+				LabelSelector: InventoryLabelSelector(),
+			})
+			if err != nil {
+				if apierrors.IsNotFound(err) || apierrors.IsMethodNotSupported(err) || apierrors.IsForbidden(err) {
+					continue
+				}
+
+				return nil, fmt.Errorf("error listing %s: %w", gvr, err)
+			}
+
+			for i := range list.Items {
+				obj := &list.Items[i]
+				gk := schema.GroupKind{Group: gv.Group, Kind: apiResource.Kind}
+
+				_, desired := desiredKeys[inventoryKey(gk, obj.GetNamespace(), obj.GetName())]
+
+				// manifests might omit the namespace for the objects in the default namespace
+				if !desired && apiResource.Namespaced && obj.GetNamespace() == metav1.NamespaceDefault {
+					_, desired = desiredKeys[inventoryKey(gk, "", obj.GetName())]
+				}
+
+				if desired {
+					desiredUIDs[obj.GetUID()] = struct{}{}
+
+					continue
+				}
+
+				if _, seen := seenUIDs[obj.GetUID()]; seen {
+					continue
+				}
+
+				seenUIDs[obj.GetUID()] = struct{}{}
+
+				obj.SetGroupVersionKind(gv.WithKind(apiResource.Kind))
+
+				candidates = append(candidates, InventoryObject{
+					Resource:   gvr,
+					Namespaced: apiResource.Namespaced,
+					Object:     obj,
+				})
+			}
+		}
+	}
+
+	candidates = slices.FilterInPlace(candidates, func(o InventoryObject) bool {
+		_, desired := desiredUIDs[o.Object.GetUID()]
+
+		return !desired
+	})
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return deletionOrder(candidates[i]) < deletionOrder(candidates[j])
+	})
+
+	return candidates, nil
+}
+
+func inventoryKey(gk schema.GroupKind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", gk, namespace, name)
+}
+
+func deletionOrder(o InventoryObject) int {
+	gvk := o.Object.GroupVersionKind()
+
+	switch {
+	case gvk.Group == "" && gvk.Kind == "Namespace":
+		return 2
+	case gvk.Group == "apiextensions.k8s.io" && gvk.Kind == "CustomResourceDefinition":
+		return 1
+	default:
+		return 0
+	}
+}
+
+func hasVerb(verbs metav1.Verbs, verb string) bool {
+	return slices.Contains(verbs, func(v string) bool { return v == verb })
+}
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/siderolabs/gen/slices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+func object(apiVersion, kind, namespace, name string, inventory bool) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetUID(types.UID(kind + "/" + namespace + "/" + name))
+
+	if inventory {
+		obj.SetLabels(map[string]string{
+			constants.KubernetesManifestInventoryLabel: constants.KubernetesManifestInventory,
+		})
+	}
+
+	return obj
+}
+
+// discoveryClient is a fake discovery client which returns the resources as the preferred ones.
+type discoveryClient struct {
+	*fakediscovery.FakeDiscovery
+}
+
+func (c discoveryClient) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	return c.Resources, nil
+}
+
+func TestInventoryPruneCandidates(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme := runtime.NewScheme()
+
+	dyn := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{
+			{Version: "v1", Resource: "namespaces"}:                                               "NamespaceList",
+			{Version: "v1", Resource: "configmaps"}:                                               "ConfigMapList",
+			{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}: "CustomResourceDefinitionList",
+		},
+		object("v1", "Namespace", "", "kept", true),
+		object("v1", "Namespace", "", "removed", true),
+		object("v1", "ConfigMap", "kept", "kept", true),
+		object("v1", "ConfigMap", "default", "kept-default", true),
+		object("v1", "ConfigMap", "removed", "removed", true),
+		object("v1", "ConfigMap", "kept", "not-in-inventory", false),
+		object("apiextensions.k8s.io/v1", "CustomResourceDefinition", "", "removed.example.com", true),
+	)
+
+	dc := discoveryClient{&fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{}}}
+	dc.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "namespaces", Kind: "Namespace", Verbs: metav1.Verbs{"list", "delete"}},
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: metav1.Verbs{"list", "delete"}},
+				{Name: "bindings", Kind: "Binding", Namespaced: true, Verbs: metav1.Verbs{"create"}},
+			},
+		},
+		{
+			GroupVersion: "apiextensions.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "customresourcedefinitions", Kind: "CustomResourceDefinition", Verbs: metav1.Verbs{"list", "delete"}},
+			},
+		},
+	}
+
+	desired := []*unstructured.Unstructured{
+		object("v1", "Namespace", "", "kept", true),
+		object("v1", "ConfigMap", "kept", "kept", true),
+		object("v1", "ConfigMap", "", "kept-default", true),
+	}
+
+	candidates, err := kubernetes.InventoryPruneCandidates(ctx, dc, dyn, desired)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"/v1/ConfigMap/removed/removed",
+			"apiextensions.k8s.io/v1/CustomResourceDefinition/removed.example.com",
+			"/v1/Namespace/removed",
+		},
+		slices.Map(candidates, kubernetes.InventoryObject.String),
+	)
+
+	for _, obj := range candidates {
+		require.NoError(t, obj.Delete(ctx, dyn))
+	}
+
+	_, err = dyn.Resource(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}).Get(ctx, "removed", metav1.GetOptions{})
+	assert.Error(t, err)
+
+	_, err = dyn.Resource(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}).Namespace("kept").Get(ctx, "not-in-inventory", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
@@ -55,6 +55,11 @@ type MachineServiceClient interface {
 	// This method is available only on control plane nodes (which run etcd).
 	EtcdSnapshot(ctx context.Context, in *EtcdSnapshotRequest, opts ...grpc.CallOption) (MachineService_EtcdSnapshotClient, error)
 	GenerateConfiguration(ctx context.Context, in *GenerateConfigurationRequest, opts ...grpc.CallOption) (*GenerateConfigurationResponse, error)
+	// GrowPartitions grows EPHEMERAL and user disk partitions online to occupy free space after them.
+	GrowPartitions(ctx context.Context, in *GrowPartitionsRequest, opts ...grpc.CallOption) (*GrowPartitionsResponse, error)
+	// PruneImages removes images in the system containerd namespace which are not referenced
+	// by Talos services and the current and previous installation.
+	PruneImages(ctx context.Context, in *PruneImagesRequest, opts ...grpc.CallOption) (*PruneImagesResponse, error)
 	Hostname(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HostnameResponse, error)
 	Kubeconfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_KubeconfigClient, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (MachineService_ListClient, error)
@@ -345,6 +350,24 @@ func (c *machineServiceClient) GenerateConfiguration(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *machineServiceClient) GrowPartitions(ctx context.Context, in *GrowPartitionsRequest, opts ...grpc.CallOption) (*GrowPartitionsResponse, error) {
+	out := new(GrowPartitionsResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/GrowPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) PruneImages(ctx context.Context, in *PruneImagesRequest, opts ...grpc.CallOption) (*PruneImagesResponse, error) {
+	out := new(PruneImagesResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/PruneImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Hostname(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HostnameResponse, error) {
 	out := new(HostnameResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/Hostname", in, out, opts...)
@@ -750,6 +773,11 @@ type MachineServiceServer interface {
 	// This method is available only on control plane nodes (which run etcd).
 	EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error
 	GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error)
+	// GrowPartitions grows EPHEMERAL and user disk partitions online to occupy free space after them.
+	GrowPartitions(context.Context, *GrowPartitionsRequest) (*GrowPartitionsResponse, error)
+	// PruneImages removes images in the system containerd namespace which are not referenced
+	// by Talos services and the current and previous installation.
+	PruneImages(context.Context, *PruneImagesRequest) (*PruneImagesResponse, error)
 	Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error)
 	Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error
 	List(*ListRequest, MachineService_ListServer) error
@@ -830,6 +858,12 @@ func (UnimplementedMachineServiceServer) EtcdSnapshot(*EtcdSnapshotRequest, Mach
 func (UnimplementedMachineServiceServer) GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfiguration not implemented")
 }
+func (UnimplementedMachineServiceServer) GrowPartitions(context.Context, *GrowPartitionsRequest) (*GrowPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrowPartitions not implemented")
+}
+func (UnimplementedMachineServiceServer) PruneImages(context.Context, *PruneImagesRequest) (*PruneImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneImages not implemented")
+}
 func (UnimplementedMachineServiceServer) Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Hostname not implemented")
 }
@@ -1211,6 +1245,42 @@ func _MachineService_GenerateConfiguration_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_GrowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrowPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).GrowPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/GrowPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).GrowPartitions(ctx, req.(*GrowPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_PruneImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).PruneImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/PruneImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).PruneImages(ctx, req.(*PruneImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_Hostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1744,6 +1814,14 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateConfiguration",
 			Handler:    _MachineService_GenerateConfiguration_Handler,
 		},
+		{
+			MethodName: "GrowPartitions",
+			Handler:    _MachineService_GrowPartitions_Handler,
+		},
+		{
+			MethodName: "PruneImages",
+			Handler:    _MachineService_PruneImages_Handler,
+		},
 		{
 			MethodName: "Hostname",
 			Handler:    _MachineService_Hostname_Handler,
@@ -153,6 +153,25 @@ func (m *ConfigSpec) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.RegistryKubernetesLegacyAnnotations {
+		i--
+		if m.RegistryKubernetesLegacyAnnotations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RegistryKubernetesEncryptionKeys) > 0 {
+		for iNdEx := len(m.RegistryKubernetesEncryptionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RegistryKubernetesEncryptionKeys[iNdEx])
+			copy(dAtA[i:], m.RegistryKubernetesEncryptionKeys[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RegistryKubernetesEncryptionKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.ServiceClusterId) > 0 {
 		i -= len(m.ServiceClusterId)
 		copy(dAtA[i:], m.ServiceClusterId)
@@ -538,6 +557,15 @@ func (m *ConfigSpec) SizeVT() (n int) {
 	if l > 0 {
 		n += 1 + l + sov(uint64(l))
 	}
+	if len(m.RegistryKubernetesEncryptionKeys) > 0 {
+		for _, b := range m.RegistryKubernetesEncryptionKeys {
+			l = len(b)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.RegistryKubernetesLegacyAnnotations {
+		n += 2
+	}
 	if m.unknownFields != nil {
 		n += len(m.unknownFields)
 	}
@@ -1138,6 +1166,58 @@ func (m *ConfigSpec) UnmarshalVT(dAtA []byte) error {
 			}
 			m.ServiceClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryKubernetesEncryptionKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistryKubernetesEncryptionKeys = append(m.RegistryKubernetesEncryptionKeys, make([]byte, postIndex-iNdEx))
+			copy(m.RegistryKubernetesEncryptionKeys[len(m.RegistryKubernetesEncryptionKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryKubernetesLegacyAnnotations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RegistryKubernetesLegacyAnnotations = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -402,6 +402,30 @@ func (c *Client) Rollback(ctx context.Context) (err error) {
 	return
 }
 
+// GrowPartitions implements the proto.MachineServiceClient interface.
+func (c *Client) GrowPartitions(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.GrowPartitionsResponse, err error) {
+	resp, err = c.MachineClient.GrowPartitions(ctx, &machineapi.GrowPartitionsRequest{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.GrowPartitionsResponse) //nolint:errcheck
+
+	return
+}
+
+// PruneImages removes unreferenced images in the system containerd namespace.
+//
+// If dryRun is set, images which would be removed are listed without removing them.
+func (c *Client) PruneImages(ctx context.Context, dryRun bool, callOptions ...grpc.CallOption) (resp *machineapi.PruneImagesResponse, err error) {
+	resp, err = c.MachineClient.PruneImages(ctx, &machineapi.PruneImagesRequest{DryRun: dryRun}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.PruneImagesResponse) //nolint:errcheck
+
+	return
+}
+
 // Bootstrap implements the proto.MachineServiceClient interface.
 func (c *Client) Bootstrap(ctx context.Context, req *machineapi.BootstrapRequest) (err error) {
 	resp, err := c.MachineClient.Bootstrap(ctx, req)
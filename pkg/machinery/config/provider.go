@@ -13,6 +13,7 @@ import (
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/go-blockdevice/blockdevice/util/disk"
 
 	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -50,6 +51,7 @@ type MachineConfig interface {
 	Security() Security
 	Network() MachineNetwork
 	Disks() []Disk
+	VolumeGroups() []VolumeGroup
 	Time() Time
 	Env() Env
 	Files() ([]File, error)
@@ -65,7 +67,11 @@ type MachineConfig interface {
 	Udev() UdevConfig
 	Logging() Logging
 	Kernel() Kernel
+	ImageGC() ImageGC
 	SeccompProfiles() []SeccompProfile
+	NodeLabels() map[string]string
+	NodeAnnotations() map[string]string
+	NodeTaints() map[string]string
 }
 
 // SeccompProfile defines the requirements for a config that pertains to seccomp
@@ -86,6 +92,25 @@ type Disk interface {
 type Partition interface {
 	Size() uint64
 	MountPoint() string
+	Filesystem() string
+	MountOptions() []string
+}
+
+// VolumeGroup represents an LVM volume group created by Talos.
+type VolumeGroup interface {
+	Name() string
+	PhysicalVolumes() []string
+	LogicalVolumes() []LogicalVolume
+}
+
+// LogicalVolume represents an LVM logical volume created by Talos.
+type LogicalVolume interface {
+	Name() string
+	Type() string
+	Mirrors() int
+	Size() uint64
+	MountPoint() string
+	Filesystem() string
 }
 
 // Env represents a set of environment variables.
@@ -105,10 +130,12 @@ type Install interface {
 	Image() string
 	Extensions() []Extension
 	Disk() (string, error)
+	DiskMatchers() []disk.Matcher
 	ExtraKernelArgs() []string
 	Zero() bool
 	LegacyBIOSSupport() bool
 	WithBootloader() bool
+	GrowPartitions() bool
 }
 
 // Extension defines the system extension.
@@ -120,6 +147,7 @@ type Extension interface {
 // related options.
 type Security interface {
 	CA() *x509.PEMEncodedCertificateAndKey
+	AcceptedCAs() []*x509.PEMEncodedCertificateAndKey
 	Token() string
 	CertSANs() []string
 }
@@ -152,6 +180,9 @@ type MachineNetwork interface {
 	ExtraHosts() []ExtraHost
 	KubeSpan() KubeSpan
 	DisableSearchDomain() bool
+	Probes() []NetworkProbe
+	Rules() []NetworkRule
+	LLDP() LLDP
 }
 
 // ExtraHost represents a host entry in /etc/hosts.
@@ -160,6 +191,51 @@ type ExtraHost interface {
 	Aliases() []string
 }
 
+// NetworkProbe represents a network reachability probe.
+type NetworkProbe interface {
+	Name() string
+	Interval() time.Duration
+	FailureThreshold() int
+	TCP() NetworkProbeTCP
+	ICMP() NetworkProbeICMP
+	HTTP() NetworkProbeHTTP
+}
+
+// NetworkProbeTCP represents a TCP connect probe.
+type NetworkProbeTCP interface {
+	Endpoint() string
+	Timeout() time.Duration
+}
+
+// NetworkProbeICMP represents an ICMP echo probe.
+type NetworkProbeICMP interface {
+	Host() string
+	Timeout() time.Duration
+}
+
+// NetworkProbeHTTP represents an HTTP GET probe.
+type NetworkProbeHTTP interface {
+	URL() string
+	Timeout() time.Duration
+}
+
+// NetworkRule represents a routing policy rule.
+type NetworkRule interface {
+	From() string
+	To() string
+	FwMark() uint32
+	FwMask() uint32
+	IIFName() string
+	OIFName() string
+	Table() uint32
+	Priority() uint32
+}
+
+// LLDP defines the requirements for a config that pertains to LLDP neighbor discovery.
+type LLDP interface {
+	Transmit() bool
+}
+
 // Device represents a network interface.
 //
 //nolint:interfacebloat
@@ -178,6 +254,7 @@ type Device interface {
 	VIPConfig() VIPConfig
 	WireguardConfig() WireguardConfig
 	Selector() NetworkDeviceSelector
+	IPv6() DeviceIPv6
 }
 
 // DHCPOptions represents a set of DHCP options.
@@ -186,6 +263,18 @@ type DHCPOptions interface {
 	IPv4() bool
 	IPv6() bool
 	DUIDv6() string
+	ClientIdentifier() string
+	VendorClass() string
+	UserClasses() []string
+	RequestedOptions() []int
+	SendHostname() string
+}
+
+// DeviceIPv6 represents IPv6 settings of the network interface.
+type DeviceIPv6 interface {
+	Mode() string
+	AcceptRA() bool
+	UseTempAddr() int
 }
 
 // VIPConfig contains settings for the Virtual (shared) IP setup.
@@ -193,6 +282,21 @@ type VIPConfig interface {
 	IP() string
 	EquinixMetal() VIPEquinixMetal
 	HCloud() VIPHCloud
+	BGP() VIPBGP
+}
+
+// VIPBGP contains settings for announcing VIP over BGP.
+type VIPBGP interface {
+	LocalASN() uint32
+	RouterID() string
+	HoldTime() time.Duration
+	Peers() []VIPBGPPeer
+}
+
+// VIPBGPPeer describes a BGP peer.
+type VIPBGPPeer interface {
+	Address() string
+	ASN() uint32
 }
 
 // VIPEquinixMetal contains Equinix Metal API VIP settings.
@@ -318,6 +422,7 @@ type Kubelet interface {
 	ExtraArgs() map[string]string
 	ExtraMounts() []specs.Mount
 	ExtraConfig() map[string]interface{}
+	CredentialProviderConfig() map[string]interface{}
 	DefaultRuntimeSeccompProfileEnabled() bool
 	RegisterWithFQDN() bool
 	NodeIP() KubeletNodeIP
@@ -381,8 +486,10 @@ type ClusterConfig interface {
 	Token() Token
 	CertSANs() []string
 	CA() *x509.PEMEncodedCertificateAndKey
+	AcceptedCAs() []*x509.PEMEncodedCertificateAndKey
 	AggregatorCA() *x509.PEMEncodedCertificateAndKey
 	ServiceAccount() *x509.PEMEncodedKey
+	AcceptedServiceAccountKeys() []*x509.PEMEncodedKey
 	AESCBCEncryptionSecret() string
 	SecretboxEncryptionSecret() string
 	Config(machine.Type) (string, error)
@@ -394,9 +501,12 @@ type ClusterConfig interface {
 	ExternalCloudProvider() ExternalCloudProvider
 	ExtraManifestURLs() []string
 	ExtraManifestHeaderMap() map[string]string
+	ExtraManifestsPrune() bool
 	InlineManifests() []InlineManifest
+	BootstrapManifestsPrune() bool
 	AdminKubeconfig() AdminKubeconfig
 	ScheduleOnControlPlanes() bool
+	KubeletServingCertificateApprover() bool
 	Discovery() Discovery
 }
 
@@ -430,6 +540,46 @@ type APIServer interface {
 	DisablePodSecurityPolicy() bool
 	AdmissionControl() []AdmissionPlugin
 	AuditPolicy() map[string]interface{}
+	KMSProviders() []KMSProvider
+	Resources() Resources
+	PriorityClassName() string
+	AuditLog() AuditLog
+	OIDC() OIDC
+}
+
+// OIDC defines the API server OpenID Connect authentication configuration.
+type OIDC interface {
+	Enabled() bool
+	IssuerURL() string
+	ClientID() string
+	UsernameClaim() string
+	UsernamePrefix() string
+	GroupsClaim() string
+	GroupsPrefix() string
+	RequiredClaims() map[string]string
+	SigningAlgorithms() []string
+	CA() *x509.PEMEncodedCertificateAndKey
+}
+
+// AuditLog defines the API server audit log retention.
+type AuditLog interface {
+	MaxAge() int
+	MaxBackups() int
+	MaxSize() int
+}
+
+// Resources defines the resource requests and limits of a control plane component.
+type Resources interface {
+	Requests() map[string]string
+	Limits() map[string]string
+}
+
+// KMSProvider defines the API server KMS v2 provider configuration.
+type KMSProvider interface {
+	Name() string
+	Endpoint() string
+	Timeout() time.Duration
+	DecryptOnly() bool
 }
 
 // AdmissionPlugin defines the API server Admission Plugin configuration.
@@ -445,6 +595,8 @@ type ControllerManager interface {
 	ExtraArgs() map[string]string
 	ExtraVolumes() []VolumeMount
 	Env() Env
+	Resources() Resources
+	PriorityClassName() string
 }
 
 // Proxy defines the requirements for a config that pertains to the kube-proxy
@@ -468,6 +620,8 @@ type Scheduler interface {
 	ExtraArgs() map[string]string
 	ExtraVolumes() []VolumeMount
 	Env() Env
+	Resources() Resources
+	PriorityClassName() string
 }
 
 // Etcd defines the requirements for a config that pertains to etcd related
@@ -565,6 +719,7 @@ type VolumeMount interface {
 type InlineManifest interface {
 	Name() string
 	Contents() string
+	Prune() bool
 }
 
 // Discovery describes cluster membership discovery.
@@ -582,6 +737,8 @@ type DiscoveryRegistries interface {
 // KubernetesRegistry describes Kubernetes discovery registry.
 type KubernetesRegistry interface {
 	Enabled() bool
+	AcceptedSecrets() []string
+	LegacyAnnotations() bool
 }
 
 // ServiceRegistry describes external service discovery registry.
@@ -611,6 +768,13 @@ type Kernel interface {
 	Modules() []KernelModule
 }
 
+// ImageGC describes the garbage collection policy for the system containerd namespace images.
+type ImageGC interface {
+	Enabled() bool
+	Interval() time.Duration
+	MinAge() time.Duration
+}
+
 // KernelModule describes Linux module to load.
 type KernelModule interface {
 	Name() string
@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	"fmt"
 
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/slices"
 	"github.com/siderolabs/go-pointer"
 
@@ -71,3 +72,121 @@ func (a *APIServerConfig) AuditPolicy() map[string]interface{} {
 
 	return a.AuditPolicyConfig.Object
 }
+
+// KMSProviders implements the config.APIServer interface.
+func (a *APIServerConfig) KMSProviders() []config.KMSProvider {
+	return slices.Map(a.KMSProvidersConfig, func(c *KMSProviderConfig) config.KMSProvider { return c })
+}
+
+// AuditLog implements the config.APIServer interface.
+func (a *APIServerConfig) AuditLog() config.AuditLog {
+	if a.AuditLogConfig == nil {
+		return &AuditLogConfig{}
+	}
+
+	return a.AuditLogConfig
+}
+
+// OIDC implements the config.APIServer interface.
+func (a *APIServerConfig) OIDC() config.OIDC {
+	if a.OIDCConfig == nil {
+		return &OIDCConfig{}
+	}
+
+	return a.OIDCConfig
+}
+
+// Resources implements the config.APIServer interface.
+func (a *APIServerConfig) Resources() config.Resources {
+	if a.ResourcesConfig == nil {
+		return &ResourcesConfig{}
+	}
+
+	return a.ResourcesConfig
+}
+
+// MaxAge implements the config.AuditLog interface.
+func (a *AuditLogConfig) MaxAge() int {
+	if a.AuditLogMaxAge == nil {
+		return constants.KubernetesAuditLogDefaultMaxAge
+	}
+
+	return *a.AuditLogMaxAge
+}
+
+// MaxBackups implements the config.AuditLog interface.
+func (a *AuditLogConfig) MaxBackups() int {
+	if a.AuditLogMaxBackups == nil {
+		return constants.KubernetesAuditLogDefaultMaxBackups
+	}
+
+	return *a.AuditLogMaxBackups
+}
+
+// MaxSize implements the config.AuditLog interface.
+func (a *AuditLogConfig) MaxSize() int {
+	if a.AuditLogMaxSize == nil {
+		return constants.KubernetesAuditLogDefaultMaxSize
+	}
+
+	return *a.AuditLogMaxSize
+}
+
+// Enabled implements the config.OIDC interface.
+func (o *OIDCConfig) Enabled() bool {
+	return o.OIDCIssuerURL != ""
+}
+
+// IssuerURL implements the config.OIDC interface.
+func (o *OIDCConfig) IssuerURL() string {
+	return o.OIDCIssuerURL
+}
+
+// ClientID implements the config.OIDC interface.
+func (o *OIDCConfig) ClientID() string {
+	return o.OIDCClientID
+}
+
+// UsernameClaim implements the config.OIDC interface.
+func (o *OIDCConfig) UsernameClaim() string {
+	return o.OIDCUsernameClaim
+}
+
+// UsernamePrefix implements the config.OIDC interface.
+func (o *OIDCConfig) UsernamePrefix() string {
+	return o.OIDCUsernamePrefix
+}
+
+// GroupsClaim implements the config.OIDC interface.
+func (o *OIDCConfig) GroupsClaim() string {
+	return o.OIDCGroupsClaim
+}
+
+// GroupsPrefix implements the config.OIDC interface.
+func (o *OIDCConfig) GroupsPrefix() string {
+	return o.OIDCGroupsPrefix
+}
+
+// RequiredClaims implements the config.OIDC interface.
+func (o *OIDCConfig) RequiredClaims() map[string]string {
+	return o.OIDCRequiredClaims
+}
+
+// SigningAlgorithms implements the config.OIDC interface.
+func (o *OIDCConfig) SigningAlgorithms() []string {
+	return o.OIDCSigningAlgorithms
+}
+
+// CA implements the config.OIDC interface.
+func (o *OIDCConfig) CA() *x509.PEMEncodedCertificateAndKey {
+	return o.OIDCCA
+}
+
+// PriorityClassName implements the config.APIServer interface.
+func (a *APIServerConfig) PriorityClassName() string {
+	if a.PriorityClassNameConfig == "" {
+		return constants.KubernetesControlPlaneDefaultPriorityClassName
+	}
+
+	return a.PriorityClassNameConfig
+}
@@ -87,6 +87,11 @@ func (c *ClusterConfig) CA() *x509.PEMEncodedCertificateAndKey {
 	return c.ClusterCA
 }
 
+// AcceptedCAs implements the config.ClusterConfig interface.
+func (c *ClusterConfig) AcceptedCAs() []*x509.PEMEncodedCertificateAndKey {
+	return c.ClusterAcceptedCAs
+}
+
 // AggregatorCA implements the config.ClusterConfig interface.
 func (c *ClusterConfig) AggregatorCA() *x509.PEMEncodedCertificateAndKey {
 	return c.ClusterAggregatorCA
@@ -97,6 +102,11 @@ func (c *ClusterConfig) ServiceAccount() *x509.PEMEncodedKey {
 	return c.ClusterServiceAccount
 }
 
+// AcceptedServiceAccountKeys implements the config.ClusterConfig interface.
+func (c *ClusterConfig) AcceptedServiceAccountKeys() []*x509.PEMEncodedKey {
+	return c.ClusterAcceptedServiceAccountKeys
+}
+
 // AESCBCEncryptionSecret implements the config.ClusterConfig interface.
 func (c *ClusterConfig) AESCBCEncryptionSecret() string {
 	return c.ClusterAESCBCEncryptionSecret
@@ -163,6 +173,16 @@ func (c *ClusterConfig) ExtraManifestHeaderMap() map[string]string {
 	return c.ExtraManifestHeaders
 }
 
+// ExtraManifestsPrune implements the config.ClusterConfig interface.
+func (c *ClusterConfig) ExtraManifestsPrune() bool {
+	return pointer.SafeDeref(c.ExtraManifestsPruneConfig)
+}
+
+// BootstrapManifestsPrune implements the config.ClusterConfig interface.
+func (c *ClusterConfig) BootstrapManifestsPrune() bool {
+	return pointer.SafeDeref(c.BootstrapManifestsPruneConfig)
+}
+
 // InlineManifests implements the config.ClusterConfig interface.
 func (c *ClusterConfig) InlineManifests() []config.InlineManifest {
 	return slices.Map(c.ClusterInlineManifests, func(m ClusterInlineManifest) config.InlineManifest { return m })
@@ -186,6 +206,11 @@ func (c *ClusterConfig) ScheduleOnControlPlanes() bool {
 	return pointer.SafeDeref(c.AllowSchedulingOnMasters)
 }
 
+// KubeletServingCertificateApprover implements the config.ClusterConfig interface.
+func (c *ClusterConfig) KubeletServingCertificateApprover() bool {
+	return !pointer.SafeDeref(c.KubeletServingCertificateApproverDisabled)
+}
+
 // ID returns the unique identifier for the cluster.
 func (c *ClusterConfig) ID() string {
 	return c.ClusterID
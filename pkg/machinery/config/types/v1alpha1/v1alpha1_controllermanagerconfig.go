@@ -38,3 +38,21 @@ func (c *ControllerManagerConfig) ExtraVolumes() []config.VolumeMount {
 func (c *ControllerManagerConfig) Env() Env {
 	return c.EnvConfig
 }
+
+// Resources implements the config.ControllerManager interface.
+func (c *ControllerManagerConfig) Resources() config.Resources {
+	if c.ResourcesConfig == nil {
+		return &ResourcesConfig{}
+	}
+
+	return c.ResourcesConfig
+}
+
+// PriorityClassName implements the config.ControllerManager interface.
+func (c *ControllerManagerConfig) PriorityClassName() string {
+	if c.PriorityClassNameConfig == "" {
+		return constants.KubernetesControlPlaneDefaultPriorityClassName
+	}
+
+	return c.PriorityClassNameConfig
+}
@@ -36,6 +36,16 @@ func (c RegistryKubernetesConfig) Enabled() bool {
 	return !pointer.SafeDeref(c.RegistryDisabled)
 }
 
+// AcceptedSecrets implements the config.KubernetesRegistry interface.
+func (c RegistryKubernetesConfig) AcceptedSecrets() []string {
+	return c.RegistryAcceptedSecrets
+}
+
+// LegacyAnnotations implements the config.KubernetesRegistry interface.
+func (c RegistryKubernetesConfig) LegacyAnnotations() bool {
+	return pointer.SafeDeref(c.RegistryLegacyAnnotations)
+}
+
 // Enabled implements the config.ServiceRegistry interface.
 func (c RegistryServiceConfig) Enabled() bool {
 	return !pointer.SafeDeref(c.RegistryDisabled)
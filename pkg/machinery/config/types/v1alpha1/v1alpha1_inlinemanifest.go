@@ -4,6 +4,8 @@
 
 package v1alpha1
 
+import "github.com/siderolabs/go-pointer"
+
 // Name implements the config.InlineManifest interface.
 func (m ClusterInlineManifest) Name() string {
 	return m.InlineManifestName
@@ -13,3 +15,8 @@ func (m ClusterInlineManifest) Name() string {
 func (m ClusterInlineManifest) Contents() string {
 	return m.InlineManifestContents
 }
+
+// Prune implements the config.InlineManifest interface.
+func (m ClusterInlineManifest) Prune() bool {
+	return pointer.SafeDeref(m.InlineManifestPrune)
+}
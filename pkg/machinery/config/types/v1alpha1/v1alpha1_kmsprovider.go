@@ -0,0 +1,37 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// Name implements the config.KMSProvider interface.
+func (k *KMSProviderConfig) Name() string {
+	return k.KMSName
+}
+
+// Endpoint implements the config.KMSProvider interface.
+func (k *KMSProviderConfig) Endpoint() string {
+	return k.KMSEndpoint
+}
+
+// Timeout implements the config.KMSProvider interface.
+func (k *KMSProviderConfig) Timeout() time.Duration {
+	if k.KMSTimeout == 0 {
+		return constants.KubernetesAPIServerKMSDefaultTimeout
+	}
+
+	return k.KMSTimeout
+}
+
+// DecryptOnly implements the config.KMSProvider interface.
+func (k *KMSProviderConfig) DecryptOnly() bool {
+	return pointer.SafeDeref(k.KMSDecryptOnly)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 )
 
 const (
@@ -121,6 +122,11 @@ func (m *MachineConfig) Disks() []config.Disk {
 	return slices.Map(m.MachineDisks, func(d *MachineDisk) config.Disk { return d })
 }
 
+// VolumeGroups implements the config.Provider interface.
+func (m *MachineConfig) VolumeGroups() []config.VolumeGroup {
+	return slices.Map(m.MachineVolumeGroups, func(vg *VolumeGroup) config.VolumeGroup { return vg })
+}
+
 // Network implements the config.Provider interface.
 func (m *MachineConfig) Network() config.MachineNetwork {
 	if m.MachineNetwork == nil {
@@ -221,6 +227,21 @@ func (m *MachineConfig) Sysctls() map[string]string {
 	return m.MachineSysctls
 }
 
+// NodeLabels implements the config.Provider interface.
+func (m *MachineConfig) NodeLabels() map[string]string {
+	return m.MachineNodeLabels
+}
+
+// NodeAnnotations implements the config.Provider interface.
+func (m *MachineConfig) NodeAnnotations() map[string]string {
+	return m.MachineNodeAnnotations
+}
+
+// NodeTaints implements the config.Provider interface.
+func (m *MachineConfig) NodeTaints() map[string]string {
+	return m.MachineNodeTaints
+}
+
 // Sysfs implements the config.Provider interface.
 func (m *MachineConfig) Sysfs() map[string]string {
 	if m.MachineSysfs == nil {
@@ -235,6 +256,11 @@ func (m *MachineConfig) CA() *x509.PEMEncodedCertificateAndKey {
 	return m.MachineCA
 }
 
+// AcceptedCAs implements the config.Provider interface.
+func (m *MachineConfig) AcceptedCAs() []*x509.PEMEncodedCertificateAndKey {
+	return m.MachineAcceptedCAs
+}
+
 // Token implements the config.Provider interface.
 func (m *MachineConfig) Token() string {
 	return m.MachineToken
@@ -295,6 +321,38 @@ func (m *MachineConfig) Kernel() config.Kernel {
 	return m.MachineKernel
 }
 
+// ImageGC implements the config.MachineConfig interface.
+func (m *MachineConfig) ImageGC() config.ImageGC {
+	if m.MachineImageGC == nil {
+		return &ImageGCConfig{}
+	}
+
+	return m.MachineImageGC
+}
+
+// Enabled implements the config.ImageGC interface.
+func (i *ImageGCConfig) Enabled() bool {
+	return pointer.SafeDeref(i.ImageGCEnabled)
+}
+
+// Interval implements the config.ImageGC interface.
+func (i *ImageGCConfig) Interval() time.Duration {
+	if i.ImageGCInterval == 0 {
+		return constants.DefaultImageGCInterval
+	}
+
+	return i.ImageGCInterval
+}
+
+// MinAge implements the config.ImageGC interface.
+func (i *ImageGCConfig) MinAge() time.Duration {
+	if i.ImageGCMinAge == 0 {
+		return constants.DefaultImageGCMinAge
+	}
+
+	return i.ImageGCMinAge
+}
+
 // Image implements the config.Provider interface.
 func (k *KubeletConfig) Image() string {
 	image := k.KubeletImage
@@ -334,6 +392,11 @@ func (k *KubeletConfig) ExtraConfig() map[string]interface{} {
 	return k.KubeletExtraConfig.Object
 }
 
+// CredentialProviderConfig implements the config.Provider interface.
+func (k *KubeletConfig) CredentialProviderConfig() map[string]interface{} {
+	return k.KubeletCredentialProviderConfig.Object
+}
+
 // DefaultRuntimeSeccompProfileEnabled implements the config.Provider interface.
 func (k *KubeletConfig) DefaultRuntimeSeccompProfileEnabled() bool {
 	return pointer.SafeDeref(k.KubeletDefaultRuntimeSeccompProfileEnabled)
@@ -521,6 +584,30 @@ func (n *NetworkConfig) KubeSpan() config.KubeSpan {
 	return n.NetworkKubeSpan
 }
 
+// Probes implements the config.Provider interface.
+func (n *NetworkConfig) Probes() []config.NetworkProbe {
+	return slices.Map(n.NetworkProbes, func(p *NetworkProbe) config.NetworkProbe { return p })
+}
+
+// Rules implements the config.Provider interface.
+func (n *NetworkConfig) Rules() []config.NetworkRule {
+	return slices.Map(n.NetworkRules, func(r *NetworkRule) config.NetworkRule { return r })
+}
+
+// LLDP implements the config.Provider interface.
+func (n *NetworkConfig) LLDP() config.LLDP {
+	if n.NetworkLLDP == nil {
+		return &NetworkLLDP{}
+	}
+
+	return n.NetworkLLDP
+}
+
+// Transmit implements the config.LLDP interface.
+func (l *NetworkLLDP) Transmit() bool {
+	return pointer.SafeDeref(l.LLDPTransmit)
+}
+
 // IP implements the MachineNetwork interface.
 func (e *ExtraHost) IP() string {
 	return e.HostIP
@@ -531,6 +618,138 @@ func (e *ExtraHost) Aliases() []string {
 	return e.HostAliases
 }
 
+// Name implements the config.NetworkProbe interface.
+func (p *NetworkProbe) Name() string {
+	return p.ProbeName
+}
+
+// Interval implements the config.NetworkProbe interface.
+func (p *NetworkProbe) Interval() time.Duration {
+	if p.ProbeInterval == 0 {
+		return constants.NetworkProbeDefaultInterval
+	}
+
+	return p.ProbeInterval
+}
+
+// FailureThreshold implements the config.NetworkProbe interface.
+func (p *NetworkProbe) FailureThreshold() int {
+	if p.ProbeFailureThreshold == 0 {
+		return constants.NetworkProbeDefaultFailureThreshold
+	}
+
+	return p.ProbeFailureThreshold
+}
+
+// TCP implements the config.NetworkProbe interface.
+func (p *NetworkProbe) TCP() config.NetworkProbeTCP {
+	if p.ProbeTCP == nil {
+		return nil
+	}
+
+	return p.ProbeTCP
+}
+
+// ICMP implements the config.NetworkProbe interface.
+func (p *NetworkProbe) ICMP() config.NetworkProbeICMP {
+	if p.ProbeICMP == nil {
+		return nil
+	}
+
+	return p.ProbeICMP
+}
+
+// HTTP implements the config.NetworkProbe interface.
+func (p *NetworkProbe) HTTP() config.NetworkProbeHTTP {
+	if p.ProbeHTTP == nil {
+		return nil
+	}
+
+	return p.ProbeHTTP
+}
+
+// Endpoint implements the config.NetworkProbeTCP interface.
+func (p *NetworkProbeTCP) Endpoint() string {
+	return p.TCPEndpoint
+}
+
+// Timeout implements the config.NetworkProbeTCP interface.
+func (p *NetworkProbeTCP) Timeout() time.Duration {
+	if p.TCPTimeout == 0 {
+		return constants.NetworkProbeDefaultTimeout
+	}
+
+	return p.TCPTimeout
+}
+
+// Host implements the config.NetworkProbeICMP interface.
+func (p *NetworkProbeICMP) Host() string {
+	return p.ICMPHost
+}
+
+// Timeout implements the config.NetworkProbeICMP interface.
+func (p *NetworkProbeICMP) Timeout() time.Duration {
+	if p.ICMPTimeout == 0 {
+		return constants.NetworkProbeDefaultTimeout
+	}
+
+	return p.ICMPTimeout
+}
+
+// URL implements the config.NetworkProbeHTTP interface.
+func (p *NetworkProbeHTTP) URL() string {
+	return p.HTTPURL
+}
+
+// Timeout implements the config.NetworkProbeHTTP interface.
+func (p *NetworkProbeHTTP) Timeout() time.Duration {
+	if p.HTTPTimeout == 0 {
+		return constants.NetworkProbeDefaultTimeout
+	}
+
+	return p.HTTPTimeout
+}
+
+// From implements the config.NetworkRule interface.
+func (r *NetworkRule) From() string {
+	return r.RuleFrom
+}
+
+// To implements the config.NetworkRule interface.
+func (r *NetworkRule) To() string {
+	return r.RuleTo
+}
+
+// FwMark implements the config.NetworkRule interface.
+func (r *NetworkRule) FwMark() uint32 {
+	return r.RuleFwMark
+}
+
+// FwMask implements the config.NetworkRule interface.
+func (r *NetworkRule) FwMask() uint32 {
+	return r.RuleFwMask
+}
+
+// IIFName implements the config.NetworkRule interface.
+func (r *NetworkRule) IIFName() string {
+	return r.RuleIIFName
+}
+
+// OIFName implements the config.NetworkRule interface.
+func (r *NetworkRule) OIFName() string {
+	return r.RuleOIFName
+}
+
+// Table implements the config.NetworkRule interface.
+func (r *NetworkRule) Table() uint32 {
+	return r.RuleTable
+}
+
+// Priority implements the config.NetworkRule interface.
+func (r *NetworkRule) Priority() uint32 {
+	return r.RulePriority
+}
+
 // Interface implements the MachineNetwork interface.
 func (d *Device) Interface() string {
 	return d.DeviceInterface
@@ -659,6 +878,45 @@ func (v *VIPHCloudConfig) APIToken() string {
 	return v.HCloudAPIToken
 }
 
+// BGP implements the config.VIPConfig interface.
+func (d *DeviceVIPConfig) BGP() config.VIPBGP {
+	if d.BGPConfig == nil {
+		return nil
+	}
+
+	return d.BGPConfig
+}
+
+// LocalASN implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) LocalASN() uint32 {
+	return v.BGPLocalASN
+}
+
+// RouterID implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) RouterID() string {
+	return v.BGPRouterID
+}
+
+// HoldTime implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) HoldTime() time.Duration {
+	return v.BGPHoldTime
+}
+
+// Peers implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) Peers() []config.VIPBGPPeer {
+	return slices.Map(v.BGPPeers, func(p *VIPBGPPeer) config.VIPBGPPeer { return p })
+}
+
+// Address implements the config.VIPBGPPeer interface.
+func (p *VIPBGPPeer) Address() string {
+	return p.PeerAddress
+}
+
+// ASN implements the config.VIPBGPPeer interface.
+func (p *VIPBGPPeer) ASN() uint32 {
+	return p.PeerASN
+}
+
 // WireguardConfig implements the MachineNetwork interface.
 func (d *Device) WireguardConfig() config.WireguardConfig {
 	if d.DeviceWireguardConfig == nil {
@@ -668,6 +926,34 @@ func (d *Device) WireguardConfig() config.WireguardConfig {
 	return d.DeviceWireguardConfig
 }
 
+// IPv6 implements the MachineNetwork interface.
+func (d *Device) IPv6() config.DeviceIPv6 {
+	if d.DeviceIPv6Config == nil {
+		return nil
+	}
+
+	return d.DeviceIPv6Config
+}
+
+// Mode implements the config.DeviceIPv6 interface.
+func (c *DeviceIPv6Config) Mode() string {
+	return c.IPv6Mode
+}
+
+// AcceptRA implements the config.DeviceIPv6 interface.
+func (c *DeviceIPv6Config) AcceptRA() bool {
+	if c.IPv6AcceptRA == nil {
+		return c.IPv6Mode != nethelpers.IPv6ModeStatic.String()
+	}
+
+	return *c.IPv6AcceptRA
+}
+
+// UseTempAddr implements the config.DeviceIPv6 interface.
+func (c *DeviceIPv6Config) UseTempAddr() int {
+	return c.IPv6UseTempAddr
+}
+
 // RouteMetric implements the DHCPOptions interface.
 func (d *DHCPOptions) RouteMetric() uint32 {
 	return d.DHCPRouteMetric
@@ -696,6 +982,35 @@ func (d *DHCPOptions) DUIDv6() string {
 	return d.DHCPDUIDv6
 }
 
+// ClientIdentifier implements the DHCPOptions interface.
+func (d *DHCPOptions) ClientIdentifier() string {
+	return d.DHCPClientIdentifier
+}
+
+// VendorClass implements the DHCPOptions interface.
+func (d *DHCPOptions) VendorClass() string {
+	return d.DHCPVendorClass
+}
+
+// UserClasses implements the DHCPOptions interface.
+func (d *DHCPOptions) UserClasses() []string {
+	return d.DHCPUserClasses
+}
+
+// RequestedOptions implements the DHCPOptions interface.
+func (d *DHCPOptions) RequestedOptions() []int {
+	return d.DHCPRequestedOptions
+}
+
+// SendHostname implements the DHCPOptions interface.
+func (d *DHCPOptions) SendHostname() string {
+	if d.DHCPSendHostname == "" {
+		return nethelpers.DHCPSendHostnameNone.String()
+	}
+
+	return d.DHCPSendHostname
+}
+
 // PrivateKey implements the MachineNetwork interface.
 func (wc *DeviceWireguardConfig) PrivateKey() string {
 	return wc.WireguardPrivateKey
@@ -1138,6 +1453,15 @@ func (i *InstallConfig) WithBootloader() bool {
 	return pointer.SafeDeref(i.InstallBootloader)
 }
 
+// GrowPartitions implements the config.Provider interface.
+func (i *InstallConfig) GrowPartitions() bool {
+	if i.InstallGrowPartitions == nil {
+		return true
+	}
+
+	return *i.InstallGrowPartitions
+}
+
 // Image implements the config.Provider interface.
 func (i InstallExtensionConfig) Image() string {
 	return i.ExtensionImage
@@ -1218,6 +1542,81 @@ func (p *DiskPartition) MountPoint() string {
 	return p.DiskMountPoint
 }
 
+// Filesystem implements the config.Provider interface.
+func (p *DiskPartition) Filesystem() string {
+	if p.DiskFilesystem == "" {
+		return constants.UserDiskFilesystemXFS
+	}
+
+	return p.DiskFilesystem
+}
+
+// MountOptions implements the config.Provider interface.
+func (p *DiskPartition) MountOptions() []string {
+	if len(p.DiskMountOptions) == 0 {
+		return []string{"noatime"}
+	}
+
+	return p.DiskMountOptions
+}
+
+// Name implements the config.Provider interface.
+func (vg *VolumeGroup) Name() string {
+	return vg.VolumeGroupName
+}
+
+// PhysicalVolumes implements the config.Provider interface.
+func (vg *VolumeGroup) PhysicalVolumes() []string {
+	return vg.VolumeGroupPhysicalVolumes
+}
+
+// LogicalVolumes implements the config.Provider interface.
+func (vg *VolumeGroup) LogicalVolumes() []config.LogicalVolume {
+	return slices.Map(vg.VolumeGroupLogicalVolumes, func(lv *LogicalVolume) config.LogicalVolume { return lv })
+}
+
+// Name implements the config.Provider interface.
+func (lv *LogicalVolume) Name() string {
+	return lv.LogicalVolumeName
+}
+
+// Type implements the config.Provider interface.
+func (lv *LogicalVolume) Type() string {
+	if lv.LogicalVolumeType == "" {
+		return constants.LogicalVolumeTypeLinear
+	}
+
+	return lv.LogicalVolumeType
+}
+
+// Mirrors implements the config.Provider interface.
+func (lv *LogicalVolume) Mirrors() int {
+	if lv.LogicalVolumeMirrors == 0 && lv.Type() != constants.LogicalVolumeTypeLinear {
+		return 1
+	}
+
+	return lv.LogicalVolumeMirrors
+}
+
+// Size implements the config.Provider interface.
+func (lv *LogicalVolume) Size() uint64 {
+	return uint64(lv.LogicalVolumeSize)
+}
+
+// MountPoint implements the config.Provider interface.
+func (lv *LogicalVolume) MountPoint() string {
+	return lv.LogicalVolumeMountPoint
+}
+
+// Filesystem implements the config.Provider interface.
+func (lv *LogicalVolume) Filesystem() string {
+	if lv.LogicalVolumeFilesystem == "" {
+		return constants.UserDiskFilesystemXFS
+	}
+
+	return lv.LogicalVolumeFilesystem
+}
+
 // Kind implements the config.Provider interface.
 func (e *EncryptionConfig) Kind() string {
 	return e.EncryptionProvider
@@ -0,0 +1,155 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/maps"
+)
+
+// quantityRegexp matches the Kubernetes resource quantity format: a non-negative number followed by
+// a binary SI suffix, a decimal SI suffix or a decimal exponent.
+var quantityRegexp = regexp.MustCompile(`^\+?([0-9]+(?:\.[0-9]*)?|\.[0-9]+)(Ki|Mi|Gi|Ti|Pi|Ei|n|u|m|k|M|G|T|P|E|[eE][-+]?[0-9]+)?$`)
+
+// quantitySuffixes maps the quantity suffixes to the multipliers.
+var quantitySuffixes = map[string]*big.Rat{
+	"":   big.NewRat(1, 1),
+	"n":  big.NewRat(1, 1_000_000_000),
+	"u":  big.NewRat(1, 1_000_000),
+	"m":  big.NewRat(1, 1_000),
+	"k":  big.NewRat(1_000, 1),
+	"M":  big.NewRat(1_000_000, 1),
+	"G":  big.NewRat(1_000_000_000, 1),
+	"T":  big.NewRat(1_000_000_000_000, 1),
+	"P":  big.NewRat(1_000_000_000_000_000, 1),
+	"E":  big.NewRat(1_000_000_000_000_000_000, 1),
+	"Ki": big.NewRat(1<<10, 1),
+	"Mi": big.NewRat(1<<20, 1),
+	"Gi": big.NewRat(1<<30, 1),
+	"Ti": big.NewRat(1<<40, 1),
+	"Pi": big.NewRat(1<<50, 1),
+	"Ei": big.NewRat(1<<60, 1),
+}
+
+// parseQuantity parses the Kubernetes resource quantity.
+func parseQuantity(value string) (*big.Rat, bool) {
+	matches := quantityRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return nil, false
+	}
+
+	quantity, ok := new(big.Rat).SetString(matches[1])
+	if !ok {
+		return nil, false
+	}
+
+	if multiplier, ok := quantitySuffixes[matches[2]]; ok {
+		return quantity.Mul(quantity, multiplier), true
+	}
+
+	exponent, err := strconv.Atoi(matches[2][1:])
+	if err != nil || exponent < -64 || exponent > 64 {
+		return nil, false
+	}
+
+	multiplier := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(exponent))), nil))
+	if exponent < 0 {
+		multiplier.Inv(multiplier)
+	}
+
+	return quantity.Mul(quantity, multiplier), true
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
+
+// Requests implements the config.Resources interface.
+func (r *ResourcesConfig) Requests() map[string]string {
+	return resourcesToMap(r.RequestsConfig)
+}
+
+// Limits implements the config.Resources interface.
+func (r *ResourcesConfig) Limits() map[string]string {
+	return resourcesToMap(r.LimitsConfig)
+}
+
+// Validate validates the resources config.
+//
+//nolint:gocyclo
+func (r *ResourcesConfig) Validate(component string) error {
+	var result *multierror.Error
+
+	parsed := map[string]map[string]*big.Rat{}
+
+	for _, resources := range []struct {
+		kind   string
+		values Unstructured
+	}{
+		{"requests", r.RequestsConfig},
+		{"limits", r.LimitsConfig},
+	} {
+		kind, values := resources.kind, resources.values
+
+		parsed[kind] = map[string]*big.Rat{}
+
+		keys := maps.Keys(values.Object)
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if key != "cpu" && key != "memory" {
+				result = multierror.Append(result, fmt.Errorf("%s resource %s: unsupported resource %q", component, kind, key))
+
+				continue
+			}
+
+			value := fmt.Sprint(values.Object[key])
+
+			quantity, ok := parseQuantity(value)
+			if !ok {
+				result = multierror.Append(result, fmt.Errorf("%s resource %s: invalid %s quantity %q", component, kind, key, value))
+
+				continue
+			}
+
+			parsed[kind][key] = quantity
+		}
+	}
+
+	for _, key := range []string{"cpu", "memory"} {
+		request, limit := parsed["requests"][key], parsed["limits"][key]
+
+		if request != nil && limit != nil && request.Cmp(limit) > 0 {
+			result = multierror.Append(result, fmt.Errorf("%s resource requests: %s request %q exceeds the limit %q",
+				component, key, fmt.Sprint(r.RequestsConfig.Object[key]), fmt.Sprint(r.LimitsConfig.Object[key])))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func resourcesToMap(resources Unstructured) map[string]string {
+	if len(resources.Object) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(resources.Object))
+
+	for key, value := range resources.Object {
+		result[key] = fmt.Sprint(value)
+	}
+
+	return result
+}
@@ -38,3 +38,21 @@ func (s *SchedulerConfig) ExtraVolumes() []config.VolumeMount {
 func (s *SchedulerConfig) Env() Env {
 	return s.EnvConfig
 }
+
+// Resources implements the config.Scheduler interface.
+func (s *SchedulerConfig) Resources() config.Resources {
+	if s.ResourcesConfig == nil {
+		return &ResourcesConfig{}
+	}
+
+	return s.ResourcesConfig
+}
+
+// PriorityClassName implements the config.Scheduler interface.
+func (s *SchedulerConfig) PriorityClassName() string {
+	if s.PriorityClassNameConfig == "" {
+		return constants.KubernetesControlPlaneDefaultPriorityClassName
+	}
+
+	return s.PriorityClassNameConfig
+}
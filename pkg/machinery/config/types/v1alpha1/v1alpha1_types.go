@@ -183,6 +183,25 @@ var (
 		},
 	}
 
+	machineVolumeGroupsExample = []*VolumeGroup{
+		{
+			VolumeGroupName:            "data",
+			VolumeGroupPhysicalVolumes: []string{"/dev/sdb", "/dev/sdc"},
+			VolumeGroupLogicalVolumes: []*LogicalVolume{
+				{
+					LogicalVolumeName:       "mirror",
+					LogicalVolumeType:       "raid1",
+					LogicalVolumeSize:       DiskSize(100 * 1000 * 1000 * 1000),
+					LogicalVolumeMountPoint: "/var/mnt/mirror",
+				},
+				{
+					LogicalVolumeName:       "scratch",
+					LogicalVolumeMountPoint: "/var/mnt/scratch",
+				},
+			},
+		},
+	}
+
 	machineInstallExample = &InstallConfig{
 		InstallDisk:            "/dev/sda",
 		InstallExtraKernelArgs: []string{"console=ttyS1", "panic=10"},
@@ -440,10 +459,29 @@ var (
 		DHCPRouteMetric: 1024,
 	}
 
+	networkConfigIPv6Example = &DeviceIPv6Config{
+		IPv6Mode:        "slaac",
+		IPv6UseTempAddr: 2,
+	}
+
 	networkConfigVIPLayer2Example = &DeviceVIPConfig{
 		SharedIP: "172.16.199.55",
 	}
 
+	networkConfigVIPBGPExample = &VIPBGPConfig{
+		BGPLocalASN: 65001,
+		BGPPeers: []*VIPBGPPeer{
+			{
+				PeerAddress: "10.5.0.1",
+				PeerASN:     65000,
+			},
+			{
+				PeerAddress: "10.5.1.1",
+				PeerASN:     65000,
+			},
+		},
+	}
+
 	networkConfigWireguardHostExample = &DeviceWireguardConfig{
 		WireguardPrivateKey: "ABCDEF...",
 		WireguardListenPort: 51111,
@@ -491,6 +529,55 @@ metadata:
 		KubeSpanEnabled: pointer.To(true),
 	}
 
+	networkProbesExample = []*NetworkProbe{
+		{
+			ProbeName:     "uplink",
+			ProbeInterval: 10 * time.Second,
+			ProbeTCP: &NetworkProbeTCP{
+				TCPEndpoint: "10.5.0.1:443",
+			},
+		},
+		{
+			ProbeName: "gateway",
+			ProbeICMP: &NetworkProbeICMP{
+				ICMPHost: "10.5.0.1",
+			},
+		},
+	}
+
+	networkProbeTCPExample = &NetworkProbeTCP{
+		TCPEndpoint: "10.5.0.1:443",
+		TCPTimeout:  5 * time.Second,
+	}
+
+	networkProbeICMPExample = &NetworkProbeICMP{
+		ICMPHost:    "10.5.0.1",
+		ICMPTimeout: 5 * time.Second,
+	}
+
+	networkProbeHTTPExample = &NetworkProbeHTTP{
+		HTTPURL:     "http://10.5.0.1:8080/healthz",
+		HTTPTimeout: 5 * time.Second,
+	}
+
+	networkLLDPExample = &NetworkLLDP{
+		LLDPTransmit: pointer.To(true),
+	}
+
+	networkRulesExample = []*NetworkRule{
+		{
+			RuleFrom:     "10.6.0.0/24",
+			RuleTable:    100,
+			RulePriority: 1000,
+		},
+		{
+			RuleFwMark:   0x100,
+			RuleFwMask:   0xff00,
+			RuleTable:    200,
+			RulePriority: 1100,
+		},
+	}
+
 	networkDeviceSelectorExamples = []NetworkDeviceSelector{
 		{
 			NetworkDeviceBus: "00:*",
@@ -524,6 +611,21 @@ metadata:
 		},
 	}
 
+	kubeletCredentialProviderConfigExample = Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "kubelet.config.k8s.io/v1beta1",
+			"kind":       "CredentialProviderConfig",
+			"providers": []interface{}{
+				map[string]interface{}{
+					"name":                 "ecr-credential-provider",
+					"apiVersion":           "credentialprovider.kubelet.k8s.io/v1beta1",
+					"matchImages":          []interface{}{"*.dkr.ecr.*.amazonaws.com"},
+					"defaultCacheDuration": "12h",
+				},
+			},
+		},
+	}
+
 	loggingEndpointExample1 = &Endpoint{
 		mustParseURL("udp://127.0.0.1:12345"),
 	}
@@ -549,6 +651,24 @@ metadata:
 		},
 	}
 
+	machineImageGCExample = &ImageGCConfig{
+		ImageGCEnabled:  pointer.To(true),
+		ImageGCInterval: 6 * time.Hour,
+		ImageGCMinAge:   72 * time.Hour,
+	}
+
+	machineNodeLabelsExample = map[string]string{
+		"exampleLabel": "exampleLabelValue",
+	}
+
+	machineNodeAnnotationsExample = map[string]string{
+		"customer.io/rack": "r13a25",
+	}
+
+	machineNodeTaintsExample = map[string]string{
+		"exampleTaint": "exampleTaintValue:NoSchedule",
+	}
+
 	machinePodsExample = []Unstructured{
 		{
 			Object: map[string]interface{}{
@@ -594,6 +714,65 @@ metadata:
 		},
 	}
 
+	apiServerKMSProvidersExample = []*KMSProviderConfig{
+		{
+			KMSName:     "vault",
+			KMSEndpoint: "/var/run/kmsplugin/vault.sock",
+			KMSTimeout:  3 * time.Second,
+		},
+	}
+
+	machineAcceptedCAsExample = []*x509.PEMEncodedCertificateAndKey{
+		{
+			Crt: []byte("--- EXAMPLE CERTIFICATE ---"),
+		},
+	}
+
+	clusterAcceptedCAsExample = []*x509.PEMEncodedCertificateAndKey{
+		{
+			Crt: []byte("--- EXAMPLE CERTIFICATE ---"),
+		},
+	}
+
+	clusterAcceptedServiceAccountKeysExample = []*x509.PEMEncodedKey{
+		{
+			Key: []byte("--- EXAMPLE KEY ---"),
+		},
+	}
+
+	apiServerAuditLogExample = &AuditLogConfig{
+		AuditLogMaxAge:     pointer.To(7),
+		AuditLogMaxBackups: pointer.To(5),
+		AuditLogMaxSize:    pointer.To(50),
+	}
+
+	apiServerOIDCExample = &OIDCConfig{
+		OIDCIssuerURL:     "https://accounts.example.com",
+		OIDCClientID:      "kubernetes",
+		OIDCUsernameClaim: "email",
+		OIDCGroupsClaim:   "groups",
+		OIDCGroupsPrefix:  "oidc:",
+	}
+
+	apiServerOIDCCAExample = &x509.PEMEncodedCertificateAndKey{
+		Crt: []byte("--- EXAMPLE CERTIFICATE ---"),
+	}
+
+	resourcesConfigExample = &ResourcesConfig{
+		RequestsConfig: Unstructured{
+			Object: map[string]interface{}{
+				"cpu":    "1",
+				"memory": "1Gi",
+			},
+		},
+		LimitsConfig: Unstructured{
+			Object: map[string]interface{}{
+				"cpu":    "2",
+				"memory": "2500Mi",
+			},
+		},
+	}
+
 	installExtensionsExample = []InstallExtensionConfig{
 		{
 			ExtensionImage: "ghcr.io/siderolabs/gvisor:20220117.0-v1.0.0",
@@ -688,6 +867,15 @@ type MachineConfig struct {
 	//       name: machine CA example
 	MachineCA *x509.PEMEncodedCertificateAndKey `yaml:"ca,omitempty"`
 	//   description: |
+	//     The list of additional base64 encoded certificate authorities trusted by the Talos API.
+	//
+	//     Only the certificates (`crt`) are used, the keys are ignored.
+	//     This is used to rotate the `.machine.ca`: the new CA is accepted first, and after the switch the previous CA stays accepted until it is dropped.
+	//   examples:
+	//     - name: AcceptedCAs example.
+	//       value: machineAcceptedCAsExample
+	MachineAcceptedCAs []*x509.PEMEncodedCertificateAndKey `yaml:"acceptedCAs,omitempty"`
+	//   description: |
 	//     Extra certificate subject alternative names for the machine's certificate.
 	//     By default, all non-loopback interface IPs are automatically added to the certificate's SANs.
 	//   examples:
@@ -734,6 +922,15 @@ type MachineConfig struct {
 	//       value: machineDisksExample
 	MachineDisks []*MachineDisk `yaml:"disks,omitempty"` // Note: `size` is in units of bytes.
 	//   description: |
+	//     Used to create LVM volume groups and logical volumes across machine disks, format and mount them.
+	//     Since the rootfs is read only with the exception of `/var`, mounts are only valid if they are under `/var`.
+	//     Volume groups and logical volumes are created only if they don't exist yet, so the configuration is applied idempotently on each boot.
+	//     Logical volumes are formatted with XFS if no filesystem is found.
+	//   examples:
+	//     - name: MachineVolumeGroups list example.
+	//       value: machineVolumeGroupsExample
+	MachineVolumeGroups []*VolumeGroup `yaml:"volumeGroups,omitempty"`
+	//   description: |
 	//     Used to provide instructions for installations.
 	//   examples:
 	//     - name: MachineInstall config usage example.
@@ -825,11 +1022,47 @@ type MachineConfig struct {
 	//   examples:
 	//     - value: machineKernelExample
 	MachineKernel *KernelConfig `yaml:"kernel,omitempty"`
+	//   description: |
+	//     Configures garbage collection of the images in the system containerd namespace.
+	//
+	//     Images of the system namespace which are not used by Talos services, the configured installer and system extensions,
+	//     and the current and previous Talos installation are pruned.
+	//   examples:
+	//     - value: machineImageGCExample
+	MachineImageGC *ImageGCConfig `yaml:"imageGC,omitempty"`
 	//  description: |
 	//    Configures the seccomp profiles for the machine.
 	//  examples:
 	//    - value: machineSeccompExample
 	MachineSeccompProfiles []*MachineSeccompProfile `yaml:"seccompProfiles,omitempty" talos:"omitonlyifnil"`
+	//   description: |
+	//     Configures the node labels for the machine.
+	//
+	//     Labels are kept in sync with the Kubernetes Node resource,
+	//     only labels set by Talos are removed when they are dropped from this list.
+	//     Labels restricted by the `NodeRestriction` admission plugin (e.g. `node-role.kubernetes.io/*`) can't be set.
+	//   examples:
+	//     - value: machineNodeLabelsExample
+	MachineNodeLabels map[string]string `yaml:"nodeLabels,omitempty"`
+	//   description: |
+	//     Configures the node annotations for the machine.
+	//
+	//     Annotations are kept in sync with the Kubernetes Node resource,
+	//     only annotations set by Talos are removed when they are dropped from this list.
+	//   examples:
+	//     - value: machineNodeAnnotationsExample
+	MachineNodeAnnotations map[string]string `yaml:"nodeAnnotations,omitempty"`
+	//   description: |
+	//     Configures the node taints for the machine, the value format is `value:effect` (value is optional).
+	//
+	//     Taints are set when the node is registered,
+	//     and then they are kept in sync with the Kubernetes Node resource (only taints set by Talos are removed).
+	//     Control plane nodes update the taints with the admin credentials.
+	//     The `NodeRestriction` admission plugin forbids the kubelet to modify the taints of the registered node,
+	//     so on worker nodes changes to the taints are applied only if the plugin is disabled or when the node is registered again.
+	//   examples:
+	//     - value: machineNodeTaintsExample
+	MachineNodeTaints map[string]string `yaml:"nodeTaints,omitempty"`
 }
 
 // MachineSeccompProfile defines seccomp profiles for the machine.
@@ -903,6 +1136,15 @@ type ClusterConfig struct {
 	//       value: pemEncodedCertificateExample
 	ClusterCA *x509.PEMEncodedCertificateAndKey `yaml:"ca,omitempty"`
 	//   description: |
+	//     The list of additional base64 encoded Kubernetes certificate authorities trusted by the cluster components.
+	//
+	//     Only the certificates (`crt`) are used, the keys are ignored.
+	//     This is used to rotate the `.cluster.ca`: the new CA is accepted first, and after the switch the previous CA stays accepted until it is dropped.
+	//   examples:
+	//     - name: AcceptedCAs example.
+	//       value: clusterAcceptedCAsExample
+	ClusterAcceptedCAs []*x509.PEMEncodedCertificateAndKey `yaml:"acceptedCAs,omitempty"`
+	//   description: |
 	//     The base64 encoded aggregator certificate authority used by Kubernetes for front-proxy certificate generation.
 	//
 	//     This CA can be self-signed.
@@ -917,6 +1159,14 @@ type ClusterConfig struct {
 	//       value: pemEncodedKeyExample
 	ClusterServiceAccount *x509.PEMEncodedKey `yaml:"serviceAccount,omitempty"`
 	//   description: |
+	//     The list of additional base64 encoded service account keys accepted to verify service account tokens.
+	//
+	//     This is used to rotate the `.cluster.serviceAccount` key: tokens signed with the accepted keys stay valid.
+	//   examples:
+	//     - name: AcceptedServiceAccountKeys example.
+	//       value: clusterAcceptedServiceAccountKeysExample
+	ClusterAcceptedServiceAccountKeys []*x509.PEMEncodedKey `yaml:"acceptedServiceAccountKeys,omitempty"`
+	//   description: |
 	//     API server specific configuration options.
 	//   examples:
 	//     - value: clusterAPIServerExample
@@ -976,12 +1226,24 @@ type ClusterConfig struct {
 	//         }
 	ExtraManifestHeaders map[string]string `yaml:"extraManifestHeaders,omitempty"`
 	//   description: |
+	//     Prune the objects removed from the `extraManifests` (and other manifests fetched by URL, e.g. CNI manifests).
+	//
+	//     Objects applied from the manifests are labeled with the Talos inventory label,
+	//     and objects which are no longer present in any manifest are removed from the cluster by `talosctl upgrade-k8s`.
+	//     Defaults to `false`.
+	ExtraManifestsPruneConfig *bool `yaml:"extraManifestsPrune,omitempty"`
+	//   description: |
 	//     A list of inline Kubernetes manifests.
 	//     These will get automatically deployed as part of the bootstrap.
 	//   examples:
 	//     - value: clusterInlineManifestsExample
 	ClusterInlineManifests ClusterInlineManifests `yaml:"inlineManifests,omitempty" talos:"omitonlyifnil"`
 	//   description: |
+	//     Prune the objects removed from the bootstrap manifests generated by Talos (e.g. `kube-proxy` when it is disabled).
+	//
+	//     Defaults to `false`.
+	BootstrapManifestsPruneConfig *bool `yaml:"bootstrapManifestsPrune,omitempty"`
+	//   description: |
 	//     Settings for admin kubeconfig generation.
 	//     Certificate lifetime can be configured.
 	//   examples:
@@ -999,6 +1261,14 @@ type ClusterConfig struct {
 	//     - false
 	//     - no
 	AllowSchedulingOnControlPlanes *bool `yaml:"allowSchedulingOnControlPlanes,omitempty"`
+	//   description: |
+	//     Disable the kubelet serving certificate approver.
+	//
+	//     When kubelet serving certificate rotation is enabled, control plane nodes approve kubelet serving certificate signing requests
+	//     if the node name and the requested SANs match the cluster member.
+	//     Disable it to use another approver.
+	//     Defaults to `false`.
+	KubeletServingCertificateApproverDisabled *bool `yaml:"disableKubeletServingCertificateApprover,omitempty"`
 }
 
 // ExtraMount wraps OCI Mount specification.
@@ -1081,6 +1351,15 @@ type KubeletConfig struct {
 	//   examples:
 	//     - value: kubeletExtraConfigExample
 	KubeletExtraConfig Unstructured `yaml:"extraConfig,omitempty"`
+	//   description: |
+	//     The `credentialProviderConfig` field is used to configure the kubelet image credential provider plugins.
+	//
+	//     Plugin binaries should be installed into `/usr/local/lib/kubelet/credentialproviders` via system extensions.
+	//     The same plugins are used by Talos to fetch credentials for the image pulls of the system services,
+	//     if there is no static authentication configured for the registry in `.machine.registries.config`.
+	//   examples:
+	//     - value: kubeletCredentialProviderConfigExample
+	KubeletCredentialProviderConfig Unstructured `yaml:"credentialProviderConfig,omitempty"`
 	//  description: |
 	//    Enable container runtime default Seccomp profile.
 	//  values:
@@ -1173,6 +1452,26 @@ type NetworkConfig struct {
 	//     - false
 	//     - no
 	NetworkDisableSearchDomain *bool `yaml:"disableSearchDomain,omitempty"`
+	//   description: |
+	//     Network reachability probes.
+	//     Probe results are reported as ProbeStatus resources, and the `probes` network status
+	//     is ready only when all of the probes succeed.
+	//   examples:
+	//     - value: networkProbesExample
+	NetworkProbes []*NetworkProbe `yaml:"probes,omitempty"`
+	//   description: |
+	//     Routing policy rules (`ip rule`).
+	//     Rules select the routing table to be used for the packet based on the packet source, destination,
+	//     firewall mark and interfaces, e.g. to route the replies via the gateway of the interface they were received on.
+	//   examples:
+	//     - value: networkRulesExample
+	NetworkRules []*NetworkRule `yaml:"rules,omitempty"`
+	//   description: |
+	//     Configures LLDP neighbor discovery.
+	//     LLDP frames are always received on physical links, and discovered neighbors are reported as LLDPNeighbor resources.
+	//   examples:
+	//     - value: networkLLDPExample
+	NetworkLLDP *NetworkLLDP `yaml:"lldp,omitempty"`
 }
 
 // NetworkDeviceList is a list of *Device structures with overridden merge process.
@@ -1279,6 +1578,16 @@ type InstallConfig struct {
 	//     Indicates if MBR partition should be marked as bootable (active).
 	//     Should be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme.
 	InstallLegacyBIOSSupport *bool `yaml:"legacyBIOSSupport,omitempty"`
+	//   description: |
+	//     Indicates if the EPHEMERAL partition and the last partition of user disks sized to occupy the full disk
+	//     should be grown on boot to use the free space after them (e.g. after the disk is enlarged).
+	//     Defaults to `true`.
+	//   values:
+	//     - true
+	//     - yes
+	//     - false
+	//     - no
+	InstallGrowPartitions *bool `yaml:"growPartitions,omitempty"`
 }
 
 // InstallDiskSizeMatcher disk size condition parser.
@@ -1592,6 +1901,41 @@ type APIServerConfig struct {
 	//   examples:
 	//     - value: APIServerDefaultAuditPolicy
 	AuditPolicyConfig Unstructured `yaml:"auditPolicy,omitempty"`
+	//   description: |
+	//     Configure KMS providers for the envelope encryption of Kubernetes secrets at rest.
+	//
+	//     New secrets are encrypted with the first provider which is not marked as `decryptOnly`.
+	//     The remaining providers (and `secretbox`/`aescbc` keys) are used to decrypt existing secrets.
+	//   examples:
+	//     - value: apiServerKMSProvidersExample
+	KMSProvidersConfig []*KMSProviderConfig `yaml:"kmsProviders,omitempty"`
+	//   description: |
+	//     Configure the API server resources.
+	//   examples:
+	//     - value: resourcesConfigExample
+	ResourcesConfig *ResourcesConfig `yaml:"resources,omitempty"`
+	//   description: |
+	//     The priority class of the API server static pod.
+	//     Defaults to `system-cluster-critical`.
+	//   examples:
+	//     - value: '"system-node-critical"'
+	PriorityClassNameConfig string `yaml:"priorityClassName,omitempty"`
+	//   description: |
+	//     Configure the API server audit log retention.
+	//
+	//     Audit log files are stored in `/var/log/audit/kube` on the host,
+	//     new audit events are forwarded to the machine logging destinations and are available via `talosctl logs kube-apiserver-audit`.
+	//   examples:
+	//     - value: apiServerAuditLogExample
+	AuditLogConfig *AuditLogConfig `yaml:"auditLog,omitempty"`
+	//   description: |
+	//     Configure the API server OpenID Connect token authenticator.
+	//
+	//     The CA bundle to verify the issuer's serving certificate is delivered via the secrets pipeline,
+	//     so there is no need to mount it with `extraVolumes`.
+	//   examples:
+	//     - value: apiServerOIDCExample
+	OIDCConfig *OIDCConfig `yaml:"oidc,omitempty"`
 }
 
 // AdmissionPluginConfigList represents the admission plugin configuration list.
@@ -1647,6 +1991,28 @@ type AdmissionPluginConfig struct {
 	PluginConfiguration Unstructured `yaml:"configuration"`
 }
 
+// KMSProviderConfig represents the API server KMS v2 provider configuration.
+type KMSProviderConfig struct {
+	//   description: |
+	//     Name of the KMS plugin, should be unique across the providers.
+	KMSName string `yaml:"name"`
+	//   description: |
+	//     Path to the KMS plugin gRPC Unix socket on the host.
+	//
+	//     The directory containing the socket is mounted into the API server static pod.
+	KMSEndpoint string `yaml:"endpoint"`
+	//   description: |
+	//     Timeout for the calls to the KMS plugin (defaults to 3s).
+	KMSTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: |
+	//     Use the provider only to decrypt existing secrets.
+	//
+	//     Decrypt-only providers are placed after the `secretbox`/`aescbc` keys, which allows
+	//     to roll the provider out to all control plane nodes before it is used for encryption,
+	//     or to migrate secrets away from the provider.
+	KMSDecryptOnly *bool `yaml:"decryptOnly,omitempty"`
+}
+
 var _ config.ControllerManager = (*ControllerManagerConfig)(nil)
 
 // ControllerManagerConfig represents the kube controller manager configuration options.
@@ -1665,6 +2031,17 @@ type ControllerManagerConfig struct {
 	//   description: |
 	//     The `env` field allows for the addition of environment variables for the control plane component.
 	EnvConfig Env `yaml:"env,omitempty"`
+	//   description: |
+	//     Configure the controller manager resources.
+	//   examples:
+	//     - value: resourcesConfigExample
+	ResourcesConfig *ResourcesConfig `yaml:"resources,omitempty"`
+	//   description: |
+	//     The priority class of the controller manager static pod.
+	//     Defaults to `system-cluster-critical`.
+	//   examples:
+	//     - value: '"system-node-critical"'
+	PriorityClassNameConfig string `yaml:"priorityClassName,omitempty"`
 }
 
 // ProxyConfig represents the kube proxy configuration options.
@@ -1706,6 +2083,87 @@ type SchedulerConfig struct {
 	//   description: |
 	//     The `env` field allows for the addition of environment variables for the control plane component.
 	EnvConfig Env `yaml:"env,omitempty"`
+	//   description: |
+	//     Configure the scheduler resources.
+	//   examples:
+	//     - value: resourcesConfigExample
+	ResourcesConfig *ResourcesConfig `yaml:"resources,omitempty"`
+	//   description: |
+	//     The priority class of the scheduler static pod.
+	//     Defaults to `system-cluster-critical`.
+	//   examples:
+	//     - value: '"system-node-critical"'
+	PriorityClassNameConfig string `yaml:"priorityClassName,omitempty"`
+}
+
+var _ config.Resources = (*ResourcesConfig)(nil)
+
+// ResourcesConfig represents the pod resources.
+type ResourcesConfig struct {
+	//   description: |
+	//     Requests configures the reserved cpu/memory resources.
+	//     Unset values default to the built-in requests of the control plane component.
+	RequestsConfig Unstructured `yaml:"requests,omitempty"`
+	//   description: |
+	//     Limits configures the maximum cpu/memory resources a container can use.
+	LimitsConfig Unstructured `yaml:"limits,omitempty"`
+}
+
+var _ config.AuditLog = (*AuditLogConfig)(nil)
+
+// AuditLogConfig represents the API server audit log retention.
+type AuditLogConfig struct {
+	//   description: |
+	//     The maximum number of days to retain the rotated audit log files, `0` disables the age-based removal.
+	//     Defaults to `30`.
+	AuditLogMaxAge *int `yaml:"maxAge,omitempty"`
+	//   description: |
+	//     The maximum number of the rotated audit log files to retain, `0` retains all files.
+	//     Defaults to `10`.
+	AuditLogMaxBackups *int `yaml:"maxBackups,omitempty"`
+	//   description: |
+	//     The maximum size in megabytes of the audit log file before it gets rotated.
+	//     Defaults to `100`.
+	AuditLogMaxSize *int `yaml:"maxSize,omitempty"`
+}
+
+var _ config.OIDC = (*OIDCConfig)(nil)
+
+// OIDCConfig represents the API server OpenID Connect authentication configuration.
+type OIDCConfig struct {
+	//   description: |
+	//     The URL of the OpenID issuer, only the `https://` scheme is accepted.
+	OIDCIssuerURL string `yaml:"issuerURL"`
+	//   description: |
+	//     The client ID for the OpenID Connect client, all tokens must be issued for this client ID.
+	OIDCClientID string `yaml:"clientID"`
+	//   description: |
+	//     The OpenID claim to use as the user name.
+	//     Defaults to `sub`.
+	OIDCUsernameClaim string `yaml:"usernameClaim,omitempty"`
+	//   description: |
+	//     The prefix prepended to the username claims to prevent clashes with existing names.
+	OIDCUsernamePrefix string `yaml:"usernamePrefix,omitempty"`
+	//   description: |
+	//     The OpenID claim to use as the user's groups.
+	OIDCGroupsClaim string `yaml:"groupsClaim,omitempty"`
+	//   description: |
+	//     The prefix prepended to the group claims to prevent clashes with existing names.
+	OIDCGroupsPrefix string `yaml:"groupsPrefix,omitempty"`
+	//   description: |
+	//     The claims which should be present in the ID token with the matching values.
+	OIDCRequiredClaims map[string]string `yaml:"requiredClaims,omitempty"`
+	//   description: |
+	//     The list of allowed JOSE asymmetric signing algorithms.
+	//     Defaults to `RS256`.
+	OIDCSigningAlgorithms []string `yaml:"signingAlgorithms,omitempty"`
+	//   description: |
+	//     The CA certificate bundle to verify the OpenID issuer's serving certificate.
+	//     If not set, the host's root CA set is used.
+	//     Only the `crt` part is used.
+	//   examples:
+	//     - value: apiServerOIDCCAExample
+	OIDCCA *x509.PEMEncodedCertificateAndKey `yaml:"ca,omitempty"`
 }
 
 var _ config.Etcd = (*EtcdConfig)(nil)
@@ -1862,6 +2320,58 @@ type MachineDisk struct {
 	DiskPartitions []*DiskPartition `yaml:"partitions,omitempty"`
 }
 
+// VolumeGroup represents an LVM volume group spanning one or more disks.
+type VolumeGroup struct {
+	//   description: The name of the volume group.
+	VolumeGroupName string `yaml:"name"`
+	//   description: |
+	//     The list of devices to use as LVM physical volumes.
+	//     Devices which are not LVM physical volumes yet are initialized, devices with existing data are rejected by LVM.
+	//     Devices which are added to the list later are used to extend the volume group.
+	//   examples:
+	//     - value: '[]string{"/dev/sdb", "/dev/sdc"}'
+	VolumeGroupPhysicalVolumes []string `yaml:"physicalVolumes"`
+	//   description: A list of logical volumes to create in the volume group.
+	VolumeGroupLogicalVolumes []*LogicalVolume `yaml:"logicalVolumes,omitempty"`
+}
+
+// LogicalVolume represents an LVM logical volume.
+type LogicalVolume struct {
+	//   description: The name of the logical volume.
+	LogicalVolumeName string `yaml:"name"`
+	//   description: |
+	//     The type of the logical volume.
+	//     RAID logical volumes are backed by the kernel MD RAID drivers, `raid1` requires at least two physical volumes,
+	//     `raid10` requires at least four physical volumes.
+	//     LVM RAID is used instead of standalone MD RAID arrays (`mdadm`): it provides the same RAID levels via the same kernel drivers,
+	//     while the RAID metadata is managed by LVM, so that the arrays are assembled on boot along with the volume group,
+	//     and the logical volumes can share the physical volumes with other (e.g. linear) logical volumes.
+	//   values:
+	//     - linear
+	//     - raid1
+	//     - raid10
+	LogicalVolumeType string `yaml:"type,omitempty"`
+	//   description: |
+	//     The number of additional copies of the data for `raid1` and `raid10` logical volumes (defaults to 1).
+	LogicalVolumeMirrors int `yaml:"mirrors,omitempty"`
+	//   description: >
+	//     The size of the logical volume: either bytes or human readable representation.
+	//     If `size:` is omitted, the logical volume occupies all remaining free space in the volume group.
+	//   examples:
+	//     - name: Human readable representation.
+	//       value: DiskSize(100000000000)
+	LogicalVolumeSize DiskSize `yaml:"size,omitempty"`
+	//   description:
+	//     Where to mount the logical volume.
+	LogicalVolumeMountPoint string `yaml:"mountpoint,omitempty"`
+	//   description: |
+	//     The filesystem to create on the logical volume (defaults to `xfs`).
+	//   values:
+	//     - xfs
+	//     - ext4
+	LogicalVolumeFilesystem string `yaml:"filesystem,omitempty"`
+}
+
 // DiskSize partition size in bytes.
 type DiskSize uint64
 
@@ -1912,6 +2422,19 @@ type DiskPartition struct {
 	//   description:
 	//     Where to mount the partition.
 	DiskMountPoint string `yaml:"mountpoint,omitempty"`
+	//   description: |
+	//     The filesystem to create on the partition (defaults to `xfs`).
+	//   values:
+	//     - xfs
+	//     - ext4
+	DiskFilesystem string `yaml:"filesystem,omitempty"`
+	//   description: |
+	//     Mount options for the partition (defaults to `noatime`).
+	//     Generic options (e.g. `noatime`, `nodev`) are applied as mount flags,
+	//     filesystem-specific options (e.g. `prjquota`) are passed to the filesystem.
+	//   examples:
+	//     - value: '[]string{"noatime", "prjquota"}'
+	DiskMountOptions []string `yaml:"mountOptions,omitempty"`
 }
 
 // EncryptionConfig represents partition encryption settings.
@@ -2105,6 +2628,13 @@ type Device struct {
 	//     - name: layer2 vip example
 	//       value: networkConfigVIPLayer2Example
 	DeviceVIPConfig *DeviceVIPConfig `yaml:"vip,omitempty"`
+	//   description: |
+	//     IPv6 settings of the interface.
+	//     Settings are applied as soon as the interface appears, so interface-specific
+	//     `machine.sysctls` are not required.
+	//   examples:
+	//     - value: networkConfigIPv6Example
+	DeviceIPv6Config *DeviceIPv6Config `yaml:"ipv6,omitempty"`
 }
 
 // DHCPOptions contains options for configuring the DHCP settings for a given interface.
@@ -2117,6 +2647,64 @@ type DHCPOptions struct {
 	DHCPIPv6 *bool `yaml:"ipv6,omitempty"`
 	//   description: Set client DUID (hex string).
 	DHCPDUIDv6 string `yaml:"duidv6,omitempty"`
+	//   description: Set DHCPv4 client identifier, option 61 (hex string).
+	DHCPClientIdentifier string `yaml:"clientIdentifier,omitempty"`
+	//   description: Set DHCPv4 vendor class identifier, option 60.
+	DHCPVendorClass string `yaml:"vendorClass,omitempty"`
+	//   description: Set DHCPv4 user classes, option 77.
+	DHCPUserClasses []string `yaml:"userClass,omitempty"`
+	//   description: |
+	//     Additional DHCPv4 options to request from the server (option codes).
+	//
+	//     Options which are not consumed by Talos are only logged when they are received (or their values change).
+	DHCPRequestedOptions []int `yaml:"requestedOptions,omitempty"`
+	//   description: |
+	//     Hostname send policy for DHCPv4 requests, option 12.
+	//
+	//     The hostname is taken from `.machine.network.hostname`:
+	//     `none` doesn't send the hostname (default), `hostname` sends the short hostname,
+	//     `fqdn` sends the fully qualified domain name.
+	//     Hostnames from other sources (DHCP, platform metadata, or the default hostname) are not sent,
+	//     as the hostname might be assigned by the DHCP server itself.
+	//   values:
+	//     - none
+	//     - hostname
+	//     - fqdn
+	DHCPSendHostname string `yaml:"sendHostname,omitempty"`
+}
+
+// DeviceIPv6Config contains IPv6 settings of the network interface.
+type DeviceIPv6Config struct {
+	//   description: |
+	//     IPv6 addressing mode of the interface.
+	//
+	//     - `disabled`: IPv6 is disabled on the interface.
+	//     - `slaac`: addresses are configured via stateless address autoconfiguration from the router advertisements.
+	//     - `dhcp6`: addresses are configured via DHCPv6, router advertisements are used for the default route.
+	//     - `static`: only statically configured addresses and routes are used, router advertisements are ignored.
+	//   values:
+	//     - disabled
+	//     - slaac
+	//     - dhcp6
+	//     - static
+	IPv6Mode string `yaml:"mode"`
+	//   description: |
+	//     Accept router advertisements on the interface.
+	//     Defaults to `true` for `slaac` and `dhcp6` modes, and to `false` for the `static` mode.
+	IPv6AcceptRA *bool `yaml:"acceptRA,omitempty"`
+	//   description: |
+	//     Privacy extensions for the SLAAC addresses (RFC 4941), `use_tempaddr` kernel setting.
+	//
+	//     - `0`: disabled.
+	//     - `1`: temporary addresses are generated, but public addresses are preferred.
+	//     - `2`: temporary addresses are generated and preferred.
+	//
+	//     Defaults to `0`.
+	//   values:
+	//     - 0
+	//     - 1
+	//     - 2
+	IPv6UseTempAddr int `yaml:"useTempAddr,omitempty"`
 }
 
 // DeviceWireguardConfig contains settings for configuring Wireguard network interface.
@@ -2157,6 +2745,13 @@ type DeviceVIPConfig struct {
 	EquinixMetalConfig *VIPEquinixMetalConfig `yaml:"equinixMetal,omitempty"`
 	// description: Specifies the Hetzner Cloud API settings to assign VIP to the node.
 	HCloudConfig *VIPHCloudConfig `yaml:"hcloud,omitempty"`
+	//   description: |
+	//     Specifies the BGP settings to announce VIP to the routers.
+	//     The node holding the VIP announces it as a host route (/32 or /128) to the configured peers,
+	//     and withdraws it once it loses the VIP.
+	//   examples:
+	//     - value: networkConfigVIPBGPExample
+	BGPConfig *VIPBGPConfig `yaml:"bgp,omitempty"`
 }
 
 // VIPEquinixMetalConfig contains settings for Equinix Metal VIP management.
@@ -2171,6 +2766,35 @@ type VIPHCloudConfig struct {
 	HCloudAPIToken string `yaml:"apiToken"`
 }
 
+// VIPBGPConfig contains settings for announcing VIP over BGP.
+type VIPBGPConfig struct {
+	//   description: Specifies the local autonomous system number.
+	BGPLocalASN uint32 `yaml:"localASN"`
+	//   description: |
+	//     Specifies the BGP router ID (IPv4 address).
+	//     Defaults to the local IPv4 address of each BGP session.
+	BGPRouterID string `yaml:"routerID,omitempty"`
+	//   description: |
+	//     Specifies the BGP hold time.
+	//     Defaults to 90s.
+	BGPHoldTime time.Duration `yaml:"holdTime,omitempty"`
+	//   description: Specifies the list of BGP peers.
+	BGPPeers []*VIPBGPPeer `yaml:"peers"`
+}
+
+// VIPBGPPeer describes a BGP peer.
+type VIPBGPPeer struct {
+	//   description: |
+	//     Specifies the peer address, port defaults to 179.
+	//     The peer address should be of the same address family as the VIP.
+	//   examples:
+	//     - value: '"10.5.0.1"'
+	//     - value: '"[2001:db8::1]:1179"'
+	PeerAddress string `yaml:"address"`
+	//   description: Specifies the peer autonomous system number.
+	PeerASN uint32 `yaml:"asn"`
+}
+
 // Bond contains the various options for configuring a bonded interface.
 type Bond struct {
 	//   description: The interfaces that make up the bond.
@@ -2479,6 +3103,21 @@ type ClusterInlineManifest struct {
 	//   examples:
 	//     - value: '"/etc/kubernetes/auth"'
 	InlineManifestContents string `yaml:"contents"`
+	//   description: |
+	//     Prune the objects removed from the manifest (or when the manifest is removed).
+	//
+	//     Objects applied from the manifest are labeled with the Talos inventory label,
+	//     and objects which are no longer present in any manifest are removed from the cluster by `talosctl upgrade-k8s`.
+	//     Defaults to `false`.
+	InlineManifestPrune *bool `yaml:"prune,omitempty"`
+}
+
+// NetworkLLDP struct describes LLDP neighbor discovery configuration.
+type NetworkLLDP struct {
+	//   description: |
+	//     Transmit LLDP frames on physical links advertising the node hostname and the link name.
+	//     Defaults to `false`.
+	LLDPTransmit *bool `yaml:"transmit,omitempty"`
 }
 
 // NetworkKubeSpan struct describes KubeSpan configuration.
@@ -2507,6 +3146,104 @@ type NetworkKubeSpan struct {
 	KubeSpanMTU *uint32 `yaml:"mtu,omitempty"`
 }
 
+// NetworkProbe struct describes a network reachability probe.
+type NetworkProbe struct {
+	//   description: |
+	//     Name of the probe, used as the ID of the ProbeStatus resource.
+	ProbeName string `yaml:"name"`
+	//   description: |
+	//     Interval between the probe attempts.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//     Defaults to `5s`.
+	ProbeInterval time.Duration `yaml:"interval,omitempty"`
+	//   description: |
+	//     Number of consecutive failures for the probe to be considered failed after having succeeded.
+	//     Defaults to 3.
+	ProbeFailureThreshold int `yaml:"failureThreshold,omitempty"`
+	//   description: |
+	//     TCP probe: succeeds if TCP connection to the endpoint can be established.
+	//   examples:
+	//     - value: networkProbeTCPExample
+	ProbeTCP *NetworkProbeTCP `yaml:"tcp,omitempty"`
+	//   description: |
+	//     ICMP probe: succeeds if the host replies to the ICMP echo request.
+	//   examples:
+	//     - value: networkProbeICMPExample
+	ProbeICMP *NetworkProbeICMP `yaml:"icmp,omitempty"`
+	//   description: |
+	//     HTTP probe: succeeds if HTTP GET request returns a non-error (2xx or 3xx) status code.
+	//   examples:
+	//     - value: networkProbeHTTPExample
+	ProbeHTTP *NetworkProbeHTTP `yaml:"http,omitempty"`
+}
+
+// NetworkProbeTCP struct describes a TCP connect probe.
+type NetworkProbeTCP struct {
+	//   description: |
+	//     Endpoint to connect to, in the `host:port` format.
+	TCPEndpoint string `yaml:"endpoint"`
+	//   description: |
+	//     Timeout for a single probe attempt.
+	//     Defaults to `5s`.
+	TCPTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// NetworkProbeICMP struct describes an ICMP echo probe.
+type NetworkProbeICMP struct {
+	//   description: |
+	//     Host (IP address or hostname) to send the ICMP echo request to.
+	ICMPHost string `yaml:"host"`
+	//   description: |
+	//     Timeout for a single probe attempt.
+	//     Defaults to `5s`.
+	ICMPTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// NetworkProbeHTTP struct describes an HTTP GET probe.
+type NetworkProbeHTTP struct {
+	//   description: |
+	//     URL to send the HTTP GET request to.
+	HTTPURL string `yaml:"url"`
+	//   description: |
+	//     Timeout for a single probe attempt.
+	//     Defaults to `5s`.
+	HTTPTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// NetworkRule struct describes a routing policy rule.
+type NetworkRule struct {
+	//   description: |
+	//     Source prefix to match, in the CIDR notation.
+	//   examples:
+	//     - value: '"10.6.0.0/24"'
+	RuleFrom string `yaml:"from,omitempty"`
+	//   description: |
+	//     Destination prefix to match, in the CIDR notation.
+	//   examples:
+	//     - value: '"10.7.0.0/16"'
+	RuleTo string `yaml:"to,omitempty"`
+	//   description: |
+	//     Firewall mark to match.
+	RuleFwMark uint32 `yaml:"fwMark,omitempty"`
+	//   description: |
+	//     Mask for the firewall mark.
+	//     Defaults to matching all bits of the firewall mark.
+	RuleFwMask uint32 `yaml:"fwMask,omitempty"`
+	//   description: |
+	//     Name of the incoming interface to match.
+	RuleIIFName string `yaml:"iif,omitempty"`
+	//   description: |
+	//     Name of the outgoing interface to match.
+	RuleOIFName string `yaml:"oif,omitempty"`
+	//   description: |
+	//     Routing table to look up the route in if the rule matches.
+	RuleTable uint32 `yaml:"table"`
+	//   description: |
+	//     Rule priority, rules are evaluated in the increasing order of priority.
+	//     Should be unique across the rules, valid range is 1-32765.
+	RulePriority uint32 `yaml:"priority"`
+}
+
 // NetworkDeviceSelector struct describes network device selector.
 type NetworkDeviceSelector struct {
 	// description: PCI, USB bus prefix, supports matching by wildcard.
@@ -2546,6 +3283,20 @@ type RegistryKubernetesConfig struct {
 	// description: |
 	//   Disable Kubernetes discovery registry.
 	RegistryDisabled *bool `yaml:"disabled,omitempty"`
+	// description: |
+	//   Additional cluster secrets accepted to decrypt the affiliate data stored in the Node annotations.
+	//
+	//   Affiliate data is always encrypted with `.cluster.secret`.
+	//   Listing the old (or the new) secret here allows to roll over `.cluster.secret` without losing discovered members.
+	RegistryAcceptedSecrets []string `yaml:"acceptedSecrets,omitempty"`
+	// description: |
+	//   Read the plain text affiliate annotations published by Talos versions before 1.3.
+	//
+	//   When enabled, plain text annotations are read from the nodes which don't publish encrypted data yet.
+	//   Plain text annotations are not authenticated, so enable it only while upgrading from Talos versions before 1.3,
+	//   and disable it once all nodes are upgraded.
+	//   Defaults to `false`.
+	RegistryLegacyAnnotations *bool `yaml:"legacyAnnotations,omitempty"`
 }
 
 // RegistryServiceConfig struct configures Kubernetes discovery registry.
@@ -2596,6 +3347,21 @@ type KernelConfig struct {
 	KernelModules []*KernelModuleConfig `yaml:"modules,omitempty"`
 }
 
+// ImageGCConfig struct configures garbage collection of the system containerd namespace images.
+type ImageGCConfig struct {
+	// description: |
+	//   Enable pruning of the unreferenced images.
+	ImageGCEnabled *bool `yaml:"enabled,omitempty"`
+	// description: |
+	//   Interval between the garbage collection runs.
+	//   Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	ImageGCInterval time.Duration `yaml:"interval,omitempty"`
+	// description: |
+	//   Minimum age of the unreferenced image before it is pruned.
+	//   Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	ImageGCMinAge time.Duration `yaml:"minAge,omitempty"`
+}
+
 // KernelModuleConfig struct configures Linux kernel modules to load.
 type KernelModuleConfig struct {
 	// description: |
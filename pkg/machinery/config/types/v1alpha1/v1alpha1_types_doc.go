@@ -36,15 +36,21 @@ var (
 	ControlPlaneConfigDoc             encoder.Doc
 	APIServerConfigDoc                encoder.Doc
 	AdmissionPluginConfigDoc          encoder.Doc
+	KMSProviderConfigDoc              encoder.Doc
 	ControllerManagerConfigDoc        encoder.Doc
 	ProxyConfigDoc                    encoder.Doc
 	SchedulerConfigDoc                encoder.Doc
+	ResourcesConfigDoc                encoder.Doc
+	AuditLogConfigDoc                 encoder.Doc
+	OIDCConfigDoc                     encoder.Doc
 	EtcdConfigDoc                     encoder.Doc
 	ClusterNetworkConfigDoc           encoder.Doc
 	CNIConfigDoc                      encoder.Doc
 	ExternalCloudProviderConfigDoc    encoder.Doc
 	AdminKubeconfigConfigDoc          encoder.Doc
 	MachineDiskDoc                    encoder.Doc
+	VolumeGroupDoc                    encoder.Doc
+	LogicalVolumeDoc                  encoder.Doc
 	DiskPartitionDoc                  encoder.Doc
 	EncryptionConfigDoc               encoder.Doc
 	EncryptionKeyDoc                  encoder.Doc
@@ -54,11 +60,14 @@ var (
 	ExtraHostDoc                      encoder.Doc
 	DeviceDoc                         encoder.Doc
 	DHCPOptionsDoc                    encoder.Doc
+	DeviceIPv6ConfigDoc               encoder.Doc
 	DeviceWireguardConfigDoc          encoder.Doc
 	DeviceWireguardPeerDoc            encoder.Doc
 	DeviceVIPConfigDoc                encoder.Doc
 	VIPEquinixMetalConfigDoc          encoder.Doc
 	VIPHCloudConfigDoc                encoder.Doc
+	VIPBGPConfigDoc                   encoder.Doc
+	VIPBGPPeerDoc                     encoder.Doc
 	BondDoc                           encoder.Doc
 	STPDoc                            encoder.Doc
 	BridgeDoc                         encoder.Doc
@@ -73,7 +82,13 @@ var (
 	KubernetesTalosAPIAccessConfigDoc encoder.Doc
 	VolumeMountConfigDoc              encoder.Doc
 	ClusterInlineManifestDoc          encoder.Doc
+	NetworkLLDPDoc                    encoder.Doc
 	NetworkKubeSpanDoc                encoder.Doc
+	NetworkProbeDoc                   encoder.Doc
+	NetworkProbeTCPDoc                encoder.Doc
+	NetworkProbeICMPDoc               encoder.Doc
+	NetworkProbeHTTPDoc               encoder.Doc
+	NetworkRuleDoc                    encoder.Doc
 	NetworkDeviceSelectorDoc          encoder.Doc
 	ClusterDiscoveryConfigDoc         encoder.Doc
 	DiscoveryRegistriesConfigDoc      encoder.Doc
@@ -83,6 +98,7 @@ var (
 	LoggingConfigDoc                  encoder.Doc
 	LoggingDestinationDoc             encoder.Doc
 	KernelConfigDoc                   encoder.Doc
+	ImageGCConfigDoc                  encoder.Doc
 	KernelModuleConfigDoc             encoder.Doc
 )
 
@@ -145,7 +161,7 @@ func init() {
 			FieldName: "machine",
 		},
 	}
-	MachineConfigDoc.Fields = make([]encoder.Doc, 22)
+	MachineConfigDoc.Fields = make([]encoder.Doc, 28)
 	MachineConfigDoc.Fields[0].Name = "type"
 	MachineConfigDoc.Fields[0].Type = "string"
 	MachineConfigDoc.Fields[0].Note = ""
@@ -169,150 +185,192 @@ func init() {
 	MachineConfigDoc.Fields[2].Comments[encoder.LineComment] = "The root certificate authority of the PKI."
 
 	MachineConfigDoc.Fields[2].AddExample("machine CA example", pemEncodedCertificateExample)
-	MachineConfigDoc.Fields[3].Name = "certSANs"
-	MachineConfigDoc.Fields[3].Type = "[]string"
+	MachineConfigDoc.Fields[3].Name = "acceptedCAs"
+	MachineConfigDoc.Fields[3].Type = "[]PEMEncodedCertificateAndKey"
 	MachineConfigDoc.Fields[3].Note = ""
-	MachineConfigDoc.Fields[3].Description = "Extra certificate subject alternative names for the machine's certificate.\nBy default, all non-loopback interface IPs are automatically added to the certificate's SANs."
-	MachineConfigDoc.Fields[3].Comments[encoder.LineComment] = "Extra certificate subject alternative names for the machine's certificate."
+	MachineConfigDoc.Fields[3].Description = "The list of additional base64 encoded certificate authorities trusted by the Talos API.\n\nOnly the certificates (`crt`) are used, the keys are ignored.\nThis is used to rotate the `.machine.ca`: the new CA is accepted first, and after the switch the previous CA stays accepted until it is dropped."
+	MachineConfigDoc.Fields[3].Comments[encoder.LineComment] = "The list of additional base64 encoded certificate authorities trusted by the Talos API."
 
-	MachineConfigDoc.Fields[3].AddExample("Uncomment this to enable SANs.", []string{"10.0.0.10", "172.16.0.10", "192.168.0.10"})
-	MachineConfigDoc.Fields[4].Name = "controlPlane"
-	MachineConfigDoc.Fields[4].Type = "MachineControlPlaneConfig"
+	MachineConfigDoc.Fields[3].AddExample("AcceptedCAs example.", machineAcceptedCAsExample)
+	MachineConfigDoc.Fields[4].Name = "certSANs"
+	MachineConfigDoc.Fields[4].Type = "[]string"
 	MachineConfigDoc.Fields[4].Note = ""
-	MachineConfigDoc.Fields[4].Description = "Provides machine specific control plane configuration options."
-	MachineConfigDoc.Fields[4].Comments[encoder.LineComment] = "Provides machine specific control plane configuration options."
+	MachineConfigDoc.Fields[4].Description = "Extra certificate subject alternative names for the machine's certificate.\nBy default, all non-loopback interface IPs are automatically added to the certificate's SANs."
+	MachineConfigDoc.Fields[4].Comments[encoder.LineComment] = "Extra certificate subject alternative names for the machine's certificate."
 
-	MachineConfigDoc.Fields[4].AddExample("ControlPlane definition example.", machineControlplaneExample)
-	MachineConfigDoc.Fields[5].Name = "kubelet"
-	MachineConfigDoc.Fields[5].Type = "KubeletConfig"
+	MachineConfigDoc.Fields[4].AddExample("Uncomment this to enable SANs.", []string{"10.0.0.10", "172.16.0.10", "192.168.0.10"})
+	MachineConfigDoc.Fields[5].Name = "controlPlane"
+	MachineConfigDoc.Fields[5].Type = "MachineControlPlaneConfig"
 	MachineConfigDoc.Fields[5].Note = ""
-	MachineConfigDoc.Fields[5].Description = "Used to provide additional options to the kubelet."
-	MachineConfigDoc.Fields[5].Comments[encoder.LineComment] = "Used to provide additional options to the kubelet."
+	MachineConfigDoc.Fields[5].Description = "Provides machine specific control plane configuration options."
+	MachineConfigDoc.Fields[5].Comments[encoder.LineComment] = "Provides machine specific control plane configuration options."
 
-	MachineConfigDoc.Fields[5].AddExample("Kubelet definition example.", machineKubeletExample)
-	MachineConfigDoc.Fields[6].Name = "pods"
-	MachineConfigDoc.Fields[6].Type = "[]Unstructured"
+	MachineConfigDoc.Fields[5].AddExample("ControlPlane definition example.", machineControlplaneExample)
+	MachineConfigDoc.Fields[6].Name = "kubelet"
+	MachineConfigDoc.Fields[6].Type = "KubeletConfig"
 	MachineConfigDoc.Fields[6].Note = ""
-	MachineConfigDoc.Fields[6].Description = "Used to provide static pod definitions to be run by the kubelet directly bypassing the kube-apiserver.\n\nStatic pods can be used to run components which should be started before the Kubernetes control plane is up.\nTalos doesn't validate the pod definition.\nUpdates to this field can be applied without a reboot.\n\nSee https://kubernetes.io/docs/tasks/configure-pod-container/static-pod/."
-	MachineConfigDoc.Fields[6].Comments[encoder.LineComment] = "Used to provide static pod definitions to be run by the kubelet directly bypassing the kube-apiserver."
+	MachineConfigDoc.Fields[6].Description = "Used to provide additional options to the kubelet."
+	MachineConfigDoc.Fields[6].Comments[encoder.LineComment] = "Used to provide additional options to the kubelet."
 
-	MachineConfigDoc.Fields[6].AddExample("nginx static pod.", machinePodsExample)
-	MachineConfigDoc.Fields[7].Name = "network"
-	MachineConfigDoc.Fields[7].Type = "NetworkConfig"
+	MachineConfigDoc.Fields[6].AddExample("Kubelet definition example.", machineKubeletExample)
+	MachineConfigDoc.Fields[7].Name = "pods"
+	MachineConfigDoc.Fields[7].Type = "[]Unstructured"
 	MachineConfigDoc.Fields[7].Note = ""
-	MachineConfigDoc.Fields[7].Description = "Provides machine specific network configuration options."
-	MachineConfigDoc.Fields[7].Comments[encoder.LineComment] = "Provides machine specific network configuration options."
-
-	MachineConfigDoc.Fields[7].AddExample("Network definition example.", machineNetworkConfigExample)
-	MachineConfigDoc.Fields[8].Name = "disks"
-	MachineConfigDoc.Fields[8].Type = "[]MachineDisk"
-	MachineConfigDoc.Fields[8].Note = "Note: `size` is in units of bytes.\n"
-	MachineConfigDoc.Fields[8].Description = "Used to partition, format and mount additional disks.\nSince the rootfs is read only with the exception of `/var`, mounts are only valid if they are under `/var`.\nNote that the partitioning and formating is done only once, if and only if no existing partitions are found.\nIf `size:` is omitted, the partition is sized to occupy the full disk."
-	MachineConfigDoc.Fields[8].Comments[encoder.LineComment] = "Used to partition, format and mount additional disks."
-
-	MachineConfigDoc.Fields[8].AddExample("MachineDisks list example.", machineDisksExample)
-	MachineConfigDoc.Fields[9].Name = "install"
-	MachineConfigDoc.Fields[9].Type = "InstallConfig"
-	MachineConfigDoc.Fields[9].Note = ""
-	MachineConfigDoc.Fields[9].Description = "Used to provide instructions for installations."
-	MachineConfigDoc.Fields[9].Comments[encoder.LineComment] = "Used to provide instructions for installations."
-
-	MachineConfigDoc.Fields[9].AddExample("MachineInstall config usage example.", machineInstallExample)
-	MachineConfigDoc.Fields[10].Name = "files"
-	MachineConfigDoc.Fields[10].Type = "[]MachineFile"
-	MachineConfigDoc.Fields[10].Note = "Note: The specified `path` is relative to `/var`.\n"
-	MachineConfigDoc.Fields[10].Description = "Allows the addition of user specified files.\nThe value of `op` can be `create`, `overwrite`, or `append`.\nIn the case of `create`, `path` must not exist.\nIn the case of `overwrite`, and `append`, `path` must be a valid file.\nIf an `op` value of `append` is used, the existing file will be appended.\nNote that the file contents are not required to be base64 encoded."
-	MachineConfigDoc.Fields[10].Comments[encoder.LineComment] = "Allows the addition of user specified files."
-
-	MachineConfigDoc.Fields[10].AddExample("MachineFiles usage example.", machineFilesExample)
-	MachineConfigDoc.Fields[11].Name = "env"
-	MachineConfigDoc.Fields[11].Type = "Env"
+	MachineConfigDoc.Fields[7].Description = "Used to provide static pod definitions to be run by the kubelet directly bypassing the kube-apiserver.\n\nStatic pods can be used to run components which should be started before the Kubernetes control plane is up.\nTalos doesn't validate the pod definition.\nUpdates to this field can be applied without a reboot.\n\nSee https://kubernetes.io/docs/tasks/configure-pod-container/static-pod/."
+	MachineConfigDoc.Fields[7].Comments[encoder.LineComment] = "Used to provide static pod definitions to be run by the kubelet directly bypassing the kube-apiserver."
+
+	MachineConfigDoc.Fields[7].AddExample("nginx static pod.", machinePodsExample)
+	MachineConfigDoc.Fields[8].Name = "network"
+	MachineConfigDoc.Fields[8].Type = "NetworkConfig"
+	MachineConfigDoc.Fields[8].Note = ""
+	MachineConfigDoc.Fields[8].Description = "Provides machine specific network configuration options."
+	MachineConfigDoc.Fields[8].Comments[encoder.LineComment] = "Provides machine specific network configuration options."
+
+	MachineConfigDoc.Fields[8].AddExample("Network definition example.", machineNetworkConfigExample)
+	MachineConfigDoc.Fields[9].Name = "disks"
+	MachineConfigDoc.Fields[9].Type = "[]MachineDisk"
+	MachineConfigDoc.Fields[9].Note = "Note: `size` is in units of bytes.\n"
+	MachineConfigDoc.Fields[9].Description = "Used to partition, format and mount additional disks.\nSince the rootfs is read only with the exception of `/var`, mounts are only valid if they are under `/var`.\nNote that the partitioning and formating is done only once, if and only if no existing partitions are found.\nIf `size:` is omitted, the partition is sized to occupy the full disk."
+	MachineConfigDoc.Fields[9].Comments[encoder.LineComment] = "Used to partition, format and mount additional disks."
+
+	MachineConfigDoc.Fields[9].AddExample("MachineDisks list example.", machineDisksExample)
+	MachineConfigDoc.Fields[10].Name = "volumeGroups"
+	MachineConfigDoc.Fields[10].Type = "[]VolumeGroup"
+	MachineConfigDoc.Fields[10].Note = ""
+	MachineConfigDoc.Fields[10].Description = "Used to create LVM volume groups and logical volumes across machine disks, format and mount them.\nSince the rootfs is read only with the exception of `/var`, mounts are only valid if they are under `/var`.\nVolume groups and logical volumes are created only if they don't exist yet, so the configuration is applied idempotently on each boot.\nLogical volumes are formatted with XFS if no filesystem is found."
+	MachineConfigDoc.Fields[10].Comments[encoder.LineComment] = "Used to create LVM volume groups and logical volumes across machine disks, format and mount them."
+
+	MachineConfigDoc.Fields[10].AddExample("MachineVolumeGroups list example.", machineVolumeGroupsExample)
+	MachineConfigDoc.Fields[11].Name = "install"
+	MachineConfigDoc.Fields[11].Type = "InstallConfig"
 	MachineConfigDoc.Fields[11].Note = ""
-	MachineConfigDoc.Fields[11].Description = "The `env` field allows for the addition of environment variables.\nAll environment variables are set on PID 1 in addition to every service."
-	MachineConfigDoc.Fields[11].Comments[encoder.LineComment] = "The `env` field allows for the addition of environment variables."
+	MachineConfigDoc.Fields[11].Description = "Used to provide instructions for installations."
+	MachineConfigDoc.Fields[11].Comments[encoder.LineComment] = "Used to provide instructions for installations."
+
+	MachineConfigDoc.Fields[11].AddExample("MachineInstall config usage example.", machineInstallExample)
+	MachineConfigDoc.Fields[12].Name = "files"
+	MachineConfigDoc.Fields[12].Type = "[]MachineFile"
+	MachineConfigDoc.Fields[12].Note = "Note: The specified `path` is relative to `/var`.\n"
+	MachineConfigDoc.Fields[12].Description = "Allows the addition of user specified files.\nThe value of `op` can be `create`, `overwrite`, or `append`.\nIn the case of `create`, `path` must not exist.\nIn the case of `overwrite`, and `append`, `path` must be a valid file.\nIf an `op` value of `append` is used, the existing file will be appended.\nNote that the file contents are not required to be base64 encoded."
+	MachineConfigDoc.Fields[12].Comments[encoder.LineComment] = "Allows the addition of user specified files."
+
+	MachineConfigDoc.Fields[12].AddExample("MachineFiles usage example.", machineFilesExample)
+	MachineConfigDoc.Fields[13].Name = "env"
+	MachineConfigDoc.Fields[13].Type = "Env"
+	MachineConfigDoc.Fields[13].Note = ""
+	MachineConfigDoc.Fields[13].Description = "The `env` field allows for the addition of environment variables.\nAll environment variables are set on PID 1 in addition to every service."
+	MachineConfigDoc.Fields[13].Comments[encoder.LineComment] = "The `env` field allows for the addition of environment variables."
 
-	MachineConfigDoc.Fields[11].AddExample("Environment variables definition examples.", machineEnvExamples[0])
+	MachineConfigDoc.Fields[13].AddExample("Environment variables definition examples.", machineEnvExamples[0])
 
-	MachineConfigDoc.Fields[11].AddExample("", machineEnvExamples[1])
+	MachineConfigDoc.Fields[13].AddExample("", machineEnvExamples[1])
 
-	MachineConfigDoc.Fields[11].AddExample("", machineEnvExamples[2])
-	MachineConfigDoc.Fields[11].Values = []string{
+	MachineConfigDoc.Fields[13].AddExample("", machineEnvExamples[2])
+	MachineConfigDoc.Fields[13].Values = []string{
 		"`GRPC_GO_LOG_VERBOSITY_LEVEL`",
 		"`GRPC_GO_LOG_SEVERITY_LEVEL`",
 		"`http_proxy`",
 		"`https_proxy`",
 		"`no_proxy`",
 	}
-	MachineConfigDoc.Fields[12].Name = "time"
-	MachineConfigDoc.Fields[12].Type = "TimeConfig"
-	MachineConfigDoc.Fields[12].Note = ""
-	MachineConfigDoc.Fields[12].Description = "Used to configure the machine's time settings."
-	MachineConfigDoc.Fields[12].Comments[encoder.LineComment] = "Used to configure the machine's time settings."
-
-	MachineConfigDoc.Fields[12].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample)
-	MachineConfigDoc.Fields[13].Name = "sysctls"
-	MachineConfigDoc.Fields[13].Type = "map[string]string"
-	MachineConfigDoc.Fields[13].Note = ""
-	MachineConfigDoc.Fields[13].Description = "Used to configure the machine's sysctls."
-	MachineConfigDoc.Fields[13].Comments[encoder.LineComment] = "Used to configure the machine's sysctls."
-
-	MachineConfigDoc.Fields[13].AddExample("MachineSysctls usage example.", machineSysctlsExample)
-	MachineConfigDoc.Fields[14].Name = "sysfs"
-	MachineConfigDoc.Fields[14].Type = "map[string]string"
+	MachineConfigDoc.Fields[14].Name = "time"
+	MachineConfigDoc.Fields[14].Type = "TimeConfig"
 	MachineConfigDoc.Fields[14].Note = ""
-	MachineConfigDoc.Fields[14].Description = "Used to configure the machine's sysfs."
-	MachineConfigDoc.Fields[14].Comments[encoder.LineComment] = "Used to configure the machine's sysfs."
+	MachineConfigDoc.Fields[14].Description = "Used to configure the machine's time settings."
+	MachineConfigDoc.Fields[14].Comments[encoder.LineComment] = "Used to configure the machine's time settings."
 
-	MachineConfigDoc.Fields[14].AddExample("MachineSysfs usage example.", machineSysfsExample)
-	MachineConfigDoc.Fields[15].Name = "registries"
-	MachineConfigDoc.Fields[15].Type = "RegistriesConfig"
+	MachineConfigDoc.Fields[14].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample)
+	MachineConfigDoc.Fields[15].Name = "sysctls"
+	MachineConfigDoc.Fields[15].Type = "map[string]string"
 	MachineConfigDoc.Fields[15].Note = ""
-	MachineConfigDoc.Fields[15].Description = "Used to configure the machine's container image registry mirrors.\n\nAutomatically generates matching CRI configuration for registry mirrors.\n\nThe `mirrors` section allows to redirect requests for images to non-default registry,\nwhich might be local registry or caching mirror.\n\nThe `config` section provides a way to authenticate to the registry with TLS client\nidentity, provide registry CA, or authentication information.\nAuthentication information has same meaning with the corresponding field in `.docker/config.json`.\n\nSee also matching configuration for [CRI containerd plugin](https://github.com/containerd/cri/blob/master/docs/registry.md)."
-	MachineConfigDoc.Fields[15].Comments[encoder.LineComment] = "Used to configure the machine's container image registry mirrors."
+	MachineConfigDoc.Fields[15].Description = "Used to configure the machine's sysctls."
+	MachineConfigDoc.Fields[15].Comments[encoder.LineComment] = "Used to configure the machine's sysctls."
 
-	MachineConfigDoc.Fields[15].AddExample("", machineConfigRegistriesExample)
-	MachineConfigDoc.Fields[16].Name = "systemDiskEncryption"
-	MachineConfigDoc.Fields[16].Type = "SystemDiskEncryptionConfig"
+	MachineConfigDoc.Fields[15].AddExample("MachineSysctls usage example.", machineSysctlsExample)
+	MachineConfigDoc.Fields[16].Name = "sysfs"
+	MachineConfigDoc.Fields[16].Type = "map[string]string"
 	MachineConfigDoc.Fields[16].Note = ""
-	MachineConfigDoc.Fields[16].Description = "Machine system disk encryption configuration.\nDefines each system partition encryption parameters."
-	MachineConfigDoc.Fields[16].Comments[encoder.LineComment] = "Machine system disk encryption configuration."
+	MachineConfigDoc.Fields[16].Description = "Used to configure the machine's sysfs."
+	MachineConfigDoc.Fields[16].Comments[encoder.LineComment] = "Used to configure the machine's sysfs."
 
-	MachineConfigDoc.Fields[16].AddExample("", machineSystemDiskEncryptionExample)
-	MachineConfigDoc.Fields[17].Name = "features"
-	MachineConfigDoc.Fields[17].Type = "FeaturesConfig"
+	MachineConfigDoc.Fields[16].AddExample("MachineSysfs usage example.", machineSysfsExample)
+	MachineConfigDoc.Fields[17].Name = "registries"
+	MachineConfigDoc.Fields[17].Type = "RegistriesConfig"
 	MachineConfigDoc.Fields[17].Note = ""
-	MachineConfigDoc.Fields[17].Description = "Features describe individual Talos features that can be switched on or off."
-	MachineConfigDoc.Fields[17].Comments[encoder.LineComment] = "Features describe individual Talos features that can be switched on or off."
+	MachineConfigDoc.Fields[17].Description = "Used to configure the machine's container image registry mirrors.\n\nAutomatically generates matching CRI configuration for registry mirrors.\n\nThe `mirrors` section allows to redirect requests for images to non-default registry,\nwhich might be local registry or caching mirror.\n\nThe `config` section provides a way to authenticate to the registry with TLS client\nidentity, provide registry CA, or authentication information.\nAuthentication information has same meaning with the corresponding field in `.docker/config.json`.\n\nSee also matching configuration for [CRI containerd plugin](https://github.com/containerd/cri/blob/master/docs/registry.md)."
+	MachineConfigDoc.Fields[17].Comments[encoder.LineComment] = "Used to configure the machine's container image registry mirrors."
 
-	MachineConfigDoc.Fields[17].AddExample("", machineFeaturesExample)
-	MachineConfigDoc.Fields[18].Name = "udev"
-	MachineConfigDoc.Fields[18].Type = "UdevConfig"
+	MachineConfigDoc.Fields[17].AddExample("", machineConfigRegistriesExample)
+	MachineConfigDoc.Fields[18].Name = "systemDiskEncryption"
+	MachineConfigDoc.Fields[18].Type = "SystemDiskEncryptionConfig"
 	MachineConfigDoc.Fields[18].Note = ""
-	MachineConfigDoc.Fields[18].Description = "Configures the udev system."
-	MachineConfigDoc.Fields[18].Comments[encoder.LineComment] = "Configures the udev system."
+	MachineConfigDoc.Fields[18].Description = "Machine system disk encryption configuration.\nDefines each system partition encryption parameters."
+	MachineConfigDoc.Fields[18].Comments[encoder.LineComment] = "Machine system disk encryption configuration."
 
-	MachineConfigDoc.Fields[18].AddExample("", machineUdevExample)
-	MachineConfigDoc.Fields[19].Name = "logging"
-	MachineConfigDoc.Fields[19].Type = "LoggingConfig"
+	MachineConfigDoc.Fields[18].AddExample("", machineSystemDiskEncryptionExample)
+	MachineConfigDoc.Fields[19].Name = "features"
+	MachineConfigDoc.Fields[19].Type = "FeaturesConfig"
 	MachineConfigDoc.Fields[19].Note = ""
-	MachineConfigDoc.Fields[19].Description = "Configures the logging system."
-	MachineConfigDoc.Fields[19].Comments[encoder.LineComment] = "Configures the logging system."
+	MachineConfigDoc.Fields[19].Description = "Features describe individual Talos features that can be switched on or off."
+	MachineConfigDoc.Fields[19].Comments[encoder.LineComment] = "Features describe individual Talos features that can be switched on or off."
 
-	MachineConfigDoc.Fields[19].AddExample("", machineLoggingExample)
-	MachineConfigDoc.Fields[20].Name = "kernel"
-	MachineConfigDoc.Fields[20].Type = "KernelConfig"
+	MachineConfigDoc.Fields[19].AddExample("", machineFeaturesExample)
+	MachineConfigDoc.Fields[20].Name = "udev"
+	MachineConfigDoc.Fields[20].Type = "UdevConfig"
 	MachineConfigDoc.Fields[20].Note = ""
-	MachineConfigDoc.Fields[20].Description = "Configures the kernel."
-	MachineConfigDoc.Fields[20].Comments[encoder.LineComment] = "Configures the kernel."
+	MachineConfigDoc.Fields[20].Description = "Configures the udev system."
+	MachineConfigDoc.Fields[20].Comments[encoder.LineComment] = "Configures the udev system."
 
-	MachineConfigDoc.Fields[20].AddExample("", machineKernelExample)
-	MachineConfigDoc.Fields[21].Name = "seccompProfiles"
-	MachineConfigDoc.Fields[21].Type = "[]MachineSeccompProfile"
+	MachineConfigDoc.Fields[20].AddExample("", machineUdevExample)
+	MachineConfigDoc.Fields[21].Name = "logging"
+	MachineConfigDoc.Fields[21].Type = "LoggingConfig"
 	MachineConfigDoc.Fields[21].Note = ""
-	MachineConfigDoc.Fields[21].Description = "Configures the seccomp profiles for the machine."
-	MachineConfigDoc.Fields[21].Comments[encoder.LineComment] = "Configures the seccomp profiles for the machine."
-
-	MachineConfigDoc.Fields[21].AddExample("", machineSeccompExample)
+	MachineConfigDoc.Fields[21].Description = "Configures the logging system."
+	MachineConfigDoc.Fields[21].Comments[encoder.LineComment] = "Configures the logging system."
+
+	MachineConfigDoc.Fields[21].AddExample("", machineLoggingExample)
+	MachineConfigDoc.Fields[22].Name = "kernel"
+	MachineConfigDoc.Fields[22].Type = "KernelConfig"
+	MachineConfigDoc.Fields[22].Note = ""
+	MachineConfigDoc.Fields[22].Description = "Configures the kernel."
+	MachineConfigDoc.Fields[22].Comments[encoder.LineComment] = "Configures the kernel."
+
+	MachineConfigDoc.Fields[22].AddExample("", machineKernelExample)
+	MachineConfigDoc.Fields[23].Name = "imageGC"
+	MachineConfigDoc.Fields[23].Type = "ImageGCConfig"
+	MachineConfigDoc.Fields[23].Note = ""
+	MachineConfigDoc.Fields[23].Description = "Configures garbage collection of the images in the system containerd namespace.\n\nImages of the system namespace which are not used by Talos services, the configured installer and system extensions,\nand the current and previous Talos installation are pruned."
+	MachineConfigDoc.Fields[23].Comments[encoder.LineComment] = "Configures garbage collection of the images in the system containerd namespace."
+
+	MachineConfigDoc.Fields[23].AddExample("", machineImageGCExample)
+	MachineConfigDoc.Fields[24].Name = "seccompProfiles"
+	MachineConfigDoc.Fields[24].Type = "[]MachineSeccompProfile"
+	MachineConfigDoc.Fields[24].Note = ""
+	MachineConfigDoc.Fields[24].Description = "Configures the seccomp profiles for the machine."
+	MachineConfigDoc.Fields[24].Comments[encoder.LineComment] = "Configures the seccomp profiles for the machine."
+
+	MachineConfigDoc.Fields[24].AddExample("", machineSeccompExample)
+	MachineConfigDoc.Fields[25].Name = "nodeLabels"
+	MachineConfigDoc.Fields[25].Type = "map[string]string"
+	MachineConfigDoc.Fields[25].Note = ""
+	MachineConfigDoc.Fields[25].Description = "Configures the node labels for the machine.\n\nLabels are kept in sync with the Kubernetes Node resource,\nonly labels set by Talos are removed when they are dropped from this list.\nLabels restricted by the `NodeRestriction` admission plugin (e.g. `node-role.kubernetes.io/*`) can't be set."
+	MachineConfigDoc.Fields[25].Comments[encoder.LineComment] = "Configures the node labels for the machine."
+
+	MachineConfigDoc.Fields[25].AddExample("", machineNodeLabelsExample)
+	MachineConfigDoc.Fields[26].Name = "nodeAnnotations"
+	MachineConfigDoc.Fields[26].Type = "map[string]string"
+	MachineConfigDoc.Fields[26].Note = ""
+	MachineConfigDoc.Fields[26].Description = "Configures the node annotations for the machine.\n\nAnnotations are kept in sync with the Kubernetes Node resource,\nonly annotations set by Talos are removed when they are dropped from this list."
+	MachineConfigDoc.Fields[26].Comments[encoder.LineComment] = "Configures the node annotations for the machine."
+
+	MachineConfigDoc.Fields[26].AddExample("", machineNodeAnnotationsExample)
+	MachineConfigDoc.Fields[27].Name = "nodeTaints"
+	MachineConfigDoc.Fields[27].Type = "map[string]string"
+	MachineConfigDoc.Fields[27].Note = ""
+	MachineConfigDoc.Fields[27].Description = "Configures the node taints for the machine, the value format is `value:effect` (value is optional).\n\nTaints are set when the node is registered,\nand then they are kept in sync with the Kubernetes Node resource (only taints set by Talos are removed).\nControl plane nodes update the taints with the admin credentials.\nThe `NodeRestriction` admission plugin forbids the kubelet to modify the taints of the registered node,\nso on worker nodes changes to the taints are applied only if the plugin is disabled or when the node is registered again."
+	MachineConfigDoc.Fields[27].Comments[encoder.LineComment] = "Configures the node taints for the machine, the value format is `value:effect` (value is optional)."
+
+	MachineConfigDoc.Fields[27].AddExample("", machineNodeTaintsExample)
 
 	MachineSeccompProfileDoc.Type = "MachineSeccompProfile"
 	MachineSeccompProfileDoc.Comments[encoder.LineComment] = "MachineSeccompProfile defines seccomp profiles for the machine."
@@ -348,7 +406,7 @@ func init() {
 			FieldName: "cluster",
 		},
 	}
-	ClusterConfigDoc.Fields = make([]encoder.Doc, 25)
+	ClusterConfigDoc.Fields = make([]encoder.Doc, 30)
 	ClusterConfigDoc.Fields[0].Name = "id"
 	ClusterConfigDoc.Fields[0].Type = "string"
 	ClusterConfigDoc.Fields[0].Note = ""
@@ -404,121 +462,150 @@ func init() {
 	ClusterConfigDoc.Fields[8].Comments[encoder.LineComment] = "The base64 encoded root certificate authority used by Kubernetes."
 
 	ClusterConfigDoc.Fields[8].AddExample("ClusterCA example.", pemEncodedCertificateExample)
-	ClusterConfigDoc.Fields[9].Name = "aggregatorCA"
-	ClusterConfigDoc.Fields[9].Type = "PEMEncodedCertificateAndKey"
+	ClusterConfigDoc.Fields[9].Name = "acceptedCAs"
+	ClusterConfigDoc.Fields[9].Type = "[]PEMEncodedCertificateAndKey"
 	ClusterConfigDoc.Fields[9].Note = ""
-	ClusterConfigDoc.Fields[9].Description = "The base64 encoded aggregator certificate authority used by Kubernetes for front-proxy certificate generation.\n\nThis CA can be self-signed."
-	ClusterConfigDoc.Fields[9].Comments[encoder.LineComment] = "The base64 encoded aggregator certificate authority used by Kubernetes for front-proxy certificate generation."
+	ClusterConfigDoc.Fields[9].Description = "The list of additional base64 encoded Kubernetes certificate authorities trusted by the cluster components.\n\nOnly the certificates (`crt`) are used, the keys are ignored.\nThis is used to rotate the `.cluster.ca`: the new CA is accepted first, and after the switch the previous CA stays accepted until it is dropped."
+	ClusterConfigDoc.Fields[9].Comments[encoder.LineComment] = "The list of additional base64 encoded Kubernetes certificate authorities trusted by the cluster components."
 
-	ClusterConfigDoc.Fields[9].AddExample("AggregatorCA example.", pemEncodedCertificateExample)
-	ClusterConfigDoc.Fields[10].Name = "serviceAccount"
-	ClusterConfigDoc.Fields[10].Type = "PEMEncodedKey"
+	ClusterConfigDoc.Fields[9].AddExample("AcceptedCAs example.", clusterAcceptedCAsExample)
+	ClusterConfigDoc.Fields[10].Name = "aggregatorCA"
+	ClusterConfigDoc.Fields[10].Type = "PEMEncodedCertificateAndKey"
 	ClusterConfigDoc.Fields[10].Note = ""
-	ClusterConfigDoc.Fields[10].Description = "The base64 encoded private key for service account token generation."
-	ClusterConfigDoc.Fields[10].Comments[encoder.LineComment] = "The base64 encoded private key for service account token generation."
+	ClusterConfigDoc.Fields[10].Description = "The base64 encoded aggregator certificate authority used by Kubernetes for front-proxy certificate generation.\n\nThis CA can be self-signed."
+	ClusterConfigDoc.Fields[10].Comments[encoder.LineComment] = "The base64 encoded aggregator certificate authority used by Kubernetes for front-proxy certificate generation."
 
-	ClusterConfigDoc.Fields[10].AddExample("AggregatorCA example.", pemEncodedKeyExample)
-	ClusterConfigDoc.Fields[11].Name = "apiServer"
-	ClusterConfigDoc.Fields[11].Type = "APIServerConfig"
+	ClusterConfigDoc.Fields[10].AddExample("AggregatorCA example.", pemEncodedCertificateExample)
+	ClusterConfigDoc.Fields[11].Name = "serviceAccount"
+	ClusterConfigDoc.Fields[11].Type = "PEMEncodedKey"
 	ClusterConfigDoc.Fields[11].Note = ""
-	ClusterConfigDoc.Fields[11].Description = "API server specific configuration options."
-	ClusterConfigDoc.Fields[11].Comments[encoder.LineComment] = "API server specific configuration options."
+	ClusterConfigDoc.Fields[11].Description = "The base64 encoded private key for service account token generation."
+	ClusterConfigDoc.Fields[11].Comments[encoder.LineComment] = "The base64 encoded private key for service account token generation."
 
-	ClusterConfigDoc.Fields[11].AddExample("", clusterAPIServerExample)
-	ClusterConfigDoc.Fields[12].Name = "controllerManager"
-	ClusterConfigDoc.Fields[12].Type = "ControllerManagerConfig"
+	ClusterConfigDoc.Fields[11].AddExample("AggregatorCA example.", pemEncodedKeyExample)
+	ClusterConfigDoc.Fields[12].Name = "acceptedServiceAccountKeys"
+	ClusterConfigDoc.Fields[12].Type = "[]PEMEncodedKey"
 	ClusterConfigDoc.Fields[12].Note = ""
-	ClusterConfigDoc.Fields[12].Description = "Controller manager server specific configuration options."
-	ClusterConfigDoc.Fields[12].Comments[encoder.LineComment] = "Controller manager server specific configuration options."
+	ClusterConfigDoc.Fields[12].Description = "The list of additional base64 encoded service account keys accepted to verify service account tokens.\n\nThis is used to rotate the `.cluster.serviceAccount` key: tokens signed with the accepted keys stay valid."
+	ClusterConfigDoc.Fields[12].Comments[encoder.LineComment] = "The list of additional base64 encoded service account keys accepted to verify service account tokens."
 
-	ClusterConfigDoc.Fields[12].AddExample("", clusterControllerManagerExample)
-	ClusterConfigDoc.Fields[13].Name = "proxy"
-	ClusterConfigDoc.Fields[13].Type = "ProxyConfig"
+	ClusterConfigDoc.Fields[12].AddExample("AcceptedServiceAccountKeys example.", clusterAcceptedServiceAccountKeysExample)
+	ClusterConfigDoc.Fields[13].Name = "apiServer"
+	ClusterConfigDoc.Fields[13].Type = "APIServerConfig"
 	ClusterConfigDoc.Fields[13].Note = ""
-	ClusterConfigDoc.Fields[13].Description = "Kube-proxy server-specific configuration options"
-	ClusterConfigDoc.Fields[13].Comments[encoder.LineComment] = "Kube-proxy server-specific configuration options"
+	ClusterConfigDoc.Fields[13].Description = "API server specific configuration options."
+	ClusterConfigDoc.Fields[13].Comments[encoder.LineComment] = "API server specific configuration options."
 
-	ClusterConfigDoc.Fields[13].AddExample("", clusterProxyExample)
-	ClusterConfigDoc.Fields[14].Name = "scheduler"
-	ClusterConfigDoc.Fields[14].Type = "SchedulerConfig"
+	ClusterConfigDoc.Fields[13].AddExample("", clusterAPIServerExample)
+	ClusterConfigDoc.Fields[14].Name = "controllerManager"
+	ClusterConfigDoc.Fields[14].Type = "ControllerManagerConfig"
 	ClusterConfigDoc.Fields[14].Note = ""
-	ClusterConfigDoc.Fields[14].Description = "Scheduler server specific configuration options."
-	ClusterConfigDoc.Fields[14].Comments[encoder.LineComment] = "Scheduler server specific configuration options."
+	ClusterConfigDoc.Fields[14].Description = "Controller manager server specific configuration options."
+	ClusterConfigDoc.Fields[14].Comments[encoder.LineComment] = "Controller manager server specific configuration options."
 
-	ClusterConfigDoc.Fields[14].AddExample("", clusterSchedulerExample)
-	ClusterConfigDoc.Fields[15].Name = "discovery"
-	ClusterConfigDoc.Fields[15].Type = "ClusterDiscoveryConfig"
+	ClusterConfigDoc.Fields[14].AddExample("", clusterControllerManagerExample)
+	ClusterConfigDoc.Fields[15].Name = "proxy"
+	ClusterConfigDoc.Fields[15].Type = "ProxyConfig"
 	ClusterConfigDoc.Fields[15].Note = ""
-	ClusterConfigDoc.Fields[15].Description = "Configures cluster member discovery."
-	ClusterConfigDoc.Fields[15].Comments[encoder.LineComment] = "Configures cluster member discovery."
+	ClusterConfigDoc.Fields[15].Description = "Kube-proxy server-specific configuration options"
+	ClusterConfigDoc.Fields[15].Comments[encoder.LineComment] = "Kube-proxy server-specific configuration options"
 
-	ClusterConfigDoc.Fields[15].AddExample("", clusterDiscoveryExample)
-	ClusterConfigDoc.Fields[16].Name = "etcd"
-	ClusterConfigDoc.Fields[16].Type = "EtcdConfig"
+	ClusterConfigDoc.Fields[15].AddExample("", clusterProxyExample)
+	ClusterConfigDoc.Fields[16].Name = "scheduler"
+	ClusterConfigDoc.Fields[16].Type = "SchedulerConfig"
 	ClusterConfigDoc.Fields[16].Note = ""
-	ClusterConfigDoc.Fields[16].Description = "Etcd specific configuration options."
-	ClusterConfigDoc.Fields[16].Comments[encoder.LineComment] = "Etcd specific configuration options."
+	ClusterConfigDoc.Fields[16].Description = "Scheduler server specific configuration options."
+	ClusterConfigDoc.Fields[16].Comments[encoder.LineComment] = "Scheduler server specific configuration options."
 
-	ClusterConfigDoc.Fields[16].AddExample("", clusterEtcdExample)
-	ClusterConfigDoc.Fields[17].Name = "coreDNS"
-	ClusterConfigDoc.Fields[17].Type = "CoreDNS"
+	ClusterConfigDoc.Fields[16].AddExample("", clusterSchedulerExample)
+	ClusterConfigDoc.Fields[17].Name = "discovery"
+	ClusterConfigDoc.Fields[17].Type = "ClusterDiscoveryConfig"
 	ClusterConfigDoc.Fields[17].Note = ""
-	ClusterConfigDoc.Fields[17].Description = "Core DNS specific configuration options."
-	ClusterConfigDoc.Fields[17].Comments[encoder.LineComment] = "Core DNS specific configuration options."
+	ClusterConfigDoc.Fields[17].Description = "Configures cluster member discovery."
+	ClusterConfigDoc.Fields[17].Comments[encoder.LineComment] = "Configures cluster member discovery."
 
-	ClusterConfigDoc.Fields[17].AddExample("", clusterCoreDNSExample)
-	ClusterConfigDoc.Fields[18].Name = "externalCloudProvider"
-	ClusterConfigDoc.Fields[18].Type = "ExternalCloudProviderConfig"
+	ClusterConfigDoc.Fields[17].AddExample("", clusterDiscoveryExample)
+	ClusterConfigDoc.Fields[18].Name = "etcd"
+	ClusterConfigDoc.Fields[18].Type = "EtcdConfig"
 	ClusterConfigDoc.Fields[18].Note = ""
-	ClusterConfigDoc.Fields[18].Description = "External cloud provider configuration."
-	ClusterConfigDoc.Fields[18].Comments[encoder.LineComment] = "External cloud provider configuration."
+	ClusterConfigDoc.Fields[18].Description = "Etcd specific configuration options."
+	ClusterConfigDoc.Fields[18].Comments[encoder.LineComment] = "Etcd specific configuration options."
 
-	ClusterConfigDoc.Fields[18].AddExample("", clusterExternalCloudProviderConfigExample)
-	ClusterConfigDoc.Fields[19].Name = "extraManifests"
-	ClusterConfigDoc.Fields[19].Type = "[]string"
+	ClusterConfigDoc.Fields[18].AddExample("", clusterEtcdExample)
+	ClusterConfigDoc.Fields[19].Name = "coreDNS"
+	ClusterConfigDoc.Fields[19].Type = "CoreDNS"
 	ClusterConfigDoc.Fields[19].Note = ""
-	ClusterConfigDoc.Fields[19].Description = "A list of urls that point to additional manifests.\nThese will get automatically deployed as part of the bootstrap."
-	ClusterConfigDoc.Fields[19].Comments[encoder.LineComment] = "A list of urls that point to additional manifests."
+	ClusterConfigDoc.Fields[19].Description = "Core DNS specific configuration options."
+	ClusterConfigDoc.Fields[19].Comments[encoder.LineComment] = "Core DNS specific configuration options."
+
+	ClusterConfigDoc.Fields[19].AddExample("", clusterCoreDNSExample)
+	ClusterConfigDoc.Fields[20].Name = "externalCloudProvider"
+	ClusterConfigDoc.Fields[20].Type = "ExternalCloudProviderConfig"
+	ClusterConfigDoc.Fields[20].Note = ""
+	ClusterConfigDoc.Fields[20].Description = "External cloud provider configuration."
+	ClusterConfigDoc.Fields[20].Comments[encoder.LineComment] = "External cloud provider configuration."
+
+	ClusterConfigDoc.Fields[20].AddExample("", clusterExternalCloudProviderConfigExample)
+	ClusterConfigDoc.Fields[21].Name = "extraManifests"
+	ClusterConfigDoc.Fields[21].Type = "[]string"
+	ClusterConfigDoc.Fields[21].Note = ""
+	ClusterConfigDoc.Fields[21].Description = "A list of urls that point to additional manifests.\nThese will get automatically deployed as part of the bootstrap."
+	ClusterConfigDoc.Fields[21].Comments[encoder.LineComment] = "A list of urls that point to additional manifests."
 
-	ClusterConfigDoc.Fields[19].AddExample("", []string{
+	ClusterConfigDoc.Fields[21].AddExample("", []string{
 		"https://www.example.com/manifest1.yaml",
 		"https://www.example.com/manifest2.yaml",
 	})
-	ClusterConfigDoc.Fields[20].Name = "extraManifestHeaders"
-	ClusterConfigDoc.Fields[20].Type = "map[string]string"
-	ClusterConfigDoc.Fields[20].Note = ""
-	ClusterConfigDoc.Fields[20].Description = "A map of key value pairs that will be added while fetching the extraManifests."
-	ClusterConfigDoc.Fields[20].Comments[encoder.LineComment] = "A map of key value pairs that will be added while fetching the extraManifests."
+	ClusterConfigDoc.Fields[22].Name = "extraManifestHeaders"
+	ClusterConfigDoc.Fields[22].Type = "map[string]string"
+	ClusterConfigDoc.Fields[22].Note = ""
+	ClusterConfigDoc.Fields[22].Description = "A map of key value pairs that will be added while fetching the extraManifests."
+	ClusterConfigDoc.Fields[22].Comments[encoder.LineComment] = "A map of key value pairs that will be added while fetching the extraManifests."
 
-	ClusterConfigDoc.Fields[20].AddExample("", map[string]string{
+	ClusterConfigDoc.Fields[22].AddExample("", map[string]string{
 		"Token":       "1234567",
 		"X-ExtraInfo": "info",
 	})
-	ClusterConfigDoc.Fields[21].Name = "inlineManifests"
-	ClusterConfigDoc.Fields[21].Type = "ClusterInlineManifests"
-	ClusterConfigDoc.Fields[21].Note = ""
-	ClusterConfigDoc.Fields[21].Description = "A list of inline Kubernetes manifests.\nThese will get automatically deployed as part of the bootstrap."
-	ClusterConfigDoc.Fields[21].Comments[encoder.LineComment] = "A list of inline Kubernetes manifests."
-
-	ClusterConfigDoc.Fields[21].AddExample("", clusterInlineManifestsExample)
-	ClusterConfigDoc.Fields[22].Name = "adminKubeconfig"
-	ClusterConfigDoc.Fields[22].Type = "AdminKubeconfigConfig"
-	ClusterConfigDoc.Fields[22].Note = ""
-	ClusterConfigDoc.Fields[22].Description = "Settings for admin kubeconfig generation.\nCertificate lifetime can be configured."
-	ClusterConfigDoc.Fields[22].Comments[encoder.LineComment] = "Settings for admin kubeconfig generation."
-
-	ClusterConfigDoc.Fields[22].AddExample("", clusterAdminKubeconfigExample)
-	ClusterConfigDoc.Fields[24].Name = "allowSchedulingOnControlPlanes"
-	ClusterConfigDoc.Fields[24].Type = "bool"
+	ClusterConfigDoc.Fields[23].Name = "extraManifestsPrune"
+	ClusterConfigDoc.Fields[23].Type = "bool"
+	ClusterConfigDoc.Fields[23].Note = ""
+	ClusterConfigDoc.Fields[23].Description = "Prune the objects removed from the `extraManifests` (and other manifests fetched by URL, e.g. CNI manifests).\n\nObjects applied from the manifests are labeled with the Talos inventory label,\nand objects which are no longer present in any manifest are removed from the cluster by `talosctl upgrade-k8s`.\nDefaults to `false`."
+	ClusterConfigDoc.Fields[23].Comments[encoder.LineComment] = "Prune the objects removed from the `extraManifests` (and other manifests fetched by URL, e.g. CNI manifests)."
+	ClusterConfigDoc.Fields[24].Name = "inlineManifests"
+	ClusterConfigDoc.Fields[24].Type = "ClusterInlineManifests"
 	ClusterConfigDoc.Fields[24].Note = ""
-	ClusterConfigDoc.Fields[24].Description = "Allows running workload on control-plane nodes."
-	ClusterConfigDoc.Fields[24].Comments[encoder.LineComment] = "Allows running workload on control-plane nodes."
-	ClusterConfigDoc.Fields[24].Values = []string{
+	ClusterConfigDoc.Fields[24].Description = "A list of inline Kubernetes manifests.\nThese will get automatically deployed as part of the bootstrap."
+	ClusterConfigDoc.Fields[24].Comments[encoder.LineComment] = "A list of inline Kubernetes manifests."
+
+	ClusterConfigDoc.Fields[24].AddExample("", clusterInlineManifestsExample)
+	ClusterConfigDoc.Fields[25].Name = "bootstrapManifestsPrune"
+	ClusterConfigDoc.Fields[25].Type = "bool"
+	ClusterConfigDoc.Fields[25].Note = ""
+	ClusterConfigDoc.Fields[25].Description = "Prune the objects removed from the bootstrap manifests generated by Talos (e.g. `kube-proxy` when it is disabled).\n\nDefaults to `false`."
+	ClusterConfigDoc.Fields[25].Comments[encoder.LineComment] = "Prune the objects removed from the bootstrap manifests generated by Talos (e.g. `kube-proxy` when it is disabled)."
+	ClusterConfigDoc.Fields[26].Name = "adminKubeconfig"
+	ClusterConfigDoc.Fields[26].Type = "AdminKubeconfigConfig"
+	ClusterConfigDoc.Fields[26].Note = ""
+	ClusterConfigDoc.Fields[26].Description = "Settings for admin kubeconfig generation.\nCertificate lifetime can be configured."
+	ClusterConfigDoc.Fields[26].Comments[encoder.LineComment] = "Settings for admin kubeconfig generation."
+
+	ClusterConfigDoc.Fields[26].AddExample("", clusterAdminKubeconfigExample)
+	ClusterConfigDoc.Fields[28].Name = "allowSchedulingOnControlPlanes"
+	ClusterConfigDoc.Fields[28].Type = "bool"
+	ClusterConfigDoc.Fields[28].Note = ""
+	ClusterConfigDoc.Fields[28].Description = "Allows running workload on control-plane nodes."
+	ClusterConfigDoc.Fields[28].Comments[encoder.LineComment] = "Allows running workload on control-plane nodes."
+	ClusterConfigDoc.Fields[28].Values = []string{
 		"true",
 		"yes",
 		"false",
 		"no",
 	}
+	ClusterConfigDoc.Fields[29].Name = "disableKubeletServingCertificateApprover"
+	ClusterConfigDoc.Fields[29].Type = "bool"
+	ClusterConfigDoc.Fields[29].Note = ""
+	ClusterConfigDoc.Fields[29].Description = "Disable the kubelet serving certificate approver.\n\nWhen kubelet serving certificate rotation is enabled, control plane nodes approve kubelet serving certificate signing requests\nif the node name and the requested SANs match the cluster member.\nDisable it to use another approver.\nDefaults to `false`."
+	ClusterConfigDoc.Fields[29].Comments[encoder.LineComment] = "Disable the kubelet serving certificate approver."
 
 	ExtraMountDoc.Type = "ExtraMount"
 	ExtraMountDoc.Comments[encoder.LineComment] = "ExtraMount wraps OCI Mount specification."
@@ -599,7 +686,7 @@ func init() {
 			FieldName: "kubelet",
 		},
 	}
-	KubeletConfigDoc.Fields = make([]encoder.Doc, 10)
+	KubeletConfigDoc.Fields = make([]encoder.Doc, 11)
 	KubeletConfigDoc.Fields[0].Name = "image"
 	KubeletConfigDoc.Fields[0].Type = "string"
 	KubeletConfigDoc.Fields[0].Note = ""
@@ -637,57 +724,64 @@ func init() {
 	KubeletConfigDoc.Fields[4].Comments[encoder.LineComment] = "The `extraConfig` field is used to provide kubelet configuration overrides."
 
 	KubeletConfigDoc.Fields[4].AddExample("", kubeletExtraConfigExample)
-	KubeletConfigDoc.Fields[5].Name = "defaultRuntimeSeccompProfileEnabled"
-	KubeletConfigDoc.Fields[5].Type = "bool"
+	KubeletConfigDoc.Fields[5].Name = "credentialProviderConfig"
+	KubeletConfigDoc.Fields[5].Type = "Unstructured"
 	KubeletConfigDoc.Fields[5].Note = ""
-	KubeletConfigDoc.Fields[5].Description = "Enable container runtime default Seccomp profile."
-	KubeletConfigDoc.Fields[5].Comments[encoder.LineComment] = "Enable container runtime default Seccomp profile."
-	KubeletConfigDoc.Fields[5].Values = []string{
-		"true",
-		"yes",
-		"false",
-		"no",
-	}
-	KubeletConfigDoc.Fields[6].Name = "registerWithFQDN"
+	KubeletConfigDoc.Fields[5].Description = "The `credentialProviderConfig` field is used to configure the kubelet image credential provider plugins.\n\nPlugin binaries should be installed into `/usr/local/lib/kubelet/credentialproviders` via system extensions.\nThe same plugins are used by Talos to fetch credentials for the image pulls of the system services,\nif there is no static authentication configured for the registry in `.machine.registries.config`."
+	KubeletConfigDoc.Fields[5].Comments[encoder.LineComment] = "The `credentialProviderConfig` field is used to configure the kubelet image credential provider plugins."
+
+	KubeletConfigDoc.Fields[5].AddExample("", kubeletCredentialProviderConfigExample)
+	KubeletConfigDoc.Fields[6].Name = "defaultRuntimeSeccompProfileEnabled"
 	KubeletConfigDoc.Fields[6].Type = "bool"
 	KubeletConfigDoc.Fields[6].Note = ""
-	KubeletConfigDoc.Fields[6].Description = "The `registerWithFQDN` field is used to force kubelet to use the node FQDN for registration.\nThis is required in clouds like AWS."
-	KubeletConfigDoc.Fields[6].Comments[encoder.LineComment] = "The `registerWithFQDN` field is used to force kubelet to use the node FQDN for registration."
+	KubeletConfigDoc.Fields[6].Description = "Enable container runtime default Seccomp profile."
+	KubeletConfigDoc.Fields[6].Comments[encoder.LineComment] = "Enable container runtime default Seccomp profile."
 	KubeletConfigDoc.Fields[6].Values = []string{
 		"true",
 		"yes",
 		"false",
 		"no",
 	}
-	KubeletConfigDoc.Fields[7].Name = "nodeIP"
-	KubeletConfigDoc.Fields[7].Type = "KubeletNodeIPConfig"
+	KubeletConfigDoc.Fields[7].Name = "registerWithFQDN"
+	KubeletConfigDoc.Fields[7].Type = "bool"
 	KubeletConfigDoc.Fields[7].Note = ""
-	KubeletConfigDoc.Fields[7].Description = "The `nodeIP` field is used to configure `--node-ip` flag for the kubelet.\nThis is used when a node has multiple addresses to choose from."
-	KubeletConfigDoc.Fields[7].Comments[encoder.LineComment] = "The `nodeIP` field is used to configure `--node-ip` flag for the kubelet."
-
-	KubeletConfigDoc.Fields[7].AddExample("", kubeletNodeIPExample)
-	KubeletConfigDoc.Fields[8].Name = "skipNodeRegistration"
-	KubeletConfigDoc.Fields[8].Type = "bool"
-	KubeletConfigDoc.Fields[8].Note = ""
-	KubeletConfigDoc.Fields[8].Description = "The `skipNodeRegistration` is used to run the kubelet without registering with the apiserver.\nThis runs kubelet as standalone and only runs static pods."
-	KubeletConfigDoc.Fields[8].Comments[encoder.LineComment] = "The `skipNodeRegistration` is used to run the kubelet without registering with the apiserver."
-	KubeletConfigDoc.Fields[8].Values = []string{
+	KubeletConfigDoc.Fields[7].Description = "The `registerWithFQDN` field is used to force kubelet to use the node FQDN for registration.\nThis is required in clouds like AWS."
+	KubeletConfigDoc.Fields[7].Comments[encoder.LineComment] = "The `registerWithFQDN` field is used to force kubelet to use the node FQDN for registration."
+	KubeletConfigDoc.Fields[7].Values = []string{
 		"true",
 		"yes",
 		"false",
 		"no",
 	}
-	KubeletConfigDoc.Fields[9].Name = "disableManifestsDirectory"
+	KubeletConfigDoc.Fields[8].Name = "nodeIP"
+	KubeletConfigDoc.Fields[8].Type = "KubeletNodeIPConfig"
+	KubeletConfigDoc.Fields[8].Note = ""
+	KubeletConfigDoc.Fields[8].Description = "The `nodeIP` field is used to configure `--node-ip` flag for the kubelet.\nThis is used when a node has multiple addresses to choose from."
+	KubeletConfigDoc.Fields[8].Comments[encoder.LineComment] = "The `nodeIP` field is used to configure `--node-ip` flag for the kubelet."
+
+	KubeletConfigDoc.Fields[8].AddExample("", kubeletNodeIPExample)
+	KubeletConfigDoc.Fields[9].Name = "skipNodeRegistration"
 	KubeletConfigDoc.Fields[9].Type = "bool"
 	KubeletConfigDoc.Fields[9].Note = ""
-	KubeletConfigDoc.Fields[9].Description = "The `disableManifestsDirectory` field configures the kubelet to get static pod manifests from the /etc/kubernetes/manifests directory.\nIt's recommended to configure static pods with the \"pods\" key instead."
-	KubeletConfigDoc.Fields[9].Comments[encoder.LineComment] = "The `disableManifestsDirectory` field configures the kubelet to get static pod manifests from the /etc/kubernetes/manifests directory."
+	KubeletConfigDoc.Fields[9].Description = "The `skipNodeRegistration` is used to run the kubelet without registering with the apiserver.\nThis runs kubelet as standalone and only runs static pods."
+	KubeletConfigDoc.Fields[9].Comments[encoder.LineComment] = "The `skipNodeRegistration` is used to run the kubelet without registering with the apiserver."
 	KubeletConfigDoc.Fields[9].Values = []string{
 		"true",
 		"yes",
 		"false",
 		"no",
 	}
+	KubeletConfigDoc.Fields[10].Name = "disableManifestsDirectory"
+	KubeletConfigDoc.Fields[10].Type = "bool"
+	KubeletConfigDoc.Fields[10].Note = ""
+	KubeletConfigDoc.Fields[10].Description = "The `disableManifestsDirectory` field configures the kubelet to get static pod manifests from the /etc/kubernetes/manifests directory.\nIt's recommended to configure static pods with the \"pods\" key instead."
+	KubeletConfigDoc.Fields[10].Comments[encoder.LineComment] = "The `disableManifestsDirectory` field configures the kubelet to get static pod manifests from the /etc/kubernetes/manifests directory."
+	KubeletConfigDoc.Fields[10].Values = []string{
+		"true",
+		"yes",
+		"false",
+		"no",
+	}
 
 	KubeletNodeIPConfigDoc.Type = "KubeletNodeIPConfig"
 	KubeletNodeIPConfigDoc.Comments[encoder.LineComment] = "KubeletNodeIPConfig represents the kubelet node IP configuration."
@@ -718,7 +812,7 @@ func init() {
 			FieldName: "network",
 		},
 	}
-	NetworkConfigDoc.Fields = make([]encoder.Doc, 6)
+	NetworkConfigDoc.Fields = make([]encoder.Doc, 9)
 	NetworkConfigDoc.Fields[0].Name = "hostname"
 	NetworkConfigDoc.Fields[0].Type = "string"
 	NetworkConfigDoc.Fields[0].Note = ""
@@ -763,6 +857,27 @@ func init() {
 		"false",
 		"no",
 	}
+	NetworkConfigDoc.Fields[6].Name = "probes"
+	NetworkConfigDoc.Fields[6].Type = "[]NetworkProbe"
+	NetworkConfigDoc.Fields[6].Note = ""
+	NetworkConfigDoc.Fields[6].Description = "Network reachability probes.\nProbe results are reported as ProbeStatus resources, and the `probes` network status\nis ready only when all of the probes succeed."
+	NetworkConfigDoc.Fields[6].Comments[encoder.LineComment] = "Network reachability probes."
+
+	NetworkConfigDoc.Fields[6].AddExample("", networkProbesExample)
+	NetworkConfigDoc.Fields[7].Name = "rules"
+	NetworkConfigDoc.Fields[7].Type = "[]NetworkRule"
+	NetworkConfigDoc.Fields[7].Note = ""
+	NetworkConfigDoc.Fields[7].Description = "Routing policy rules (`ip rule`).\nRules select the routing table to be used for the packet based on the packet source, destination,\nfirewall mark and interfaces, e.g. to route the replies via the gateway of the interface they were received on."
+	NetworkConfigDoc.Fields[7].Comments[encoder.LineComment] = "Routing policy rules (`ip rule`)."
+
+	NetworkConfigDoc.Fields[7].AddExample("", networkRulesExample)
+	NetworkConfigDoc.Fields[8].Name = "lldp"
+	NetworkConfigDoc.Fields[8].Type = "NetworkLLDP"
+	NetworkConfigDoc.Fields[8].Note = ""
+	NetworkConfigDoc.Fields[8].Description = "Configures LLDP neighbor discovery.\nLLDP frames are always received on physical links, and discovered neighbors are reported as LLDPNeighbor resources."
+	NetworkConfigDoc.Fields[8].Comments[encoder.LineComment] = "Configures LLDP neighbor discovery."
+
+	NetworkConfigDoc.Fields[8].AddExample("", networkLLDPExample)
 
 	InstallConfigDoc.Type = "InstallConfig"
 	InstallConfigDoc.Comments[encoder.LineComment] = "InstallConfig represents the installation options for preparing a node."
@@ -775,7 +890,7 @@ func init() {
 			FieldName: "install",
 		},
 	}
-	InstallConfigDoc.Fields = make([]encoder.Doc, 8)
+	InstallConfigDoc.Fields = make([]encoder.Doc, 9)
 	InstallConfigDoc.Fields[0].Name = "disk"
 	InstallConfigDoc.Fields[0].Type = "string"
 	InstallConfigDoc.Fields[0].Note = ""
@@ -840,6 +955,17 @@ func init() {
 	InstallConfigDoc.Fields[7].Note = ""
 	InstallConfigDoc.Fields[7].Description = "Indicates if MBR partition should be marked as bootable (active).\nShould be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme."
 	InstallConfigDoc.Fields[7].Comments[encoder.LineComment] = "Indicates if MBR partition should be marked as bootable (active)."
+	InstallConfigDoc.Fields[8].Name = "growPartitions"
+	InstallConfigDoc.Fields[8].Type = "bool"
+	InstallConfigDoc.Fields[8].Note = ""
+	InstallConfigDoc.Fields[8].Description = "Indicates if the EPHEMERAL partition and the last partition of user disks sized to occupy the full disk\nshould be grown on boot to use the free space after them (e.g. after the disk is enlarged).\nDefaults to `true`."
+	InstallConfigDoc.Fields[8].Comments[encoder.LineComment] = "Indicates if the EPHEMERAL partition and the last partition of user disks sized to occupy the full disk"
+	InstallConfigDoc.Fields[8].Values = []string{
+		"true",
+		"yes",
+		"false",
+		"no",
+	}
 
 	InstallDiskSelectorDoc.Type = "InstallDiskSelector"
 	InstallDiskSelectorDoc.Comments[encoder.LineComment] = "InstallDiskSelector represents a disk query parameters for the install disk lookup."
@@ -1082,7 +1208,7 @@ func init() {
 			FieldName: "apiServer",
 		},
 	}
-	APIServerConfigDoc.Fields = make([]encoder.Doc, 8)
+	APIServerConfigDoc.Fields = make([]encoder.Doc, 13)
 	APIServerConfigDoc.Fields[0].Name = "image"
 	APIServerConfigDoc.Fields[0].Type = "string"
 	APIServerConfigDoc.Fields[0].Note = ""
@@ -1129,6 +1255,41 @@ func init() {
 	APIServerConfigDoc.Fields[7].Comments[encoder.LineComment] = "Configure the API server audit policy."
 
 	APIServerConfigDoc.Fields[7].AddExample("", APIServerDefaultAuditPolicy)
+	APIServerConfigDoc.Fields[8].Name = "kmsProviders"
+	APIServerConfigDoc.Fields[8].Type = "[]KMSProviderConfig"
+	APIServerConfigDoc.Fields[8].Note = ""
+	APIServerConfigDoc.Fields[8].Description = "Configure KMS providers for the envelope encryption of Kubernetes secrets at rest.\n\nNew secrets are encrypted with the first provider which is not marked as `decryptOnly`.\nThe remaining providers (and `secretbox`/`aescbc` keys) are used to decrypt existing secrets."
+	APIServerConfigDoc.Fields[8].Comments[encoder.LineComment] = "Configure KMS providers for the envelope encryption of Kubernetes secrets at rest."
+
+	APIServerConfigDoc.Fields[8].AddExample("", apiServerKMSProvidersExample)
+	APIServerConfigDoc.Fields[9].Name = "resources"
+	APIServerConfigDoc.Fields[9].Type = "ResourcesConfig"
+	APIServerConfigDoc.Fields[9].Note = ""
+	APIServerConfigDoc.Fields[9].Description = "Configure the API server resources."
+	APIServerConfigDoc.Fields[9].Comments[encoder.LineComment] = "Configure the API server resources."
+
+	APIServerConfigDoc.Fields[9].AddExample("", resourcesConfigExample)
+	APIServerConfigDoc.Fields[10].Name = "priorityClassName"
+	APIServerConfigDoc.Fields[10].Type = "string"
+	APIServerConfigDoc.Fields[10].Note = ""
+	APIServerConfigDoc.Fields[10].Description = "The priority class of the API server static pod.\nDefaults to `system-cluster-critical`."
+	APIServerConfigDoc.Fields[10].Comments[encoder.LineComment] = "The priority class of the API server static pod."
+
+	APIServerConfigDoc.Fields[10].AddExample("", "system-node-critical")
+	APIServerConfigDoc.Fields[11].Name = "auditLog"
+	APIServerConfigDoc.Fields[11].Type = "AuditLogConfig"
+	APIServerConfigDoc.Fields[11].Note = ""
+	APIServerConfigDoc.Fields[11].Description = "Configure the API server audit log retention.\n\nAudit log files are stored in `/var/log/audit/kube` on the host,\nnew audit events are forwarded to the machine logging destinations and are available via `talosctl logs kube-apiserver-audit`."
+	APIServerConfigDoc.Fields[11].Comments[encoder.LineComment] = "Configure the API server audit log retention."
+
+	APIServerConfigDoc.Fields[11].AddExample("", apiServerAuditLogExample)
+	APIServerConfigDoc.Fields[12].Name = "oidc"
+	APIServerConfigDoc.Fields[12].Type = "OIDCConfig"
+	APIServerConfigDoc.Fields[12].Note = ""
+	APIServerConfigDoc.Fields[12].Description = "Configure the API server OpenID Connect token authenticator.\n\nThe CA bundle to verify the issuer's serving certificate is delivered via the secrets pipeline,\nso there is no need to mount it with `extraVolumes`."
+	APIServerConfigDoc.Fields[12].Comments[encoder.LineComment] = "Configure the API server OpenID Connect token authenticator."
+
+	APIServerConfigDoc.Fields[12].AddExample("", apiServerOIDCExample)
 
 	AdmissionPluginConfigDoc.Type = "AdmissionPluginConfig"
 	AdmissionPluginConfigDoc.Comments[encoder.LineComment] = "AdmissionPluginConfig represents the API server admission plugin configuration."
@@ -1153,6 +1314,39 @@ func init() {
 	AdmissionPluginConfigDoc.Fields[1].Description = "Configuration is an embedded configuration object to be used as the plugin's\nconfiguration."
 	AdmissionPluginConfigDoc.Fields[1].Comments[encoder.LineComment] = "Configuration is an embedded configuration object to be used as the plugin's"
 
+	KMSProviderConfigDoc.Type = "KMSProviderConfig"
+	KMSProviderConfigDoc.Comments[encoder.LineComment] = "KMSProviderConfig represents the API server KMS v2 provider configuration."
+	KMSProviderConfigDoc.Description = "KMSProviderConfig represents the API server KMS v2 provider configuration."
+
+	KMSProviderConfigDoc.AddExample("", apiServerKMSProvidersExample)
+	KMSProviderConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "APIServerConfig",
+			FieldName: "kmsProviders",
+		},
+	}
+	KMSProviderConfigDoc.Fields = make([]encoder.Doc, 4)
+	KMSProviderConfigDoc.Fields[0].Name = "name"
+	KMSProviderConfigDoc.Fields[0].Type = "string"
+	KMSProviderConfigDoc.Fields[0].Note = ""
+	KMSProviderConfigDoc.Fields[0].Description = "Name of the KMS plugin, should be unique across the providers."
+	KMSProviderConfigDoc.Fields[0].Comments[encoder.LineComment] = "Name of the KMS plugin, should be unique across the providers."
+	KMSProviderConfigDoc.Fields[1].Name = "endpoint"
+	KMSProviderConfigDoc.Fields[1].Type = "string"
+	KMSProviderConfigDoc.Fields[1].Note = ""
+	KMSProviderConfigDoc.Fields[1].Description = "Path to the KMS plugin gRPC Unix socket on the host.\n\nThe directory containing the socket is mounted into the API server static pod."
+	KMSProviderConfigDoc.Fields[1].Comments[encoder.LineComment] = "Path to the KMS plugin gRPC Unix socket on the host."
+	KMSProviderConfigDoc.Fields[2].Name = "timeout"
+	KMSProviderConfigDoc.Fields[2].Type = "Duration"
+	KMSProviderConfigDoc.Fields[2].Note = ""
+	KMSProviderConfigDoc.Fields[2].Description = "Timeout for the calls to the KMS plugin (defaults to 3s)."
+	KMSProviderConfigDoc.Fields[2].Comments[encoder.LineComment] = "Timeout for the calls to the KMS plugin (defaults to 3s)."
+	KMSProviderConfigDoc.Fields[3].Name = "decryptOnly"
+	KMSProviderConfigDoc.Fields[3].Type = "bool"
+	KMSProviderConfigDoc.Fields[3].Note = ""
+	KMSProviderConfigDoc.Fields[3].Description = "Use the provider only to decrypt existing secrets.\n\nDecrypt-only providers are placed after the `secretbox`/`aescbc` keys, which allows\nto roll the provider out to all control plane nodes before it is used for encryption,\nor to migrate secrets away from the provider."
+	KMSProviderConfigDoc.Fields[3].Comments[encoder.LineComment] = "Use the provider only to decrypt existing secrets."
+
 	ControllerManagerConfigDoc.Type = "ControllerManagerConfig"
 	ControllerManagerConfigDoc.Comments[encoder.LineComment] = "ControllerManagerConfig represents the kube controller manager configuration options."
 	ControllerManagerConfigDoc.Description = "ControllerManagerConfig represents the kube controller manager configuration options."
@@ -1164,7 +1358,7 @@ func init() {
 			FieldName: "controllerManager",
 		},
 	}
-	ControllerManagerConfigDoc.Fields = make([]encoder.Doc, 4)
+	ControllerManagerConfigDoc.Fields = make([]encoder.Doc, 6)
 	ControllerManagerConfigDoc.Fields[0].Name = "image"
 	ControllerManagerConfigDoc.Fields[0].Type = "string"
 	ControllerManagerConfigDoc.Fields[0].Note = ""
@@ -1187,6 +1381,20 @@ func init() {
 	ControllerManagerConfigDoc.Fields[3].Note = ""
 	ControllerManagerConfigDoc.Fields[3].Description = "The `env` field allows for the addition of environment variables for the control plane component."
 	ControllerManagerConfigDoc.Fields[3].Comments[encoder.LineComment] = "The `env` field allows for the addition of environment variables for the control plane component."
+	ControllerManagerConfigDoc.Fields[4].Name = "resources"
+	ControllerManagerConfigDoc.Fields[4].Type = "ResourcesConfig"
+	ControllerManagerConfigDoc.Fields[4].Note = ""
+	ControllerManagerConfigDoc.Fields[4].Description = "Configure the controller manager resources."
+	ControllerManagerConfigDoc.Fields[4].Comments[encoder.LineComment] = "Configure the controller manager resources."
+
+	ControllerManagerConfigDoc.Fields[4].AddExample("", resourcesConfigExample)
+	ControllerManagerConfigDoc.Fields[5].Name = "priorityClassName"
+	ControllerManagerConfigDoc.Fields[5].Type = "string"
+	ControllerManagerConfigDoc.Fields[5].Note = ""
+	ControllerManagerConfigDoc.Fields[5].Description = "The priority class of the controller manager static pod.\nDefaults to `system-cluster-critical`."
+	ControllerManagerConfigDoc.Fields[5].Comments[encoder.LineComment] = "The priority class of the controller manager static pod."
+
+	ControllerManagerConfigDoc.Fields[5].AddExample("", "system-node-critical")
 
 	ProxyConfigDoc.Type = "ProxyConfig"
 	ProxyConfigDoc.Comments[encoder.LineComment] = "ProxyConfig represents the kube proxy configuration options."
@@ -1236,7 +1444,7 @@ func init() {
 			FieldName: "scheduler",
 		},
 	}
-	SchedulerConfigDoc.Fields = make([]encoder.Doc, 4)
+	SchedulerConfigDoc.Fields = make([]encoder.Doc, 6)
 	SchedulerConfigDoc.Fields[0].Name = "image"
 	SchedulerConfigDoc.Fields[0].Type = "string"
 	SchedulerConfigDoc.Fields[0].Note = ""
@@ -1259,6 +1467,143 @@ func init() {
 	SchedulerConfigDoc.Fields[3].Note = ""
 	SchedulerConfigDoc.Fields[3].Description = "The `env` field allows for the addition of environment variables for the control plane component."
 	SchedulerConfigDoc.Fields[3].Comments[encoder.LineComment] = "The `env` field allows for the addition of environment variables for the control plane component."
+	SchedulerConfigDoc.Fields[4].Name = "resources"
+	SchedulerConfigDoc.Fields[4].Type = "ResourcesConfig"
+	SchedulerConfigDoc.Fields[4].Note = ""
+	SchedulerConfigDoc.Fields[4].Description = "Configure the scheduler resources."
+	SchedulerConfigDoc.Fields[4].Comments[encoder.LineComment] = "Configure the scheduler resources."
+
+	SchedulerConfigDoc.Fields[4].AddExample("", resourcesConfigExample)
+	SchedulerConfigDoc.Fields[5].Name = "priorityClassName"
+	SchedulerConfigDoc.Fields[5].Type = "string"
+	SchedulerConfigDoc.Fields[5].Note = ""
+	SchedulerConfigDoc.Fields[5].Description = "The priority class of the scheduler static pod.\nDefaults to `system-cluster-critical`."
+	SchedulerConfigDoc.Fields[5].Comments[encoder.LineComment] = "The priority class of the scheduler static pod."
+
+	SchedulerConfigDoc.Fields[5].AddExample("", "system-node-critical")
+
+	ResourcesConfigDoc.Type = "ResourcesConfig"
+	ResourcesConfigDoc.Comments[encoder.LineComment] = "ResourcesConfig represents the pod resources."
+	ResourcesConfigDoc.Description = "ResourcesConfig represents the pod resources."
+
+	ResourcesConfigDoc.AddExample("", resourcesConfigExample)
+
+	ResourcesConfigDoc.AddExample("", resourcesConfigExample)
+
+	ResourcesConfigDoc.AddExample("", resourcesConfigExample)
+	ResourcesConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "APIServerConfig",
+			FieldName: "resources",
+		},
+		{
+			TypeName:  "ControllerManagerConfig",
+			FieldName: "resources",
+		},
+		{
+			TypeName:  "SchedulerConfig",
+			FieldName: "resources",
+		},
+	}
+	ResourcesConfigDoc.Fields = make([]encoder.Doc, 2)
+	ResourcesConfigDoc.Fields[0].Name = "requests"
+	ResourcesConfigDoc.Fields[0].Type = "Unstructured"
+	ResourcesConfigDoc.Fields[0].Note = ""
+	ResourcesConfigDoc.Fields[0].Description = "Requests configures the reserved cpu/memory resources.\nUnset values default to the built-in requests of the control plane component."
+	ResourcesConfigDoc.Fields[0].Comments[encoder.LineComment] = "Requests configures the reserved cpu/memory resources."
+	ResourcesConfigDoc.Fields[1].Name = "limits"
+	ResourcesConfigDoc.Fields[1].Type = "Unstructured"
+	ResourcesConfigDoc.Fields[1].Note = ""
+	ResourcesConfigDoc.Fields[1].Description = "Limits configures the maximum cpu/memory resources a container can use."
+	ResourcesConfigDoc.Fields[1].Comments[encoder.LineComment] = "Limits configures the maximum cpu/memory resources a container can use."
+
+	AuditLogConfigDoc.Type = "AuditLogConfig"
+	AuditLogConfigDoc.Comments[encoder.LineComment] = "AuditLogConfig represents the API server audit log retention."
+	AuditLogConfigDoc.Description = "AuditLogConfig represents the API server audit log retention."
+
+	AuditLogConfigDoc.AddExample("", apiServerAuditLogExample)
+	AuditLogConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "APIServerConfig",
+			FieldName: "auditLog",
+		},
+	}
+	AuditLogConfigDoc.Fields = make([]encoder.Doc, 3)
+	AuditLogConfigDoc.Fields[0].Name = "maxAge"
+	AuditLogConfigDoc.Fields[0].Type = "int"
+	AuditLogConfigDoc.Fields[0].Note = ""
+	AuditLogConfigDoc.Fields[0].Description = "The maximum number of days to retain the rotated audit log files, `0` disables the age-based removal.\nDefaults to `30`."
+	AuditLogConfigDoc.Fields[0].Comments[encoder.LineComment] = "The maximum number of days to retain the rotated audit log files, `0` disables the age-based removal."
+	AuditLogConfigDoc.Fields[1].Name = "maxBackups"
+	AuditLogConfigDoc.Fields[1].Type = "int"
+	AuditLogConfigDoc.Fields[1].Note = ""
+	AuditLogConfigDoc.Fields[1].Description = "The maximum number of the rotated audit log files to retain, `0` retains all files.\nDefaults to `10`."
+	AuditLogConfigDoc.Fields[1].Comments[encoder.LineComment] = "The maximum number of the rotated audit log files to retain, `0` retains all files."
+	AuditLogConfigDoc.Fields[2].Name = "maxSize"
+	AuditLogConfigDoc.Fields[2].Type = "int"
+	AuditLogConfigDoc.Fields[2].Note = ""
+	AuditLogConfigDoc.Fields[2].Description = "The maximum size in megabytes of the audit log file before it gets rotated.\nDefaults to `100`."
+	AuditLogConfigDoc.Fields[2].Comments[encoder.LineComment] = "The maximum size in megabytes of the audit log file before it gets rotated."
+
+	OIDCConfigDoc.Type = "OIDCConfig"
+	OIDCConfigDoc.Comments[encoder.LineComment] = "OIDCConfig represents the API server OpenID Connect authentication configuration."
+	OIDCConfigDoc.Description = "OIDCConfig represents the API server OpenID Connect authentication configuration."
+
+	OIDCConfigDoc.AddExample("", apiServerOIDCExample)
+	OIDCConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "APIServerConfig",
+			FieldName: "oidc",
+		},
+	}
+	OIDCConfigDoc.Fields = make([]encoder.Doc, 9)
+	OIDCConfigDoc.Fields[0].Name = "issuerURL"
+	OIDCConfigDoc.Fields[0].Type = "string"
+	OIDCConfigDoc.Fields[0].Note = ""
+	OIDCConfigDoc.Fields[0].Description = "The URL of the OpenID issuer, only the `https://` scheme is accepted."
+	OIDCConfigDoc.Fields[0].Comments[encoder.LineComment] = "The URL of the OpenID issuer, only the `https://` scheme is accepted."
+	OIDCConfigDoc.Fields[1].Name = "clientID"
+	OIDCConfigDoc.Fields[1].Type = "string"
+	OIDCConfigDoc.Fields[1].Note = ""
+	OIDCConfigDoc.Fields[1].Description = "The client ID for the OpenID Connect client, all tokens must be issued for this client ID."
+	OIDCConfigDoc.Fields[1].Comments[encoder.LineComment] = "The client ID for the OpenID Connect client, all tokens must be issued for this client ID."
+	OIDCConfigDoc.Fields[2].Name = "usernameClaim"
+	OIDCConfigDoc.Fields[2].Type = "string"
+	OIDCConfigDoc.Fields[2].Note = ""
+	OIDCConfigDoc.Fields[2].Description = "The OpenID claim to use as the user name.\nDefaults to `sub`."
+	OIDCConfigDoc.Fields[2].Comments[encoder.LineComment] = "The OpenID claim to use as the user name."
+	OIDCConfigDoc.Fields[3].Name = "usernamePrefix"
+	OIDCConfigDoc.Fields[3].Type = "string"
+	OIDCConfigDoc.Fields[3].Note = ""
+	OIDCConfigDoc.Fields[3].Description = "The prefix prepended to the username claims to prevent clashes with existing names."
+	OIDCConfigDoc.Fields[3].Comments[encoder.LineComment] = "The prefix prepended to the username claims to prevent clashes with existing names."
+	OIDCConfigDoc.Fields[4].Name = "groupsClaim"
+	OIDCConfigDoc.Fields[4].Type = "string"
+	OIDCConfigDoc.Fields[4].Note = ""
+	OIDCConfigDoc.Fields[4].Description = "The OpenID claim to use as the user's groups."
+	OIDCConfigDoc.Fields[4].Comments[encoder.LineComment] = "The OpenID claim to use as the user's groups."
+	OIDCConfigDoc.Fields[5].Name = "groupsPrefix"
+	OIDCConfigDoc.Fields[5].Type = "string"
+	OIDCConfigDoc.Fields[5].Note = ""
+	OIDCConfigDoc.Fields[5].Description = "The prefix prepended to the group claims to prevent clashes with existing names."
+	OIDCConfigDoc.Fields[5].Comments[encoder.LineComment] = "The prefix prepended to the group claims to prevent clashes with existing names."
+	OIDCConfigDoc.Fields[6].Name = "requiredClaims"
+	OIDCConfigDoc.Fields[6].Type = "map[string]string"
+	OIDCConfigDoc.Fields[6].Note = ""
+	OIDCConfigDoc.Fields[6].Description = "The claims which should be present in the ID token with the matching values."
+	OIDCConfigDoc.Fields[6].Comments[encoder.LineComment] = "The claims which should be present in the ID token with the matching values."
+	OIDCConfigDoc.Fields[7].Name = "signingAlgorithms"
+	OIDCConfigDoc.Fields[7].Type = "[]string"
+	OIDCConfigDoc.Fields[7].Note = ""
+	OIDCConfigDoc.Fields[7].Description = "The list of allowed JOSE asymmetric signing algorithms.\nDefaults to `RS256`."
+	OIDCConfigDoc.Fields[7].Comments[encoder.LineComment] = "The list of allowed JOSE asymmetric signing algorithms."
+	OIDCConfigDoc.Fields[8].Name = "ca"
+	OIDCConfigDoc.Fields[8].Type = "PEMEncodedCertificateAndKey"
+	OIDCConfigDoc.Fields[8].Note = ""
+	OIDCConfigDoc.Fields[8].Description = "The CA certificate bundle to verify the OpenID issuer's serving certificate.\nIf not set, the host's root CA set is used.\nOnly the `crt` part is used."
+	OIDCConfigDoc.Fields[8].Comments[encoder.LineComment] = "The CA certificate bundle to verify the OpenID issuer's serving certificate."
+
+	OIDCConfigDoc.Fields[8].AddExample("", apiServerOIDCCAExample)
 
 	EtcdConfigDoc.Type = "EtcdConfig"
 	EtcdConfigDoc.Comments[encoder.LineComment] = "EtcdConfig represents the etcd configuration options."
@@ -1449,6 +1794,88 @@ func init() {
 	MachineDiskDoc.Fields[1].Description = "A list of partitions to create on the disk."
 	MachineDiskDoc.Fields[1].Comments[encoder.LineComment] = "A list of partitions to create on the disk."
 
+	VolumeGroupDoc.Type = "VolumeGroup"
+	VolumeGroupDoc.Comments[encoder.LineComment] = "VolumeGroup represents an LVM volume group spanning one or more disks."
+	VolumeGroupDoc.Description = "VolumeGroup represents an LVM volume group spanning one or more disks."
+
+	VolumeGroupDoc.AddExample("MachineVolumeGroups list example.", machineVolumeGroupsExample)
+	VolumeGroupDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "volumeGroups",
+		},
+	}
+	VolumeGroupDoc.Fields = make([]encoder.Doc, 3)
+	VolumeGroupDoc.Fields[0].Name = "name"
+	VolumeGroupDoc.Fields[0].Type = "string"
+	VolumeGroupDoc.Fields[0].Note = ""
+	VolumeGroupDoc.Fields[0].Description = "The name of the volume group."
+	VolumeGroupDoc.Fields[0].Comments[encoder.LineComment] = "The name of the volume group."
+	VolumeGroupDoc.Fields[1].Name = "physicalVolumes"
+	VolumeGroupDoc.Fields[1].Type = "[]string"
+	VolumeGroupDoc.Fields[1].Note = ""
+	VolumeGroupDoc.Fields[1].Description = "The list of devices to use as LVM physical volumes.\nDevices which are not LVM physical volumes yet are initialized, devices with existing data are rejected by LVM.\nDevices which are added to the list later are used to extend the volume group."
+	VolumeGroupDoc.Fields[1].Comments[encoder.LineComment] = "The list of devices to use as LVM physical volumes."
+
+	VolumeGroupDoc.Fields[1].AddExample("", []string{"/dev/sdb", "/dev/sdc"})
+	VolumeGroupDoc.Fields[2].Name = "logicalVolumes"
+	VolumeGroupDoc.Fields[2].Type = "[]LogicalVolume"
+	VolumeGroupDoc.Fields[2].Note = ""
+	VolumeGroupDoc.Fields[2].Description = "A list of logical volumes to create in the volume group."
+	VolumeGroupDoc.Fields[2].Comments[encoder.LineComment] = "A list of logical volumes to create in the volume group."
+
+	LogicalVolumeDoc.Type = "LogicalVolume"
+	LogicalVolumeDoc.Comments[encoder.LineComment] = "LogicalVolume represents an LVM logical volume."
+	LogicalVolumeDoc.Description = "LogicalVolume represents an LVM logical volume."
+	LogicalVolumeDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "VolumeGroup",
+			FieldName: "logicalVolumes",
+		},
+	}
+	LogicalVolumeDoc.Fields = make([]encoder.Doc, 6)
+	LogicalVolumeDoc.Fields[0].Name = "name"
+	LogicalVolumeDoc.Fields[0].Type = "string"
+	LogicalVolumeDoc.Fields[0].Note = ""
+	LogicalVolumeDoc.Fields[0].Description = "The name of the logical volume."
+	LogicalVolumeDoc.Fields[0].Comments[encoder.LineComment] = "The name of the logical volume."
+	LogicalVolumeDoc.Fields[1].Name = "type"
+	LogicalVolumeDoc.Fields[1].Type = "string"
+	LogicalVolumeDoc.Fields[1].Note = ""
+	LogicalVolumeDoc.Fields[1].Description = "The type of the logical volume.\nRAID logical volumes are backed by the kernel MD RAID drivers, `raid1` requires at least two physical volumes,\n`raid10` requires at least four physical volumes.\nLVM RAID is used instead of standalone MD RAID arrays (`mdadm`): it provides the same RAID levels via the same kernel drivers,\nwhile the RAID metadata is managed by LVM, so that the arrays are assembled on boot along with the volume group,\nand the logical volumes can share the physical volumes with other (e.g. linear) logical volumes."
+	LogicalVolumeDoc.Fields[1].Comments[encoder.LineComment] = "The type of the logical volume."
+	LogicalVolumeDoc.Fields[1].Values = []string{
+		"linear",
+		"raid1",
+		"raid10",
+	}
+	LogicalVolumeDoc.Fields[2].Name = "mirrors"
+	LogicalVolumeDoc.Fields[2].Type = "int"
+	LogicalVolumeDoc.Fields[2].Note = ""
+	LogicalVolumeDoc.Fields[2].Description = "The number of additional copies of the data for `raid1` and `raid10` logical volumes (defaults to 1)."
+	LogicalVolumeDoc.Fields[2].Comments[encoder.LineComment] = "The number of additional copies of the data for `raid1` and `raid10` logical volumes (defaults to 1)."
+	LogicalVolumeDoc.Fields[3].Name = "size"
+	LogicalVolumeDoc.Fields[3].Type = "DiskSize"
+	LogicalVolumeDoc.Fields[3].Note = ""
+	LogicalVolumeDoc.Fields[3].Description = "The size of the logical volume: either bytes or human readable representation. If `size:` is omitted, the logical volume occupies all remaining free space in the volume group."
+	LogicalVolumeDoc.Fields[3].Comments[encoder.LineComment] = "The size of the logical volume: either bytes or human readable representation. If `size:` is omitted, the logical volume occupies all remaining free space in the volume group."
+
+	LogicalVolumeDoc.Fields[3].AddExample("Human readable representation.", DiskSize(100000000000))
+	LogicalVolumeDoc.Fields[4].Name = "mountpoint"
+	LogicalVolumeDoc.Fields[4].Type = "string"
+	LogicalVolumeDoc.Fields[4].Note = ""
+	LogicalVolumeDoc.Fields[4].Description = "Where to mount the logical volume."
+	LogicalVolumeDoc.Fields[4].Comments[encoder.LineComment] = "Where to mount the logical volume."
+	LogicalVolumeDoc.Fields[5].Name = "filesystem"
+	LogicalVolumeDoc.Fields[5].Type = "string"
+	LogicalVolumeDoc.Fields[5].Note = ""
+	LogicalVolumeDoc.Fields[5].Description = "The filesystem to create on the logical volume (defaults to `xfs`)."
+	LogicalVolumeDoc.Fields[5].Comments[encoder.LineComment] = "The filesystem to create on the logical volume (defaults to `xfs`)."
+	LogicalVolumeDoc.Fields[5].Values = []string{
+		"xfs",
+		"ext4",
+	}
+
 	DiskPartitionDoc.Type = "DiskPartition"
 	DiskPartitionDoc.Comments[encoder.LineComment] = "DiskPartition represents the options for a disk partition."
 	DiskPartitionDoc.Description = "DiskPartition represents the options for a disk partition."
@@ -1458,7 +1885,7 @@ func init() {
 			FieldName: "partitions",
 		},
 	}
-	DiskPartitionDoc.Fields = make([]encoder.Doc, 2)
+	DiskPartitionDoc.Fields = make([]encoder.Doc, 4)
 	DiskPartitionDoc.Fields[0].Name = "size"
 	DiskPartitionDoc.Fields[0].Type = "DiskSize"
 	DiskPartitionDoc.Fields[0].Note = ""
@@ -1473,6 +1900,22 @@ func init() {
 	DiskPartitionDoc.Fields[1].Note = ""
 	DiskPartitionDoc.Fields[1].Description = "Where to mount the partition."
 	DiskPartitionDoc.Fields[1].Comments[encoder.LineComment] = "Where to mount the partition."
+	DiskPartitionDoc.Fields[2].Name = "filesystem"
+	DiskPartitionDoc.Fields[2].Type = "string"
+	DiskPartitionDoc.Fields[2].Note = ""
+	DiskPartitionDoc.Fields[2].Description = "The filesystem to create on the partition (defaults to `xfs`)."
+	DiskPartitionDoc.Fields[2].Comments[encoder.LineComment] = "The filesystem to create on the partition (defaults to `xfs`)."
+	DiskPartitionDoc.Fields[2].Values = []string{
+		"xfs",
+		"ext4",
+	}
+	DiskPartitionDoc.Fields[3].Name = "mountOptions"
+	DiskPartitionDoc.Fields[3].Type = "[]string"
+	DiskPartitionDoc.Fields[3].Note = ""
+	DiskPartitionDoc.Fields[3].Description = "Mount options for the partition (defaults to `noatime`).\nGeneric options (e.g. `noatime`, `nodev`) are applied as mount flags,\nfilesystem-specific options (e.g. `prjquota`) are passed to the filesystem."
+	DiskPartitionDoc.Fields[3].Comments[encoder.LineComment] = "Mount options for the partition (defaults to `noatime`)."
+
+	DiskPartitionDoc.Fields[3].AddExample("", []string{"noatime", "prjquota"})
 
 	EncryptionConfigDoc.Type = "EncryptionConfig"
 	EncryptionConfigDoc.Comments[encoder.LineComment] = "EncryptionConfig represents partition encryption settings."
@@ -1662,7 +2105,7 @@ func init() {
 			FieldName: "interfaces",
 		},
 	}
-	DeviceDoc.Fields = make([]encoder.Doc, 15)
+	DeviceDoc.Fields = make([]encoder.Doc, 16)
 	DeviceDoc.Fields[0].Name = "interface"
 	DeviceDoc.Fields[0].Type = "string"
 	DeviceDoc.Fields[0].Note = ""
@@ -1757,6 +2200,13 @@ func init() {
 	DeviceDoc.Fields[14].Comments[encoder.LineComment] = "Virtual (shared) IP address configuration."
 
 	DeviceDoc.Fields[14].AddExample("layer2 vip example", networkConfigVIPLayer2Example)
+	DeviceDoc.Fields[15].Name = "ipv6"
+	DeviceDoc.Fields[15].Type = "DeviceIPv6Config"
+	DeviceDoc.Fields[15].Note = ""
+	DeviceDoc.Fields[15].Description = "IPv6 settings of the interface.\nSettings are applied as soon as the interface appears, so interface-specific\n`machine.sysctls` are not required."
+	DeviceDoc.Fields[15].Comments[encoder.LineComment] = "IPv6 settings of the interface."
+
+	DeviceDoc.Fields[15].AddExample("", networkConfigIPv6Example)
 
 	DHCPOptionsDoc.Type = "DHCPOptions"
 	DHCPOptionsDoc.Comments[encoder.LineComment] = "DHCPOptions contains options for configuring the DHCP settings for a given interface."
@@ -1773,7 +2223,7 @@ func init() {
 			FieldName: "dhcpOptions",
 		},
 	}
-	DHCPOptionsDoc.Fields = make([]encoder.Doc, 4)
+	DHCPOptionsDoc.Fields = make([]encoder.Doc, 9)
 	DHCPOptionsDoc.Fields[0].Name = "routeMetric"
 	DHCPOptionsDoc.Fields[0].Type = "uint32"
 	DHCPOptionsDoc.Fields[0].Note = ""
@@ -1794,6 +2244,75 @@ func init() {
 	DHCPOptionsDoc.Fields[3].Note = ""
 	DHCPOptionsDoc.Fields[3].Description = "Set client DUID (hex string)."
 	DHCPOptionsDoc.Fields[3].Comments[encoder.LineComment] = "Set client DUID (hex string)."
+	DHCPOptionsDoc.Fields[4].Name = "clientIdentifier"
+	DHCPOptionsDoc.Fields[4].Type = "string"
+	DHCPOptionsDoc.Fields[4].Note = ""
+	DHCPOptionsDoc.Fields[4].Description = "Set DHCPv4 client identifier, option 61 (hex string)."
+	DHCPOptionsDoc.Fields[4].Comments[encoder.LineComment] = "Set DHCPv4 client identifier, option 61 (hex string)."
+	DHCPOptionsDoc.Fields[5].Name = "vendorClass"
+	DHCPOptionsDoc.Fields[5].Type = "string"
+	DHCPOptionsDoc.Fields[5].Note = ""
+	DHCPOptionsDoc.Fields[5].Description = "Set DHCPv4 vendor class identifier, option 60."
+	DHCPOptionsDoc.Fields[5].Comments[encoder.LineComment] = "Set DHCPv4 vendor class identifier, option 60."
+	DHCPOptionsDoc.Fields[6].Name = "userClass"
+	DHCPOptionsDoc.Fields[6].Type = "[]string"
+	DHCPOptionsDoc.Fields[6].Note = ""
+	DHCPOptionsDoc.Fields[6].Description = "Set DHCPv4 user classes, option 77."
+	DHCPOptionsDoc.Fields[6].Comments[encoder.LineComment] = "Set DHCPv4 user classes, option 77."
+	DHCPOptionsDoc.Fields[7].Name = "requestedOptions"
+	DHCPOptionsDoc.Fields[7].Type = "[]int"
+	DHCPOptionsDoc.Fields[7].Note = ""
+	DHCPOptionsDoc.Fields[7].Description = "Additional DHCPv4 options to request from the server (option codes).\n\nOptions which are not consumed by Talos are only logged when they are received (or their values change)."
+	DHCPOptionsDoc.Fields[7].Comments[encoder.LineComment] = "Additional DHCPv4 options to request from the server (option codes)."
+	DHCPOptionsDoc.Fields[8].Name = "sendHostname"
+	DHCPOptionsDoc.Fields[8].Type = "string"
+	DHCPOptionsDoc.Fields[8].Note = ""
+	DHCPOptionsDoc.Fields[8].Description = "Hostname send policy for DHCPv4 requests, option 12.\n\nThe hostname is taken from `.machine.network.hostname`:\n`none` doesn't send the hostname (default), `hostname` sends the short hostname,\n`fqdn` sends the fully qualified domain name.\nHostnames from other sources (DHCP, platform metadata, or the default hostname) are not sent,\nas the hostname might be assigned by the DHCP server itself."
+	DHCPOptionsDoc.Fields[8].Comments[encoder.LineComment] = "Hostname send policy for DHCPv4 requests, option 12."
+	DHCPOptionsDoc.Fields[8].Values = []string{
+		"none",
+		"hostname",
+		"fqdn",
+	}
+
+	DeviceIPv6ConfigDoc.Type = "DeviceIPv6Config"
+	DeviceIPv6ConfigDoc.Comments[encoder.LineComment] = "DeviceIPv6Config contains IPv6 settings of the network interface."
+	DeviceIPv6ConfigDoc.Description = "DeviceIPv6Config contains IPv6 settings of the network interface."
+
+	DeviceIPv6ConfigDoc.AddExample("", networkConfigIPv6Example)
+	DeviceIPv6ConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "ipv6",
+		},
+	}
+	DeviceIPv6ConfigDoc.Fields = make([]encoder.Doc, 3)
+	DeviceIPv6ConfigDoc.Fields[0].Name = "mode"
+	DeviceIPv6ConfigDoc.Fields[0].Type = "string"
+	DeviceIPv6ConfigDoc.Fields[0].Note = ""
+	DeviceIPv6ConfigDoc.Fields[0].Description = "IPv6 addressing mode of the interface.\n\n- `disabled`: IPv6 is disabled on the interface.\n- `slaac`: addresses are configured via stateless address autoconfiguration from the router advertisements.\n- `dhcp6`: addresses are configured via DHCPv6, router advertisements are used for the default route.\n- `static`: only statically configured addresses and routes are used, router advertisements are ignored."
+	DeviceIPv6ConfigDoc.Fields[0].Comments[encoder.LineComment] = "IPv6 addressing mode of the interface."
+	DeviceIPv6ConfigDoc.Fields[0].Values = []string{
+		"disabled",
+		"slaac",
+		"dhcp6",
+		"static",
+	}
+	DeviceIPv6ConfigDoc.Fields[1].Name = "acceptRA"
+	DeviceIPv6ConfigDoc.Fields[1].Type = "bool"
+	DeviceIPv6ConfigDoc.Fields[1].Note = ""
+	DeviceIPv6ConfigDoc.Fields[1].Description = "Accept router advertisements on the interface.\nDefaults to `true` for `slaac` and `dhcp6` modes, and to `false` for the `static` mode."
+	DeviceIPv6ConfigDoc.Fields[1].Comments[encoder.LineComment] = "Accept router advertisements on the interface."
+	DeviceIPv6ConfigDoc.Fields[2].Name = "useTempAddr"
+	DeviceIPv6ConfigDoc.Fields[2].Type = "int"
+	DeviceIPv6ConfigDoc.Fields[2].Note = ""
+	DeviceIPv6ConfigDoc.Fields[2].Description = "Privacy extensions for the SLAAC addresses (RFC 4941), `use_tempaddr` kernel setting.\n\n- `0`: disabled.\n- `1`: temporary addresses are generated, but public addresses are preferred.\n- `2`: temporary addresses are generated and preferred.\n\nDefaults to `0`."
+	DeviceIPv6ConfigDoc.Fields[2].Comments[encoder.LineComment] = "Privacy extensions for the SLAAC addresses (RFC 4941), `use_tempaddr` kernel setting."
+	DeviceIPv6ConfigDoc.Fields[2].Values = []string{
+		"0",
+		"1",
+		"2",
+	}
 
 	DeviceWireguardConfigDoc.Type = "DeviceWireguardConfig"
 	DeviceWireguardConfigDoc.Comments[encoder.LineComment] = "DeviceWireguardConfig contains settings for configuring Wireguard network interface."
@@ -1876,7 +2395,7 @@ func init() {
 			FieldName: "vip",
 		},
 	}
-	DeviceVIPConfigDoc.Fields = make([]encoder.Doc, 3)
+	DeviceVIPConfigDoc.Fields = make([]encoder.Doc, 4)
 	DeviceVIPConfigDoc.Fields[0].Name = "ip"
 	DeviceVIPConfigDoc.Fields[0].Type = "string"
 	DeviceVIPConfigDoc.Fields[0].Note = ""
@@ -1892,6 +2411,13 @@ func init() {
 	DeviceVIPConfigDoc.Fields[2].Note = ""
 	DeviceVIPConfigDoc.Fields[2].Description = "Specifies the Hetzner Cloud API settings to assign VIP to the node."
 	DeviceVIPConfigDoc.Fields[2].Comments[encoder.LineComment] = "Specifies the Hetzner Cloud API settings to assign VIP to the node."
+	DeviceVIPConfigDoc.Fields[3].Name = "bgp"
+	DeviceVIPConfigDoc.Fields[3].Type = "VIPBGPConfig"
+	DeviceVIPConfigDoc.Fields[3].Note = ""
+	DeviceVIPConfigDoc.Fields[3].Description = "Specifies the BGP settings to announce VIP to the routers.\nThe node holding the VIP announces it as a host route (/32 or /128) to the configured peers,\nand withdraws it once it loses the VIP."
+	DeviceVIPConfigDoc.Fields[3].Comments[encoder.LineComment] = "Specifies the BGP settings to announce VIP to the routers."
+
+	DeviceVIPConfigDoc.Fields[3].AddExample("", networkConfigVIPBGPExample)
 
 	VIPEquinixMetalConfigDoc.Type = "VIPEquinixMetalConfig"
 	VIPEquinixMetalConfigDoc.Comments[encoder.LineComment] = "VIPEquinixMetalConfig contains settings for Equinix Metal VIP management."
@@ -1925,6 +2451,64 @@ func init() {
 	VIPHCloudConfigDoc.Fields[0].Description = "Specifies the Hetzner Cloud API Token."
 	VIPHCloudConfigDoc.Fields[0].Comments[encoder.LineComment] = "Specifies the Hetzner Cloud API Token."
 
+	VIPBGPConfigDoc.Type = "VIPBGPConfig"
+	VIPBGPConfigDoc.Comments[encoder.LineComment] = "VIPBGPConfig contains settings for announcing VIP over BGP."
+	VIPBGPConfigDoc.Description = "VIPBGPConfig contains settings for announcing VIP over BGP."
+
+	VIPBGPConfigDoc.AddExample("", networkConfigVIPBGPExample)
+	VIPBGPConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "DeviceVIPConfig",
+			FieldName: "bgp",
+		},
+	}
+	VIPBGPConfigDoc.Fields = make([]encoder.Doc, 4)
+	VIPBGPConfigDoc.Fields[0].Name = "localASN"
+	VIPBGPConfigDoc.Fields[0].Type = "uint32"
+	VIPBGPConfigDoc.Fields[0].Note = ""
+	VIPBGPConfigDoc.Fields[0].Description = "Specifies the local autonomous system number."
+	VIPBGPConfigDoc.Fields[0].Comments[encoder.LineComment] = "Specifies the local autonomous system number."
+	VIPBGPConfigDoc.Fields[1].Name = "routerID"
+	VIPBGPConfigDoc.Fields[1].Type = "string"
+	VIPBGPConfigDoc.Fields[1].Note = ""
+	VIPBGPConfigDoc.Fields[1].Description = "Specifies the BGP router ID (IPv4 address).\nDefaults to the local IPv4 address of each BGP session."
+	VIPBGPConfigDoc.Fields[1].Comments[encoder.LineComment] = "Specifies the BGP router ID (IPv4 address)."
+	VIPBGPConfigDoc.Fields[2].Name = "holdTime"
+	VIPBGPConfigDoc.Fields[2].Type = "Duration"
+	VIPBGPConfigDoc.Fields[2].Note = ""
+	VIPBGPConfigDoc.Fields[2].Description = "Specifies the BGP hold time.\nDefaults to 90s."
+	VIPBGPConfigDoc.Fields[2].Comments[encoder.LineComment] = "Specifies the BGP hold time."
+	VIPBGPConfigDoc.Fields[3].Name = "peers"
+	VIPBGPConfigDoc.Fields[3].Type = "[]VIPBGPPeer"
+	VIPBGPConfigDoc.Fields[3].Note = ""
+	VIPBGPConfigDoc.Fields[3].Description = "Specifies the list of BGP peers."
+	VIPBGPConfigDoc.Fields[3].Comments[encoder.LineComment] = "Specifies the list of BGP peers."
+
+	VIPBGPPeerDoc.Type = "VIPBGPPeer"
+	VIPBGPPeerDoc.Comments[encoder.LineComment] = "VIPBGPPeer describes a BGP peer."
+	VIPBGPPeerDoc.Description = "VIPBGPPeer describes a BGP peer."
+	VIPBGPPeerDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "VIPBGPConfig",
+			FieldName: "peers",
+		},
+	}
+	VIPBGPPeerDoc.Fields = make([]encoder.Doc, 2)
+	VIPBGPPeerDoc.Fields[0].Name = "address"
+	VIPBGPPeerDoc.Fields[0].Type = "string"
+	VIPBGPPeerDoc.Fields[0].Note = ""
+	VIPBGPPeerDoc.Fields[0].Description = "Specifies the peer address, port defaults to 179.\nThe peer address should be of the same address family as the VIP."
+	VIPBGPPeerDoc.Fields[0].Comments[encoder.LineComment] = "Specifies the peer address, port defaults to 179."
+
+	VIPBGPPeerDoc.Fields[0].AddExample("", "10.5.0.1")
+
+	VIPBGPPeerDoc.Fields[0].AddExample("", "[2001:db8::1]:1179")
+	VIPBGPPeerDoc.Fields[1].Name = "asn"
+	VIPBGPPeerDoc.Fields[1].Type = "uint32"
+	VIPBGPPeerDoc.Fields[1].Note = ""
+	VIPBGPPeerDoc.Fields[1].Description = "Specifies the peer autonomous system number."
+	VIPBGPPeerDoc.Fields[1].Comments[encoder.LineComment] = "Specifies the peer autonomous system number."
+
 	BondDoc.Type = "Bond"
 	BondDoc.Comments[encoder.LineComment] = "Bond contains the various options for configuring a bonded interface."
 	BondDoc.Description = "Bond contains the various options for configuring a bonded interface."
@@ -2441,7 +3025,7 @@ func init() {
 	ClusterInlineManifestDoc.Type = "ClusterInlineManifest"
 	ClusterInlineManifestDoc.Comments[encoder.LineComment] = "ClusterInlineManifest struct describes inline bootstrap manifests for the user."
 	ClusterInlineManifestDoc.Description = "ClusterInlineManifest struct describes inline bootstrap manifests for the user."
-	ClusterInlineManifestDoc.Fields = make([]encoder.Doc, 2)
+	ClusterInlineManifestDoc.Fields = make([]encoder.Doc, 3)
 	ClusterInlineManifestDoc.Fields[0].Name = "name"
 	ClusterInlineManifestDoc.Fields[0].Type = "string"
 	ClusterInlineManifestDoc.Fields[0].Note = ""
@@ -2456,6 +3040,29 @@ func init() {
 	ClusterInlineManifestDoc.Fields[1].Comments[encoder.LineComment] = "Manifest contents as a string."
 
 	ClusterInlineManifestDoc.Fields[1].AddExample("", "/etc/kubernetes/auth")
+	ClusterInlineManifestDoc.Fields[2].Name = "prune"
+	ClusterInlineManifestDoc.Fields[2].Type = "bool"
+	ClusterInlineManifestDoc.Fields[2].Note = ""
+	ClusterInlineManifestDoc.Fields[2].Description = "Prune the objects removed from the manifest (or when the manifest is removed).\n\nObjects applied from the manifest are labeled with the Talos inventory label,\nand objects which are no longer present in any manifest are removed from the cluster by `talosctl upgrade-k8s`.\nDefaults to `false`."
+	ClusterInlineManifestDoc.Fields[2].Comments[encoder.LineComment] = "Prune the objects removed from the manifest (or when the manifest is removed)."
+
+	NetworkLLDPDoc.Type = "NetworkLLDP"
+	NetworkLLDPDoc.Comments[encoder.LineComment] = "NetworkLLDP struct describes LLDP neighbor discovery configuration."
+	NetworkLLDPDoc.Description = "NetworkLLDP struct describes LLDP neighbor discovery configuration."
+
+	NetworkLLDPDoc.AddExample("", networkLLDPExample)
+	NetworkLLDPDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "lldp",
+		},
+	}
+	NetworkLLDPDoc.Fields = make([]encoder.Doc, 1)
+	NetworkLLDPDoc.Fields[0].Name = "transmit"
+	NetworkLLDPDoc.Fields[0].Type = "bool"
+	NetworkLLDPDoc.Fields[0].Note = ""
+	NetworkLLDPDoc.Fields[0].Description = "Transmit LLDP frames on physical links advertising the node hostname and the link name.\nDefaults to `false`."
+	NetworkLLDPDoc.Fields[0].Comments[encoder.LineComment] = "Transmit LLDP frames on physical links advertising the node hostname and the link name."
 
 	NetworkKubeSpanDoc.Type = "NetworkKubeSpan"
 	NetworkKubeSpanDoc.Comments[encoder.LineComment] = "NetworkKubeSpan struct describes KubeSpan configuration."
@@ -2490,6 +3097,181 @@ func init() {
 	NetworkKubeSpanDoc.Fields[3].Description = "KubeSpan link MTU size.\nDefault value is 1420."
 	NetworkKubeSpanDoc.Fields[3].Comments[encoder.LineComment] = "KubeSpan link MTU size."
 
+	NetworkProbeDoc.Type = "NetworkProbe"
+	NetworkProbeDoc.Comments[encoder.LineComment] = "NetworkProbe struct describes a network reachability probe."
+	NetworkProbeDoc.Description = "NetworkProbe struct describes a network reachability probe."
+
+	NetworkProbeDoc.AddExample("", networkProbesExample)
+	NetworkProbeDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "probes",
+		},
+	}
+	NetworkProbeDoc.Fields = make([]encoder.Doc, 6)
+	NetworkProbeDoc.Fields[0].Name = "name"
+	NetworkProbeDoc.Fields[0].Type = "string"
+	NetworkProbeDoc.Fields[0].Note = ""
+	NetworkProbeDoc.Fields[0].Description = "Name of the probe, used as the ID of the ProbeStatus resource."
+	NetworkProbeDoc.Fields[0].Comments[encoder.LineComment] = "Name of the probe, used as the ID of the ProbeStatus resource."
+	NetworkProbeDoc.Fields[1].Name = "interval"
+	NetworkProbeDoc.Fields[1].Type = "Duration"
+	NetworkProbeDoc.Fields[1].Note = ""
+	NetworkProbeDoc.Fields[1].Description = "Interval between the probe attempts.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).\nDefaults to `5s`."
+	NetworkProbeDoc.Fields[1].Comments[encoder.LineComment] = "Interval between the probe attempts."
+	NetworkProbeDoc.Fields[2].Name = "failureThreshold"
+	NetworkProbeDoc.Fields[2].Type = "int"
+	NetworkProbeDoc.Fields[2].Note = ""
+	NetworkProbeDoc.Fields[2].Description = "Number of consecutive failures for the probe to be considered failed after having succeeded.\nDefaults to 3."
+	NetworkProbeDoc.Fields[2].Comments[encoder.LineComment] = "Number of consecutive failures for the probe to be considered failed after having succeeded."
+	NetworkProbeDoc.Fields[3].Name = "tcp"
+	NetworkProbeDoc.Fields[3].Type = "NetworkProbeTCP"
+	NetworkProbeDoc.Fields[3].Note = ""
+	NetworkProbeDoc.Fields[3].Description = "TCP probe: succeeds if TCP connection to the endpoint can be established."
+	NetworkProbeDoc.Fields[3].Comments[encoder.LineComment] = "TCP probe: succeeds if TCP connection to the endpoint can be established."
+
+	NetworkProbeDoc.Fields[3].AddExample("", networkProbeTCPExample)
+	NetworkProbeDoc.Fields[4].Name = "icmp"
+	NetworkProbeDoc.Fields[4].Type = "NetworkProbeICMP"
+	NetworkProbeDoc.Fields[4].Note = ""
+	NetworkProbeDoc.Fields[4].Description = "ICMP probe: succeeds if the host replies to the ICMP echo request."
+	NetworkProbeDoc.Fields[4].Comments[encoder.LineComment] = "ICMP probe: succeeds if the host replies to the ICMP echo request."
+
+	NetworkProbeDoc.Fields[4].AddExample("", networkProbeICMPExample)
+	NetworkProbeDoc.Fields[5].Name = "http"
+	NetworkProbeDoc.Fields[5].Type = "NetworkProbeHTTP"
+	NetworkProbeDoc.Fields[5].Note = ""
+	NetworkProbeDoc.Fields[5].Description = "HTTP probe: succeeds if HTTP GET request returns a non-error (2xx or 3xx) status code."
+	NetworkProbeDoc.Fields[5].Comments[encoder.LineComment] = "HTTP probe: succeeds if HTTP GET request returns a non-error (2xx or 3xx) status code."
+
+	NetworkProbeDoc.Fields[5].AddExample("", networkProbeHTTPExample)
+
+	NetworkProbeTCPDoc.Type = "NetworkProbeTCP"
+	NetworkProbeTCPDoc.Comments[encoder.LineComment] = "NetworkProbeTCP struct describes a TCP connect probe."
+	NetworkProbeTCPDoc.Description = "NetworkProbeTCP struct describes a TCP connect probe."
+
+	NetworkProbeTCPDoc.AddExample("", networkProbeTCPExample)
+	NetworkProbeTCPDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkProbe",
+			FieldName: "tcp",
+		},
+	}
+	NetworkProbeTCPDoc.Fields = make([]encoder.Doc, 2)
+	NetworkProbeTCPDoc.Fields[0].Name = "endpoint"
+	NetworkProbeTCPDoc.Fields[0].Type = "string"
+	NetworkProbeTCPDoc.Fields[0].Note = ""
+	NetworkProbeTCPDoc.Fields[0].Description = "Endpoint to connect to, in the `host:port` format."
+	NetworkProbeTCPDoc.Fields[0].Comments[encoder.LineComment] = "Endpoint to connect to, in the `host:port` format."
+	NetworkProbeTCPDoc.Fields[1].Name = "timeout"
+	NetworkProbeTCPDoc.Fields[1].Type = "Duration"
+	NetworkProbeTCPDoc.Fields[1].Note = ""
+	NetworkProbeTCPDoc.Fields[1].Description = "Timeout for a single probe attempt.\nDefaults to `5s`."
+	NetworkProbeTCPDoc.Fields[1].Comments[encoder.LineComment] = "Timeout for a single probe attempt."
+
+	NetworkProbeICMPDoc.Type = "NetworkProbeICMP"
+	NetworkProbeICMPDoc.Comments[encoder.LineComment] = "NetworkProbeICMP struct describes an ICMP echo probe."
+	NetworkProbeICMPDoc.Description = "NetworkProbeICMP struct describes an ICMP echo probe."
+
+	NetworkProbeICMPDoc.AddExample("", networkProbeICMPExample)
+	NetworkProbeICMPDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkProbe",
+			FieldName: "icmp",
+		},
+	}
+	NetworkProbeICMPDoc.Fields = make([]encoder.Doc, 2)
+	NetworkProbeICMPDoc.Fields[0].Name = "host"
+	NetworkProbeICMPDoc.Fields[0].Type = "string"
+	NetworkProbeICMPDoc.Fields[0].Note = ""
+	NetworkProbeICMPDoc.Fields[0].Description = "Host (IP address or hostname) to send the ICMP echo request to."
+	NetworkProbeICMPDoc.Fields[0].Comments[encoder.LineComment] = "Host (IP address or hostname) to send the ICMP echo request to."
+	NetworkProbeICMPDoc.Fields[1].Name = "timeout"
+	NetworkProbeICMPDoc.Fields[1].Type = "Duration"
+	NetworkProbeICMPDoc.Fields[1].Note = ""
+	NetworkProbeICMPDoc.Fields[1].Description = "Timeout for a single probe attempt.\nDefaults to `5s`."
+	NetworkProbeICMPDoc.Fields[1].Comments[encoder.LineComment] = "Timeout for a single probe attempt."
+
+	NetworkProbeHTTPDoc.Type = "NetworkProbeHTTP"
+	NetworkProbeHTTPDoc.Comments[encoder.LineComment] = "NetworkProbeHTTP struct describes an HTTP GET probe."
+	NetworkProbeHTTPDoc.Description = "NetworkProbeHTTP struct describes an HTTP GET probe."
+
+	NetworkProbeHTTPDoc.AddExample("", networkProbeHTTPExample)
+	NetworkProbeHTTPDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkProbe",
+			FieldName: "http",
+		},
+	}
+	NetworkProbeHTTPDoc.Fields = make([]encoder.Doc, 2)
+	NetworkProbeHTTPDoc.Fields[0].Name = "url"
+	NetworkProbeHTTPDoc.Fields[0].Type = "string"
+	NetworkProbeHTTPDoc.Fields[0].Note = ""
+	NetworkProbeHTTPDoc.Fields[0].Description = "URL to send the HTTP GET request to."
+	NetworkProbeHTTPDoc.Fields[0].Comments[encoder.LineComment] = "URL to send the HTTP GET request to."
+	NetworkProbeHTTPDoc.Fields[1].Name = "timeout"
+	NetworkProbeHTTPDoc.Fields[1].Type = "Duration"
+	NetworkProbeHTTPDoc.Fields[1].Note = ""
+	NetworkProbeHTTPDoc.Fields[1].Description = "Timeout for a single probe attempt.\nDefaults to `5s`."
+	NetworkProbeHTTPDoc.Fields[1].Comments[encoder.LineComment] = "Timeout for a single probe attempt."
+
+	NetworkRuleDoc.Type = "NetworkRule"
+	NetworkRuleDoc.Comments[encoder.LineComment] = "NetworkRule struct describes a routing policy rule."
+	NetworkRuleDoc.Description = "NetworkRule struct describes a routing policy rule."
+
+	NetworkRuleDoc.AddExample("", networkRulesExample)
+	NetworkRuleDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "rules",
+		},
+	}
+	NetworkRuleDoc.Fields = make([]encoder.Doc, 8)
+	NetworkRuleDoc.Fields[0].Name = "from"
+	NetworkRuleDoc.Fields[0].Type = "string"
+	NetworkRuleDoc.Fields[0].Note = ""
+	NetworkRuleDoc.Fields[0].Description = "Source prefix to match, in the CIDR notation."
+	NetworkRuleDoc.Fields[0].Comments[encoder.LineComment] = "Source prefix to match, in the CIDR notation."
+
+	NetworkRuleDoc.Fields[0].AddExample("", "10.6.0.0/24")
+	NetworkRuleDoc.Fields[1].Name = "to"
+	NetworkRuleDoc.Fields[1].Type = "string"
+	NetworkRuleDoc.Fields[1].Note = ""
+	NetworkRuleDoc.Fields[1].Description = "Destination prefix to match, in the CIDR notation."
+	NetworkRuleDoc.Fields[1].Comments[encoder.LineComment] = "Destination prefix to match, in the CIDR notation."
+
+	NetworkRuleDoc.Fields[1].AddExample("", "10.7.0.0/16")
+	NetworkRuleDoc.Fields[2].Name = "fwMark"
+	NetworkRuleDoc.Fields[2].Type = "uint32"
+	NetworkRuleDoc.Fields[2].Note = ""
+	NetworkRuleDoc.Fields[2].Description = "Firewall mark to match."
+	NetworkRuleDoc.Fields[2].Comments[encoder.LineComment] = "Firewall mark to match."
+	NetworkRuleDoc.Fields[3].Name = "fwMask"
+	NetworkRuleDoc.Fields[3].Type = "uint32"
+	NetworkRuleDoc.Fields[3].Note = ""
+	NetworkRuleDoc.Fields[3].Description = "Mask for the firewall mark.\nDefaults to matching all bits of the firewall mark."
+	NetworkRuleDoc.Fields[3].Comments[encoder.LineComment] = "Mask for the firewall mark."
+	NetworkRuleDoc.Fields[4].Name = "iif"
+	NetworkRuleDoc.Fields[4].Type = "string"
+	NetworkRuleDoc.Fields[4].Note = ""
+	NetworkRuleDoc.Fields[4].Description = "Name of the incoming interface to match."
+	NetworkRuleDoc.Fields[4].Comments[encoder.LineComment] = "Name of the incoming interface to match."
+	NetworkRuleDoc.Fields[5].Name = "oif"
+	NetworkRuleDoc.Fields[5].Type = "string"
+	NetworkRuleDoc.Fields[5].Note = ""
+	NetworkRuleDoc.Fields[5].Description = "Name of the outgoing interface to match."
+	NetworkRuleDoc.Fields[5].Comments[encoder.LineComment] = "Name of the outgoing interface to match."
+	NetworkRuleDoc.Fields[6].Name = "table"
+	NetworkRuleDoc.Fields[6].Type = "uint32"
+	NetworkRuleDoc.Fields[6].Note = ""
+	NetworkRuleDoc.Fields[6].Description = "Routing table to look up the route in if the rule matches."
+	NetworkRuleDoc.Fields[6].Comments[encoder.LineComment] = "Routing table to look up the route in if the rule matches."
+	NetworkRuleDoc.Fields[7].Name = "priority"
+	NetworkRuleDoc.Fields[7].Type = "uint32"
+	NetworkRuleDoc.Fields[7].Note = ""
+	NetworkRuleDoc.Fields[7].Description = "Rule priority, rules are evaluated in the increasing order of priority.\nShould be unique across the rules, valid range is 1-32765."
+	NetworkRuleDoc.Fields[7].Comments[encoder.LineComment] = "Rule priority, rules are evaluated in the increasing order of priority."
+
 	NetworkDeviceSelectorDoc.Type = "NetworkDeviceSelector"
 	NetworkDeviceSelectorDoc.Comments[encoder.LineComment] = "NetworkDeviceSelector struct describes network device selector."
 	NetworkDeviceSelectorDoc.Description = "NetworkDeviceSelector struct describes network device selector."
@@ -2578,12 +3360,22 @@ func init() {
 			FieldName: "kubernetes",
 		},
 	}
-	RegistryKubernetesConfigDoc.Fields = make([]encoder.Doc, 1)
+	RegistryKubernetesConfigDoc.Fields = make([]encoder.Doc, 3)
 	RegistryKubernetesConfigDoc.Fields[0].Name = "disabled"
 	RegistryKubernetesConfigDoc.Fields[0].Type = "bool"
 	RegistryKubernetesConfigDoc.Fields[0].Note = ""
 	RegistryKubernetesConfigDoc.Fields[0].Description = "Disable Kubernetes discovery registry."
 	RegistryKubernetesConfigDoc.Fields[0].Comments[encoder.LineComment] = "Disable Kubernetes discovery registry."
+	RegistryKubernetesConfigDoc.Fields[1].Name = "acceptedSecrets"
+	RegistryKubernetesConfigDoc.Fields[1].Type = "[]string"
+	RegistryKubernetesConfigDoc.Fields[1].Note = ""
+	RegistryKubernetesConfigDoc.Fields[1].Description = "Additional cluster secrets accepted to decrypt the affiliate data stored in the Node annotations.\n\nAffiliate data is always encrypted with `.cluster.secret`.\nListing the old (or the new) secret here allows to roll over `.cluster.secret` without losing discovered members."
+	RegistryKubernetesConfigDoc.Fields[1].Comments[encoder.LineComment] = "Additional cluster secrets accepted to decrypt the affiliate data stored in the Node annotations."
+	RegistryKubernetesConfigDoc.Fields[2].Name = "legacyAnnotations"
+	RegistryKubernetesConfigDoc.Fields[2].Type = "bool"
+	RegistryKubernetesConfigDoc.Fields[2].Note = ""
+	RegistryKubernetesConfigDoc.Fields[2].Description = "Read the plain text affiliate annotations published by Talos versions before 1.3.\n\nWhen enabled, plain text annotations are read from the nodes which don't publish encrypted data yet.\nPlain text annotations are not authenticated, so enable it only while upgrading from Talos versions before 1.3,\nand disable it once all nodes are upgraded.\nDefaults to `false`."
+	RegistryKubernetesConfigDoc.Fields[2].Comments[encoder.LineComment] = "Read the plain text affiliate annotations published by Talos versions before 1.3."
 
 	RegistryServiceConfigDoc.Type = "RegistryServiceConfig"
 	RegistryServiceConfigDoc.Comments[encoder.LineComment] = "RegistryServiceConfig struct configures Kubernetes discovery registry."
@@ -2690,6 +3482,34 @@ func init() {
 	KernelConfigDoc.Fields[0].Description = "Kernel modules to load."
 	KernelConfigDoc.Fields[0].Comments[encoder.LineComment] = "Kernel modules to load."
 
+	ImageGCConfigDoc.Type = "ImageGCConfig"
+	ImageGCConfigDoc.Comments[encoder.LineComment] = "ImageGCConfig struct configures garbage collection of the system containerd namespace images."
+	ImageGCConfigDoc.Description = "ImageGCConfig struct configures garbage collection of the system containerd namespace images."
+
+	ImageGCConfigDoc.AddExample("", machineImageGCExample)
+	ImageGCConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "imageGC",
+		},
+	}
+	ImageGCConfigDoc.Fields = make([]encoder.Doc, 3)
+	ImageGCConfigDoc.Fields[0].Name = "enabled"
+	ImageGCConfigDoc.Fields[0].Type = "bool"
+	ImageGCConfigDoc.Fields[0].Note = ""
+	ImageGCConfigDoc.Fields[0].Description = "Enable pruning of the unreferenced images."
+	ImageGCConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable pruning of the unreferenced images."
+	ImageGCConfigDoc.Fields[1].Name = "interval"
+	ImageGCConfigDoc.Fields[1].Type = "Duration"
+	ImageGCConfigDoc.Fields[1].Note = ""
+	ImageGCConfigDoc.Fields[1].Description = "Interval between the garbage collection runs.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	ImageGCConfigDoc.Fields[1].Comments[encoder.LineComment] = "Interval between the garbage collection runs."
+	ImageGCConfigDoc.Fields[2].Name = "minAge"
+	ImageGCConfigDoc.Fields[2].Type = "Duration"
+	ImageGCConfigDoc.Fields[2].Note = ""
+	ImageGCConfigDoc.Fields[2].Description = "Minimum age of the unreferenced image before it is pruned.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	ImageGCConfigDoc.Fields[2].Comments[encoder.LineComment] = "Minimum age of the unreferenced image before it is pruned."
+
 	KernelModuleConfigDoc.Type = "KernelModuleConfig"
 	KernelModuleConfigDoc.Comments[encoder.LineComment] = "KernelModuleConfig struct configures Linux kernel modules to load."
 	KernelModuleConfigDoc.Description = "KernelModuleConfig struct configures Linux kernel modules to load."
@@ -2800,6 +3620,10 @@ func (_ AdmissionPluginConfig) Doc() *encoder.Doc {
 	return &AdmissionPluginConfigDoc
 }
 
+func (_ KMSProviderConfig) Doc() *encoder.Doc {
+	return &KMSProviderConfigDoc
+}
+
 func (_ ControllerManagerConfig) Doc() *encoder.Doc {
 	return &ControllerManagerConfigDoc
 }
@@ -2812,6 +3636,18 @@ func (_ SchedulerConfig) Doc() *encoder.Doc {
 	return &SchedulerConfigDoc
 }
 
+func (_ ResourcesConfig) Doc() *encoder.Doc {
+	return &ResourcesConfigDoc
+}
+
+func (_ AuditLogConfig) Doc() *encoder.Doc {
+	return &AuditLogConfigDoc
+}
+
+func (_ OIDCConfig) Doc() *encoder.Doc {
+	return &OIDCConfigDoc
+}
+
 func (_ EtcdConfig) Doc() *encoder.Doc {
 	return &EtcdConfigDoc
 }
@@ -2836,6 +3672,14 @@ func (_ MachineDisk) Doc() *encoder.Doc {
 	return &MachineDiskDoc
 }
 
+func (_ VolumeGroup) Doc() *encoder.Doc {
+	return &VolumeGroupDoc
+}
+
+func (_ LogicalVolume) Doc() *encoder.Doc {
+	return &LogicalVolumeDoc
+}
+
 func (_ DiskPartition) Doc() *encoder.Doc {
 	return &DiskPartitionDoc
 }
@@ -2872,6 +3716,10 @@ func (_ DHCPOptions) Doc() *encoder.Doc {
 	return &DHCPOptionsDoc
 }
 
+func (_ DeviceIPv6Config) Doc() *encoder.Doc {
+	return &DeviceIPv6ConfigDoc
+}
+
 func (_ DeviceWireguardConfig) Doc() *encoder.Doc {
 	return &DeviceWireguardConfigDoc
 }
@@ -2892,6 +3740,14 @@ func (_ VIPHCloudConfig) Doc() *encoder.Doc {
 	return &VIPHCloudConfigDoc
 }
 
+func (_ VIPBGPConfig) Doc() *encoder.Doc {
+	return &VIPBGPConfigDoc
+}
+
+func (_ VIPBGPPeer) Doc() *encoder.Doc {
+	return &VIPBGPPeerDoc
+}
+
 func (_ Bond) Doc() *encoder.Doc {
 	return &BondDoc
 }
@@ -2948,10 +3804,34 @@ func (_ ClusterInlineManifest) Doc() *encoder.Doc {
 	return &ClusterInlineManifestDoc
 }
 
+func (_ NetworkLLDP) Doc() *encoder.Doc {
+	return &NetworkLLDPDoc
+}
+
 func (_ NetworkKubeSpan) Doc() *encoder.Doc {
 	return &NetworkKubeSpanDoc
 }
 
+func (_ NetworkProbe) Doc() *encoder.Doc {
+	return &NetworkProbeDoc
+}
+
+func (_ NetworkProbeTCP) Doc() *encoder.Doc {
+	return &NetworkProbeTCPDoc
+}
+
+func (_ NetworkProbeICMP) Doc() *encoder.Doc {
+	return &NetworkProbeICMPDoc
+}
+
+func (_ NetworkProbeHTTP) Doc() *encoder.Doc {
+	return &NetworkProbeHTTPDoc
+}
+
+func (_ NetworkRule) Doc() *encoder.Doc {
+	return &NetworkRuleDoc
+}
+
 func (_ NetworkDeviceSelector) Doc() *encoder.Doc {
 	return &NetworkDeviceSelectorDoc
 }
@@ -2988,6 +3868,10 @@ func (_ KernelConfig) Doc() *encoder.Doc {
 	return &KernelConfigDoc
 }
 
+func (_ ImageGCConfig) Doc() *encoder.Doc {
+	return &ImageGCConfigDoc
+}
+
 func (_ KernelModuleConfig) Doc() *encoder.Doc {
 	return &KernelModuleConfigDoc
 }
@@ -3020,15 +3904,21 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&ControlPlaneConfigDoc,
 			&APIServerConfigDoc,
 			&AdmissionPluginConfigDoc,
+			&KMSProviderConfigDoc,
 			&ControllerManagerConfigDoc,
 			&ProxyConfigDoc,
 			&SchedulerConfigDoc,
+			&ResourcesConfigDoc,
+			&AuditLogConfigDoc,
+			&OIDCConfigDoc,
 			&EtcdConfigDoc,
 			&ClusterNetworkConfigDoc,
 			&CNIConfigDoc,
 			&ExternalCloudProviderConfigDoc,
 			&AdminKubeconfigConfigDoc,
 			&MachineDiskDoc,
+			&VolumeGroupDoc,
+			&LogicalVolumeDoc,
 			&DiskPartitionDoc,
 			&EncryptionConfigDoc,
 			&EncryptionKeyDoc,
@@ -3038,11 +3928,14 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&ExtraHostDoc,
 			&DeviceDoc,
 			&DHCPOptionsDoc,
+			&DeviceIPv6ConfigDoc,
 			&DeviceWireguardConfigDoc,
 			&DeviceWireguardPeerDoc,
 			&DeviceVIPConfigDoc,
 			&VIPEquinixMetalConfigDoc,
 			&VIPHCloudConfigDoc,
+			&VIPBGPConfigDoc,
+			&VIPBGPPeerDoc,
 			&BondDoc,
 			&STPDoc,
 			&BridgeDoc,
@@ -3057,7 +3950,13 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&KubernetesTalosAPIAccessConfigDoc,
 			&VolumeMountConfigDoc,
 			&ClusterInlineManifestDoc,
+			&NetworkLLDPDoc,
 			&NetworkKubeSpanDoc,
+			&NetworkProbeDoc,
+			&NetworkProbeTCPDoc,
+			&NetworkProbeICMPDoc,
+			&NetworkProbeHTTPDoc,
+			&NetworkRuleDoc,
 			&NetworkDeviceSelectorDoc,
 			&ClusterDiscoveryConfigDoc,
 			&DiscoveryRegistriesConfigDoc,
@@ -3067,6 +3966,7 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&LoggingConfigDoc,
 			&LoggingDestinationDoc,
 			&KernelConfigDoc,
+			&ImageGCConfigDoc,
 			&KernelModuleConfigDoc,
 		},
 	}
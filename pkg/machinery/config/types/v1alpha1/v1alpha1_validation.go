@@ -6,17 +6,22 @@ package v1alpha1
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/slices"
 	"github.com/talos-systems/go-debug"
 	talosnet "github.com/talos-systems/net"
 
@@ -24,6 +29,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/kubelet"
+	"github.com/talos-systems/talos/pkg/machinery/labels"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 )
 
@@ -143,6 +149,18 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 		result = multierror.Append(result, fmt.Errorf("unknown machine type %q", c.MachineConfig.MachineType))
 	}
 
+	for i, ca := range c.MachineConfig.MachineAcceptedCAs {
+		if ca == nil || len(ca.Crt) == 0 {
+			result = multierror.Append(result, fmt.Errorf("machine accepted CA %d: certificate is required", i))
+
+			continue
+		}
+
+		if _, err := ca.GetCert(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("machine accepted CA %d: %w", i, err))
+		}
+	}
+
 	if c.MachineConfig.MachineNetwork != nil {
 		bondedInterfaces := map[string]string{}
 		bridgedInterfaces := map[string]string{}
@@ -186,6 +204,30 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 			warnings = append(warnings, warn...)
 			result = multierror.Append(result, err)
 		}
+
+		probeNames := map[string]struct{}{}
+
+		for _, probe := range c.MachineConfig.MachineNetwork.NetworkProbes {
+			if _, exists := probeNames[probe.Name()]; exists {
+				result = multierror.Append(result, fmt.Errorf("duplicate network probe %q", probe.Name()))
+			}
+
+			probeNames[probe.Name()] = struct{}{}
+
+			result = multierror.Append(result, probe.Validate())
+		}
+
+		rulePriorities := map[uint32]struct{}{}
+
+		for _, rule := range c.MachineConfig.MachineNetwork.NetworkRules {
+			if _, exists := rulePriorities[rule.RulePriority]; exists {
+				result = multierror.Append(result, fmt.Errorf("duplicate routing rule priority %d", rule.RulePriority))
+			}
+
+			rulePriorities[rule.RulePriority] = struct{}{}
+
+			result = multierror.Append(result, rule.Validate())
+		}
 	}
 
 	if c.MachineConfig.MachineDisks != nil {
@@ -194,7 +236,65 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 				if pt.DiskSize == 0 && i != len(disk.DiskPartitions)-1 {
 					result = multierror.Append(result, fmt.Errorf("partition for disk %q is set to occupy full disk, but it's not the last partition in the list", disk.Device()))
 				}
+
+				switch pt.DiskFilesystem {
+				case "", constants.UserDiskFilesystemXFS, constants.UserDiskFilesystemExt4:
+				default:
+					result = multierror.Append(result, fmt.Errorf("partition for disk %q: unsupported filesystem %q", disk.Device(), pt.DiskFilesystem))
+				}
+
+				for _, opt := range pt.DiskMountOptions {
+					if strings.TrimSpace(opt) == "" {
+						result = multierror.Append(result, fmt.Errorf("partition for disk %q: mount option can't be empty", disk.Device()))
+					}
+				}
+			}
+		}
+	}
+
+	if c.MachineConfig.MachineImageGC != nil {
+		if c.MachineConfig.MachineImageGC.ImageGCInterval < 0 || c.MachineConfig.MachineImageGC.ImageGCMinAge < 0 {
+			result = multierror.Append(result, fmt.Errorf("image GC interval and minimum age can't be negative"))
+		}
+	}
+
+	if err := labels.Validate(c.MachineConfig.MachineNodeLabels); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := labels.ValidateAnnotations(c.MachineConfig.MachineNodeAnnotations); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := labels.ValidateTaints(c.MachineConfig.MachineNodeTaints); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if c.MachineConfig.MachineVolumeGroups != nil {
+		devices := map[string]string{}
+
+		for _, disk := range c.MachineConfig.MachineDisks {
+			devices[disk.Device()] = "machine disks"
+		}
+
+		vgNames := map[string]struct{}{}
+
+		for _, vg := range c.MachineConfig.MachineVolumeGroups {
+			if _, exists := vgNames[vg.VolumeGroupName]; exists {
+				result = multierror.Append(result, fmt.Errorf("duplicate volume group %q", vg.VolumeGroupName))
+			}
+
+			vgNames[vg.VolumeGroupName] = struct{}{}
+
+			for _, pv := range vg.VolumeGroupPhysicalVolumes {
+				if owner, exists := devices[pv]; exists {
+					result = multierror.Append(result, fmt.Errorf("volume group %q: device %q is already used by %s", vg.VolumeGroupName, pv, owner))
+				}
+
+				devices[pv] = fmt.Sprintf("volume group %q", vg.VolumeGroupName)
 			}
+
+			result = multierror.Append(result, vg.Validate())
 		}
 	}
 
@@ -316,11 +416,227 @@ func (c *ClusterConfig) Validate() error {
 		result = multierror.Append(result, c.EtcdConfig.Validate())
 	}
 
+	if c.APIServerConfig != nil {
+		result = multierror.Append(result, c.APIServerConfig.Validate())
+	}
+
+	for i, ca := range c.ClusterAcceptedCAs {
+		if ca == nil || len(ca.Crt) == 0 {
+			result = multierror.Append(result, fmt.Errorf("cluster accepted CA %d: certificate is required", i))
+
+			continue
+		}
+
+		if _, err := ca.GetCert(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("cluster accepted CA %d: %w", i, err))
+		}
+	}
+
+	for i, key := range c.ClusterAcceptedServiceAccountKeys {
+		if key == nil || len(key.Key) == 0 {
+			result = multierror.Append(result, fmt.Errorf("cluster accepted service account key %d: key is required", i))
+
+			continue
+		}
+
+		if _, err := key.GetKey(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("cluster accepted service account key %d: %w", i, err))
+		}
+	}
+
+	if c.ControllerManagerConfig != nil {
+		if c.ControllerManagerConfig.ResourcesConfig != nil {
+			result = multierror.Append(result, c.ControllerManagerConfig.ResourcesConfig.Validate("controller manager"))
+		}
+
+		result = multierror.Append(result, validatePriorityClassName("controller manager", c.ControllerManagerConfig.PriorityClassNameConfig))
+	}
+
+	if c.SchedulerConfig != nil {
+		if c.SchedulerConfig.ResourcesConfig != nil {
+			result = multierror.Append(result, c.SchedulerConfig.ResourcesConfig.Validate("scheduler"))
+		}
+
+		result = multierror.Append(result, validatePriorityClassName("scheduler", c.SchedulerConfig.PriorityClassNameConfig))
+	}
+
 	result = multierror.Append(result, c.ClusterInlineManifests.Validate(), c.ClusterDiscoveryConfig.Validate(c))
 
 	return result.ErrorOrNil()
 }
 
+// Validate validates the network probe config.
+func (p *NetworkProbe) Validate() error {
+	var result *multierror.Error
+
+	if p.ProbeName == "" {
+		result = multierror.Append(result, fmt.Errorf("network probe name is required"))
+	}
+
+	if p.ProbeInterval < 0 || p.ProbeFailureThreshold < 0 {
+		result = multierror.Append(result, fmt.Errorf("network probe %q: interval and failure threshold can't be negative", p.ProbeName))
+	}
+
+	probeKinds := 0
+
+	if p.ProbeTCP != nil {
+		probeKinds++
+
+		if _, _, err := net.SplitHostPort(p.ProbeTCP.TCPEndpoint); err != nil {
+			result = multierror.Append(result, fmt.Errorf("network probe %q: invalid TCP endpoint %q: %w", p.ProbeName, p.ProbeTCP.TCPEndpoint, err))
+		}
+	}
+
+	if p.ProbeICMP != nil {
+		probeKinds++
+
+		if p.ProbeICMP.ICMPHost == "" {
+			result = multierror.Append(result, fmt.Errorf("network probe %q: ICMP host is required", p.ProbeName))
+		}
+	}
+
+	if p.ProbeHTTP != nil {
+		probeKinds++
+
+		if u, err := url.Parse(p.ProbeHTTP.HTTPURL); err != nil {
+			result = multierror.Append(result, fmt.Errorf("network probe %q: invalid HTTP URL %q: %w", p.ProbeName, p.ProbeHTTP.HTTPURL, err))
+		} else if u.Scheme != "http" && u.Scheme != "https" {
+			result = multierror.Append(result, fmt.Errorf("network probe %q: unsupported HTTP URL scheme %q", p.ProbeName, u.Scheme))
+		}
+	}
+
+	if probeKinds != 1 {
+		result = multierror.Append(result, fmt.Errorf("network probe %q: exactly one of tcp, icmp or http should be set", p.ProbeName))
+	}
+
+	return result.ErrorOrNil()
+}
+
+var lvmNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9+_.][a-zA-Z0-9+_.-]*$`)
+
+func validateLVMName(name string) error {
+	if !lvmNameRegexp.MatchString(name) || name == "." || name == ".." {
+		return fmt.Errorf("invalid name %q", name)
+	}
+
+	return nil
+}
+
+// Validate validates the volume group config.
+//
+//nolint:gocyclo
+func (vg *VolumeGroup) Validate() error {
+	var result *multierror.Error
+
+	if err := validateLVMName(vg.VolumeGroupName); err != nil {
+		result = multierror.Append(result, fmt.Errorf("volume group: %w", err))
+	}
+
+	if len(vg.VolumeGroupPhysicalVolumes) == 0 {
+		result = multierror.Append(result, fmt.Errorf("volume group %q: at least one physical volume is required", vg.VolumeGroupName))
+	}
+
+	lvNames := map[string]struct{}{}
+
+	for i, lv := range vg.VolumeGroupLogicalVolumes {
+		if err := validateLVMName(lv.LogicalVolumeName); err != nil {
+			result = multierror.Append(result, fmt.Errorf("volume group %q: logical volume: %w", vg.VolumeGroupName, err))
+		}
+
+		if _, exists := lvNames[lv.LogicalVolumeName]; exists {
+			result = multierror.Append(result, fmt.Errorf("volume group %q: duplicate logical volume %q", vg.VolumeGroupName, lv.LogicalVolumeName))
+		}
+
+		lvNames[lv.LogicalVolumeName] = struct{}{}
+
+		if lv.LogicalVolumeSize == 0 && i != len(vg.VolumeGroupLogicalVolumes)-1 {
+			result = multierror.Append(result, fmt.Errorf("volume group %q: logical volume %q is set to occupy all free space, but it's not the last logical volume in the list",
+				vg.VolumeGroupName, lv.LogicalVolumeName))
+		}
+
+		if lv.LogicalVolumeMirrors < 0 {
+			result = multierror.Append(result, fmt.Errorf("volume group %q: logical volume %q: mirrors can't be negative", vg.VolumeGroupName, lv.LogicalVolumeName))
+		}
+
+		// each copy of the data should be placed on a separate physical volume
+		var minDevices int
+
+		switch lv.Type() {
+		case constants.LogicalVolumeTypeLinear:
+			if lv.LogicalVolumeMirrors != 0 {
+				result = multierror.Append(result, fmt.Errorf("volume group %q: logical volume %q: mirrors are only supported for RAID logical volumes",
+					vg.VolumeGroupName, lv.LogicalVolumeName))
+			}
+		case constants.LogicalVolumeTypeRAID1:
+			minDevices = lv.Mirrors() + 1
+		case constants.LogicalVolumeTypeRAID10:
+			minDevices = 2 * (lv.Mirrors() + 1)
+		default:
+			result = multierror.Append(result, fmt.Errorf("volume group %q: logical volume %q: unsupported type %q", vg.VolumeGroupName, lv.LogicalVolumeName, lv.LogicalVolumeType))
+		}
+
+		if len(vg.VolumeGroupPhysicalVolumes) < minDevices {
+			result = multierror.Append(result, fmt.Errorf("volume group %q: logical volume %q of type %s requires at least %d physical volumes",
+				vg.VolumeGroupName, lv.LogicalVolumeName, lv.Type(), minDevices))
+		}
+
+		if lv.LogicalVolumeMountPoint != "" && !strings.HasPrefix(lv.LogicalVolumeMountPoint, constants.EphemeralMountPoint+"/") {
+			result = multierror.Append(result, fmt.Errorf("volume group %q: logical volume %q: mount point %q should be under %s",
+				vg.VolumeGroupName, lv.LogicalVolumeName, lv.LogicalVolumeMountPoint, constants.EphemeralMountPoint))
+		}
+
+		switch lv.LogicalVolumeFilesystem {
+		case "", constants.UserDiskFilesystemXFS, constants.UserDiskFilesystemExt4:
+		default:
+			result = multierror.Append(result, fmt.Errorf("volume group %q: logical volume %q: unsupported filesystem %q",
+				vg.VolumeGroupName, lv.LogicalVolumeName, lv.LogicalVolumeFilesystem))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the routing rule config.
+func (r *NetworkRule) Validate() error {
+	var result *multierror.Error
+
+	if r.RulePriority == 0 || r.RulePriority > 32765 {
+		result = multierror.Append(result, fmt.Errorf("routing rule priority %d should be in range 1-32765", r.RulePriority))
+	}
+
+	if r.RuleTable == 0 {
+		result = multierror.Append(result, fmt.Errorf("routing rule %d: table is required", r.RulePriority))
+	}
+
+	var from, to netip.Prefix
+
+	if r.RuleFrom != "" {
+		var err error
+
+		if from, err = netip.ParsePrefix(r.RuleFrom); err != nil {
+			result = multierror.Append(result, fmt.Errorf("routing rule %d: invalid source prefix %q: %w", r.RulePriority, r.RuleFrom, err))
+		}
+	}
+
+	if r.RuleTo != "" {
+		var err error
+
+		if to, err = netip.ParsePrefix(r.RuleTo); err != nil {
+			result = multierror.Append(result, fmt.Errorf("routing rule %d: invalid destination prefix %q: %w", r.RulePriority, r.RuleTo, err))
+		}
+	}
+
+	if from.IsValid() && to.IsValid() && from.Addr().Is4() != to.Addr().Is4() {
+		result = multierror.Append(result, fmt.Errorf("routing rule %d: source and destination prefixes should be of the same address family", r.RulePriority))
+	}
+
+	if r.RuleFwMask != 0 && r.RuleFwMark == 0 {
+		result = multierror.Append(result, fmt.Errorf("routing rule %d: fwMask requires fwMark to be set", r.RulePriority))
+	}
+
+	return result.ErrorOrNil()
+}
+
 // ValidateCNI validates CNI config.
 func ValidateCNI(cni config.CNI) ([]string, error) {
 	var (
@@ -432,6 +748,18 @@ func (c *ClusterDiscoveryConfig) Validate(clusterCfg *ClusterConfig) error {
 		}
 	}
 
+	if c.Registries().Kubernetes().Enabled() {
+		if clusterCfg.Secret() == "" {
+			result = multierror.Append(result, fmt.Errorf("cluster discovery Kubernetes registry requires .cluster.secret"))
+		}
+
+		for _, secret := range c.Registries().Kubernetes().AcceptedSecrets() {
+			if _, err := base64.StdEncoding.DecodeString(secret); err != nil {
+				result = multierror.Append(result, fmt.Errorf("cluster discovery Kubernetes registry accepted secret is invalid: %w", err))
+			}
+		}
+	}
+
 	return result.ErrorOrNil()
 }
 
@@ -725,11 +1053,117 @@ func CheckDeviceAddressing(d *Device, bondedInterfaces map[string]string) ([]str
 		if ip := net.ParseIP(d.DeviceVIPConfig.IP()); ip == nil {
 			result = multierror.Append(result, fmt.Errorf("[%s] failed to parse %q as IP address", "networking.os.device.vip", d.DeviceVIPConfig.IP()))
 		}
+
+		if d.DeviceVIPConfig.BGPConfig != nil {
+			if d.DeviceVIPConfig.EquinixMetalConfig != nil || d.DeviceVIPConfig.HCloudConfig != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: BGP can't be used together with Equinix Metal or Hetzner Cloud VIP", "networking.os.device.vip.bgp", d.DeviceInterface))
+			}
+
+			result = multierror.Append(result, d.DeviceVIPConfig.BGPConfig.Validate(d.DeviceVIPConfig.IP()))
+		}
+	}
+
+	// check DHCP options
+	if d.DeviceDHCPOptions != nil {
+		result = multierror.Append(result, d.DeviceDHCPOptions.Validate())
+	}
+
+	for _, vlan := range d.DeviceVlans {
+		if vlan.VlanDHCPOptions != nil {
+			result = multierror.Append(result, vlan.VlanDHCPOptions.Validate())
+		}
+	}
+
+	// check IPv6 settings
+	if d.DeviceIPv6Config != nil {
+		mode, err := nethelpers.IPv6ModeString(d.DeviceIPv6Config.IPv6Mode)
+		if err != nil || mode == nethelpers.IPv6ModeUnspec {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: unsupported IPv6 mode %q", "networking.os.device.ipv6.mode", d.DeviceInterface, d.DeviceIPv6Config.IPv6Mode))
+		}
+
+		if d.DeviceIPv6Config.IPv6UseTempAddr < 0 || d.DeviceIPv6Config.IPv6UseTempAddr > 2 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: value should be 0, 1 or 2", "networking.os.device.ipv6.useTempAddr", d.DeviceInterface))
+		}
+
+		if mode == nethelpers.IPv6ModeDisabled {
+			for _, address := range d.DeviceAddresses {
+				if ip, _, err := net.ParseCIDR(address); err == nil && ip.To4() == nil {
+					result = multierror.Append(result, fmt.Errorf("[%s] %q: IPv6 is disabled, but IPv6 address %q is configured", "networking.os.device.ipv6.mode", d.DeviceInterface, address))
+				}
+			}
+		}
 	}
 
 	return warnings, result.ErrorOrNil()
 }
 
+// Validate DHCP options.
+func (o *DHCPOptions) Validate() error {
+	var result *multierror.Error
+
+	if o.DHCPClientIdentifier != "" {
+		if _, err := hex.DecodeString(o.DHCPClientIdentifier); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: client identifier should be a hex string: %w", "networking.os.device.dhcpOptions.clientIdentifier", o.DHCPClientIdentifier, err))
+		}
+	}
+
+	for _, code := range o.DHCPRequestedOptions {
+		if code < 1 || code > 254 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: option code should be in range 1-254", "networking.os.device.dhcpOptions.requestedOptions", code))
+		}
+	}
+
+	if o.DHCPSendHostname != "" {
+		if _, err := nethelpers.DHCPSendHostnameString(o.DHCPSendHostname); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: unsupported hostname send policy", "networking.os.device.dhcpOptions.sendHostname", o.DHCPSendHostname))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate VIP BGP settings.
+//
+// The VIP is announced with the local address of the BGP session as the next hop,
+// so the peers should be of the same address family as the VIP.
+func (v *VIPBGPConfig) Validate(vip string) error {
+	var result *multierror.Error
+
+	if v.BGPLocalASN == 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] local ASN is required", "networking.os.device.vip.bgp.localASN"))
+	}
+
+	if v.BGPRouterID != "" {
+		if addr, err := netip.ParseAddr(v.BGPRouterID); err != nil || !addr.Is4() {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: router ID should be an IPv4 address", "networking.os.device.vip.bgp.routerID", v.BGPRouterID))
+		}
+	}
+
+	if v.BGPHoldTime != 0 && (v.BGPHoldTime < 3*time.Second || v.BGPHoldTime > 0xffff*time.Second) {
+		result = multierror.Append(result, fmt.Errorf("[%s] %s: hold time should be in range 3s-65535s", "networking.os.device.vip.bgp.holdTime", v.BGPHoldTime))
+	}
+
+	if len(v.BGPPeers) == 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] at least one peer is required", "networking.os.device.vip.bgp.peers"))
+	}
+
+	vipAddr, vipErr := netip.ParseAddr(vip)
+
+	for _, peer := range v.BGPPeers {
+		if peerAddr, err := nethelpers.ParseBGPPeerAddress(peer.PeerAddress); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.vip.bgp.peers.address", peer.PeerAddress, err))
+		} else if vipErr == nil && peerAddr.Addr().Unmap().Is4() != vipAddr.Unmap().Is4() {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: peer address family should match the VIP %q", "networking.os.device.vip.bgp.peers.address", peer.PeerAddress, vip))
+		}
+
+		if peer.PeerASN == 0 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: peer ASN is required", "networking.os.device.vip.bgp.peers.asn", peer.PeerAddress))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
 // CheckDeviceRoutes ensures that the specified routes are valid.
 //
 //nolint:gocyclo
@@ -791,6 +1225,20 @@ func (k *KubeletConfig) Validate() ([]string, error) {
 		}
 	}
 
+	if len(k.KubeletCredentialProviderConfig.Object) > 0 {
+		if err := kubelet.ValidateCredentialProviderConfig(k.KubeletCredentialProviderConfig.Object); err != nil {
+			var multiErr *multierror.Error
+
+			if !errors.As(err, &multiErr) {
+				multiErr = &multierror.Error{Errors: []error{err}}
+			}
+
+			for _, e := range multiErr.Errors {
+				result = multierror.Append(result, fmt.Errorf("[%s] %w", "machine.kubelet.credentialProviderConfig", e))
+			}
+		}
+	}
+
 	return nil, result.ErrorOrNil()
 }
 
@@ -824,3 +1272,137 @@ func (e *EtcdConfig) Validate() error {
 
 	return result.ErrorOrNil()
 }
+
+// Validate validates the API server config.
+func (a *APIServerConfig) Validate() error {
+	var result *multierror.Error
+
+	kmsNames := map[string]struct{}{}
+
+	for _, kms := range a.KMSProvidersConfig {
+		if kms.KMSName == "" {
+			result = multierror.Append(result, fmt.Errorf("API server KMS provider name is required"))
+		} else if strings.Contains(kms.KMSName, ":") {
+			result = multierror.Append(result, fmt.Errorf("API server KMS provider name %q can't contain ':'", kms.KMSName))
+		}
+
+		if _, exists := kmsNames[kms.KMSName]; exists {
+			result = multierror.Append(result, fmt.Errorf("duplicate API server KMS provider %q", kms.KMSName))
+		}
+
+		kmsNames[kms.KMSName] = struct{}{}
+
+		if !filepath.IsAbs(kms.KMSEndpoint) {
+			result = multierror.Append(result, fmt.Errorf("API server KMS provider %q: endpoint should be an absolute path to the Unix socket: %q", kms.KMSName, kms.KMSEndpoint))
+		}
+
+		if kms.KMSTimeout < 0 {
+			result = multierror.Append(result, fmt.Errorf("API server KMS provider %q: timeout can't be negative", kms.KMSName))
+		}
+	}
+
+	if a.ResourcesConfig != nil {
+		result = multierror.Append(result, a.ResourcesConfig.Validate("API server"))
+	}
+
+	result = multierror.Append(result, validatePriorityClassName("API server", a.PriorityClassNameConfig))
+
+	if a.AuditLogConfig != nil {
+		for _, value := range []struct {
+			name  string
+			value *int
+		}{
+			{"maxAge", a.AuditLogConfig.AuditLogMaxAge},
+			{"maxBackups", a.AuditLogConfig.AuditLogMaxBackups},
+		} {
+			if value.value != nil && *value.value < 0 {
+				result = multierror.Append(result, fmt.Errorf("API server audit log %s should not be negative: %d", value.name, *value.value))
+			}
+		}
+
+		if a.AuditLogConfig.AuditLogMaxSize != nil && *a.AuditLogConfig.AuditLogMaxSize <= 0 {
+			result = multierror.Append(result, fmt.Errorf("API server audit log maxSize should be positive: %d", *a.AuditLogConfig.AuditLogMaxSize))
+		}
+	}
+
+	if a.OIDCConfig != nil {
+		result = multierror.Append(result, a.OIDCConfig.Validate())
+
+		for arg := range a.ExtraArgsConfig {
+			if strings.HasPrefix(arg, "oidc-") {
+				result = multierror.Append(result, fmt.Errorf("API server extra argument %q conflicts with the OIDC configuration", arg))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// oidcSigningAlgorithms is the list of the signing algorithms supported by kube-apiserver.
+var oidcSigningAlgorithms = []string{
+	"RS256", "RS384", "RS512",
+	"ES256", "ES384", "ES512",
+	"PS256", "PS384", "PS512",
+}
+
+// Validate validates the API server OIDC config.
+func (o *OIDCConfig) Validate() error {
+	var result *multierror.Error
+
+	if o.OIDCIssuerURL == "" {
+		result = multierror.Append(result, fmt.Errorf("API server OIDC issuer URL is required"))
+	} else {
+		issuerURL, err := url.Parse(o.OIDCIssuerURL)
+
+		switch {
+		case err != nil:
+			result = multierror.Append(result, fmt.Errorf("API server OIDC issuer URL is not valid: %w", err))
+		case issuerURL.Scheme != "https" || issuerURL.Host == "":
+			result = multierror.Append(result, fmt.Errorf("API server OIDC issuer URL should be an https:// URL: %q", o.OIDCIssuerURL))
+		case issuerURL.RawQuery != "" || issuerURL.Fragment != "":
+			result = multierror.Append(result, fmt.Errorf("API server OIDC issuer URL should not contain query or fragment: %q", o.OIDCIssuerURL))
+		}
+	}
+
+	if o.OIDCClientID == "" {
+		result = multierror.Append(result, fmt.Errorf("API server OIDC client ID is required"))
+	}
+
+	for _, alg := range o.OIDCSigningAlgorithms {
+		if !slices.Contains(oidcSigningAlgorithms, func(supported string) bool { return supported == alg }) {
+			result = multierror.Append(result, fmt.Errorf("API server OIDC signing algorithm %q is not supported", alg))
+		}
+	}
+
+	for claim := range o.OIDCRequiredClaims {
+		if claim == "" {
+			result = multierror.Append(result, fmt.Errorf("API server OIDC required claim name can't be empty"))
+		}
+	}
+
+	if o.OIDCCA != nil {
+		if len(o.OIDCCA.Crt) == 0 {
+			result = multierror.Append(result, fmt.Errorf("API server OIDC CA: certificate is required"))
+		} else if _, err := o.OIDCCA.GetCert(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("API server OIDC CA: %w", err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// priorityClassNameRegexp matches the DNS subdomain names (RFC 1123) used as priority class names.
+var priorityClassNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+// validatePriorityClassName validates the priority class name of a control plane component, empty name means the default.
+func validatePriorityClassName(component, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	if len(name) > 253 || !priorityClassNameRegexp.MatchString(name) {
+		return fmt.Errorf("%s priority class name %q is not a valid DNS subdomain name", component, name)
+	}
+
+	return nil
+}
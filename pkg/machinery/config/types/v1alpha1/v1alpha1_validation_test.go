@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/go-pointer"
@@ -37,6 +38,12 @@ func TestValidate(t *testing.T) {
 	endpointURL, err := url.Parse("https://localhost:6443/")
 	require.NoError(t, err)
 
+	acceptedCA, err := x509.NewSelfSignedCertificateAuthority()
+	require.NoError(t, err)
+
+	acceptedServiceAccountKey, err := x509.NewECDSAKey()
+	require.NoError(t, err)
+
 	for _, test := range []struct {
 		name             string
 		config           *v1alpha1.Config
@@ -407,6 +414,167 @@ func TestValidate(t *testing.T) {
 			expectedError:    "1 error occurred:\n\t* [networking.os.device] \"eth0\": interface can't have both .cidr and .addresses set\n\n",
 			expectedWarnings: []string{"\"eth0\": machine.network.interface.cidr is deprecated, please use machine.network.interface.addresses"},
 		},
+		{
+			name: "DeviceIPv6",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceIPv6Config: &v1alpha1.DeviceIPv6Config{
+									IPv6Mode:        "slaac",
+									IPv6UseTempAddr: 2,
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceIPv6Config: &v1alpha1.DeviceIPv6Config{
+									IPv6Mode:        "auto",
+									IPv6UseTempAddr: 3,
+								},
+							},
+							{
+								DeviceInterface: "eth2",
+								DeviceAddresses: []string{
+									"192.168.0.5/24",
+									"2001:db8::5/64",
+								},
+								DeviceIPv6Config: &v1alpha1.DeviceIPv6Config{
+									IPv6Mode: "disabled",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* [networking.os.device.ipv6.mode] \"eth1\": unsupported IPv6 mode \"auto\"\n" +
+				"\t* [networking.os.device.ipv6.useTempAddr] \"eth1\": value should be 0, 1 or 2\n" +
+				"\t* [networking.os.device.ipv6.mode] \"eth2\": IPv6 is disabled, but IPv6 address \"2001:db8::5/64\" is configured\n\n",
+		},
+		{
+			name: "DHCPOptions",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceDHCP:      pointer.To(true),
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPClientIdentifier: "01525400123456",
+									DHCPVendorClass:      "PXEClient",
+									DHCPUserClasses:      []string{"talos"},
+									DHCPRequestedOptions: []int{66, 67},
+									DHCPSendHostname:     "fqdn",
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceDHCP:      pointer.To(true),
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPClientIdentifier: "xyz",
+									DHCPRequestedOptions: []int{0, 255},
+									DHCPSendHostname:     "always",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* [networking.os.device.dhcpOptions.clientIdentifier] \"xyz\": client identifier should be a hex string: encoding/hex: invalid byte: U+0078 'x'\n" +
+				"\t* [networking.os.device.dhcpOptions.requestedOptions] 0: option code should be in range 1-254\n" +
+				"\t* [networking.os.device.dhcpOptions.requestedOptions] 255: option code should be in range 1-254\n" +
+				"\t* [networking.os.device.dhcpOptions.sendHostname] \"always\": unsupported hostname send policy\n\n",
+		},
+		{
+			name: "VIPBGP",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceDHCP:      pointer.To(true),
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "10.5.0.100",
+									BGPConfig: &v1alpha1.VIPBGPConfig{
+										BGPLocalASN: 65001,
+										BGPPeers: []*v1alpha1.VIPBGPPeer{
+											{
+												PeerAddress: "10.5.0.1",
+												PeerASN:     65000,
+											},
+											{
+												PeerAddress: "10.5.0.254:1179",
+												PeerASN:     65000,
+											},
+										},
+									},
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceDHCP:      pointer.To(true),
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "10.6.0.100",
+									BGPConfig: &v1alpha1.VIPBGPConfig{
+										BGPRouterID: "2001:db8::2",
+										BGPHoldTime: time.Second,
+										BGPPeers: []*v1alpha1.VIPBGPPeer{
+											{
+												PeerAddress: "10.6.0.1:abc",
+											},
+											{
+												PeerAddress: "2001:db8::1",
+												PeerASN:     65000,
+											},
+										},
+									},
+									HCloudConfig: &v1alpha1.VIPHCloudConfig{
+										HCloudAPIToken: "token",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "7 errors occurred:\n\t* [networking.os.device.vip.bgp] \"eth1\": BGP can't be used together with Equinix Metal or Hetzner Cloud VIP\n" +
+				"\t* [networking.os.device.vip.bgp.localASN] local ASN is required\n" +
+				"\t* [networking.os.device.vip.bgp.routerID] \"2001:db8::2\": router ID should be an IPv4 address\n" +
+				"\t* [networking.os.device.vip.bgp.holdTime] 1s: hold time should be in range 3s-65535s\n" +
+				"\t* [networking.os.device.vip.bgp.peers.address] \"10.6.0.1:abc\": invalid port \"abc\" parsing \"10.6.0.1:abc\"\n" +
+				"\t* [networking.os.device.vip.bgp.peers.asn] \"10.6.0.1:abc\": peer ASN is required\n" +
+				"\t* [networking.os.device.vip.bgp.peers.address] \"2001:db8::1\": peer address family should match the VIP \"10.6.0.100\"\n\n",
+		},
 		{
 			name: "VlanCIDRInvalid",
 			config: &v1alpha1.Config{
@@ -902,38 +1070,87 @@ func TestValidate(t *testing.T) {
 				"\t* .cluster.secret should be set when .machine.network.kubespan is enabled\n\n",
 		},
 		{
-			name: "DiscoveryServiceEndpoint",
+			name: "NetworkProbes",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
-					MachineType: "controlplane",
+					MachineType: "worker",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkProbes: []*v1alpha1.NetworkProbe{
+							{
+								ProbeName: "uplink",
+								ProbeTCP: &v1alpha1.NetworkProbeTCP{
+									TCPEndpoint: "10.5.0.1:443",
+								},
+							},
+							{
+								ProbeName: "uplink",
+								ProbeICMP: &v1alpha1.NetworkProbeICMP{
+									ICMPHost: "10.5.0.1",
+								},
+							},
+							{
+								ProbeName: "noport",
+								ProbeTCP: &v1alpha1.NetworkProbeTCP{
+									TCPEndpoint: "10.5.0.1",
+								},
+							},
+							{
+								ProbeName: "ftp",
+								ProbeHTTP: &v1alpha1.NetworkProbeHTTP{
+									HTTPURL: "ftp://10.5.0.1/",
+								},
+							},
+							{
+								ProbeName: "empty",
+							},
+						},
+					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
-					ClusterID:     "foo",
-					ClusterSecret: "bar",
 					ControlPlane: &v1alpha1.ControlPlaneConfig{
 						Endpoint: &v1alpha1.Endpoint{
 							endpointURL,
 						},
 					},
-					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
-						DiscoveryEnabled: pointer.To(true),
-						DiscoveryRegistries: v1alpha1.DiscoveryRegistriesConfig{
-							RegistryService: v1alpha1.RegistryServiceConfig{
-								RegistryEndpoint: "foo",
-							},
-						},
-					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* cluster discovery service registry endpoint is invalid: parse \"foo\": invalid URI for request\n\n",
+			expectedError: "4 errors occurred:\n\t* duplicate network probe \"uplink\"\n" +
+				"\t* network probe \"noport\": invalid TCP endpoint \"10.5.0.1\": address 10.5.0.1: missing port in address\n" +
+				"\t* network probe \"ftp\": unsupported HTTP URL scheme \"ftp\"\n" +
+				"\t* network probe \"empty\": exactly one of tcp, icmp or http should be set\n\n",
 		},
 		{
-			name: "DiscoveryServiceClusterIDSecret",
+			name: "NetworkRules",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
-					MachineType: "controlplane",
+					MachineType: "worker",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkRules: []*v1alpha1.NetworkRule{
+							{
+								RuleFrom:     "10.6.0.0/24",
+								RuleTable:    100,
+								RulePriority: 1000,
+							},
+							{
+								RuleFwMark:   0x100,
+								RuleTable:    100,
+								RulePriority: 1000,
+							},
+							{
+								RuleFrom:     "10.6.0.0/24",
+								RuleTo:       "2001:db8::/32",
+								RuleTable:    100,
+								RulePriority: 1001,
+							},
+							{
+								RuleTo:       "10.7.0.0",
+								RuleFwMask:   0xff00,
+								RulePriority: 40000,
+							},
+						},
+					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
 					ControlPlane: &v1alpha1.ControlPlaneConfig{
@@ -941,19 +1158,38 @@ func TestValidate(t *testing.T) {
 							endpointURL,
 						},
 					},
-					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
-						DiscoveryEnabled: pointer.To(true),
-					},
 				},
 			},
-			expectedError: "2 errors occurred:\n\t* cluster discovery service requires .cluster.id\n\t* cluster discovery service requires .cluster.secret\n\n",
+			expectedError: "6 errors occurred:\n\t* duplicate routing rule priority 1000\n" +
+				"\t* routing rule 1001: source and destination prefixes should be of the same address family\n" +
+				"\t* routing rule priority 40000 should be in range 1-32765\n" +
+				"\t* routing rule 40000: table is required\n" +
+				"\t* routing rule 40000: invalid destination prefix \"10.7.0.0\": netip.ParsePrefix(\"10.7.0.0\"): no '/'\n" +
+				"\t* routing rule 40000: fwMask requires fwMark to be set\n\n",
 		},
 		{
-			name: "GoodEtcdSubnet",
+			name: "DiskPartitions",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
-					MachineType: "controlplane",
+					MachineType: "worker",
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/sdb",
+							DiskPartitions: []*v1alpha1.DiskPartition{
+								{
+									DiskSize:         100 * 1024 * 1024 * 1024,
+									DiskMountPoint:   "/var/mnt/xfs",
+									DiskMountOptions: []string{"noatime", "prjquota"},
+								},
+								{
+									DiskMountPoint:   "/var/mnt/ext4",
+									DiskFilesystem:   "ext4",
+									DiskMountOptions: []string{"noatime,nodev"},
+								},
+							},
+						},
+					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
 					ControlPlane: &v1alpha1.ControlPlaneConfig{
@@ -961,27 +1197,53 @@ func TestValidate(t *testing.T) {
 							endpointURL,
 						},
 					},
-					EtcdConfig: &v1alpha1.EtcdConfig{
-						RootCA: &x509.PEMEncodedCertificateAndKey{},
-						EtcdAdvertisedSubnets: []string{
-							"10.0.0.0/8",
-							"!1.1.1.1/32",
+				},
+			},
+		},
+		{
+			name: "DiskPartitionsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/sdb",
+							DiskPartitions: []*v1alpha1.DiskPartition{
+								{
+									DiskMountPoint: "/var/mnt/btrfs",
+									DiskFilesystem: "btrfs",
+								},
+								{
+									DiskMountPoint:   "/var/mnt/xfs",
+									DiskMountOptions: []string{"noatime", " "},
+								},
+							},
 						},
-						EtcdListenSubnets: []string{
-							"10.0.0.0/8",
-							"1.1.1.1/32",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
 						},
 					},
 				},
 			},
-			expectedError: "",
+			expectedError: "3 errors occurred:\n\t* partition for disk \"/dev/sdb\" is set to occupy full disk, but it's not the last partition in the list\n" +
+				"\t* partition for disk \"/dev/sdb\": unsupported filesystem \"btrfs\"\n" +
+				"\t* partition for disk \"/dev/sdb\": mount option can't be empty\n\n",
 		},
 		{
-			name: "BadEtcdSubnet",
+			name: "ImageGC",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
-					MachineType: "controlplane",
+					MachineType: "worker",
+					MachineImageGC: &v1alpha1.ImageGCConfig{
+						ImageGCEnabled:  pointer.To(true),
+						ImageGCInterval: 6 * time.Hour,
+					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
 					ControlPlane: &v1alpha1.ControlPlaneConfig{
@@ -989,33 +1251,18 @@ func TestValidate(t *testing.T) {
 							endpointURL,
 						},
 					},
-					EtcdConfig: &v1alpha1.EtcdConfig{
-						RootCA: &x509.PEMEncodedCertificateAndKey{},
-						EtcdAdvertisedSubnets: []string{
-							"1234:",
-						},
-						EtcdListenSubnets: []string{
-							"10",
-						},
-					},
 				},
 			},
-			expectedError: "2 errors occurred:\n\t* etcd advertised subnet is not valid: \"1234:\"\n\t* etcd listen subnet is not valid: \"10\"\n\n",
 		},
 		{
-			name: "GoodKubeletSubnet",
+			name: "ImageGCInvalid",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
 					MachineType: "worker",
-					MachineKubelet: &v1alpha1.KubeletConfig{
-						KubeletNodeIP: &v1alpha1.KubeletNodeIPConfig{
-							KubeletNodeIPValidSubnets: []string{
-								"10.0.0.0/8",
-								"!10.0.0.3/32",
-								"!fd00::169:254:2:53/128",
-							},
-						},
+					MachineImageGC: &v1alpha1.ImageGCConfig{
+						ImageGCEnabled: pointer.To(true),
+						ImageGCMinAge:  -time.Hour,
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
@@ -1026,21 +1273,23 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "",
+			expectedError: "1 error occurred:\n\t* image GC interval and minimum age can't be negative\n\n",
 		},
 		{
-			name: "BadKubeletSubnet",
+			name: "NodeLabelsAnnotationsTaints",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
 					MachineType: "worker",
-					MachineKubelet: &v1alpha1.KubeletConfig{
-						KubeletNodeIP: &v1alpha1.KubeletNodeIPConfig{
-							KubeletNodeIPValidSubnets: []string{
-								"10.0.0.0.3",
-								"[fd00::169:254:2:53]:344",
-							},
-						},
+					MachineNodeLabels: map[string]string{
+						"example.com/pool": "gpu",
+					},
+					MachineNodeAnnotations: map[string]string{
+						"example.com/rack": "r13 a25",
+					},
+					MachineNodeTaints: map[string]string{
+						"example.com/gpu": "true:NoSchedule",
+						"dedicated":       ":NoExecute",
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
@@ -1051,34 +1300,807 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "2 errors occurred:\n" +
-				"\t* kubelet nodeIP subnet is not valid: \"10.0.0.0.3\"\n" +
-				"\t* kubelet nodeIP subnet is not valid: \"[fd00::169:254:2:53]:344\"\n" +
-				"\n",
 		},
 		{
-			name: "BadKubeletExtraConfig",
+			name: "NodeLabelsAnnotationsTaintsInvalid",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
 					MachineType: "worker",
-					MachineKubelet: &v1alpha1.KubeletConfig{
-						KubeletExtraConfig: v1alpha1.Unstructured{
-							Object: map[string]interface{}{
-								"port": 345,
-							},
+					MachineNodeLabels: map[string]string{
+						"example.com/pool": "gpu workers",
+					},
+					MachineNodeAnnotations: map[string]string{
+						"/rack": "r13",
+					},
+					MachineNodeTaints: map[string]string{
+						"example.com/gpu": "true",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
 						},
 					},
 				},
+			},
+			expectedError: "3 errors occurred:\n" +
+				"\t* invalid label \"example.com/pool\": value \"gpu workers\" should consist of alphanumeric characters, '-', '_' or '.', and start and end with an alphanumeric character\n" +
+				"\t* invalid annotation key: prefix of \"/rack\" can't be empty\n" +
+				"\t* invalid taint \"example.com/gpu\": taint \"true\" should be in the 'value:effect' format\n\n",
+		},
+		{
+			name: "APIServerKMSProviders",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
 					ControlPlane: &v1alpha1.ControlPlaneConfig{
 						Endpoint: &v1alpha1.Endpoint{
 							endpointURL,
 						},
 					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						KMSProvidersConfig: []*v1alpha1.KMSProviderConfig{
+							{
+								KMSName:     "vault",
+								KMSEndpoint: "/var/run/kmsplugin/vault.sock",
+								KMSTimeout:  time.Second,
+							},
+							{
+								KMSName:        "aws",
+								KMSEndpoint:    "/var/run/kmsplugin/aws.sock",
+								KMSDecryptOnly: pointer.To(true),
+							},
+						},
+					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* kubelet configuration field \"port\" can't be overridden\n\n",
+		},
+		{
+			name: "APIServerKMSProvidersInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						KMSProvidersConfig: []*v1alpha1.KMSProviderConfig{
+							{
+								KMSName:     "vault",
+								KMSEndpoint: "/var/run/kmsplugin/vault.sock",
+							},
+							{
+								KMSName:     "vault",
+								KMSEndpoint: "vault.sock",
+								KMSTimeout:  -time.Second,
+							},
+							{
+								KMSName:     "aws:kms",
+								KMSEndpoint: "/var/run/kmsplugin/aws.sock",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* duplicate API server KMS provider \"vault\"\n" +
+				"\t* API server KMS provider \"vault\": endpoint should be an absolute path to the Unix socket: \"vault.sock\"\n" +
+				"\t* API server KMS provider \"vault\": timeout can't be negative\n" +
+				"\t* API server KMS provider name \"aws:kms\" can't contain ':'\n\n",
+		},
+		{
+			name: "AcceptedCAs",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterAcceptedCAs: []*x509.PEMEncodedCertificateAndKey{
+						{
+							Crt: acceptedCA.CrtPEM,
+						},
+					},
+					ClusterAcceptedServiceAccountKeys: []*x509.PEMEncodedKey{
+						{
+							Key: acceptedServiceAccountKey.KeyPEM,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AcceptedCAsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterAcceptedCAs: []*x509.PEMEncodedCertificateAndKey{
+						{
+							Key: acceptedCA.KeyPEM,
+						},
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					ClusterAcceptedServiceAccountKeys: []*x509.PEMEncodedKey{
+						{
+							Key: acceptedCA.CrtPEM,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* cluster accepted CA 0: certificate is required\n" +
+				"\t* cluster accepted CA 1: failed to parse PEM block\n" +
+				"\t* cluster accepted service account key 0: unsupported key type \"CERTIFICATE\"\n\n",
+		},
+		{
+			name: "MachineAcceptedCAs",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificateAndKey{
+						{
+							Crt: acceptedCA.CrtPEM,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MachineAcceptedCAsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificateAndKey{
+						nil,
+						{
+							Crt: []byte("foo"),
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* machine accepted CA 0: certificate is required\n" +
+				"\t* machine accepted CA 1: failed to parse PEM block\n\n",
+		},
+		{
+			name: "ControlPlaneResources",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						ResourcesConfig: &v1alpha1.ResourcesConfig{
+							RequestsConfig: v1alpha1.Unstructured{
+								Object: map[string]interface{}{
+									"cpu":    1,
+									"memory": "1Gi",
+								},
+							},
+							LimitsConfig: v1alpha1.Unstructured{
+								Object: map[string]interface{}{
+									"cpu":    "1500m",
+									"memory": "2.5Gi",
+								},
+							},
+						},
+					},
+					SchedulerConfig: &v1alpha1.SchedulerConfig{
+						ResourcesConfig: &v1alpha1.ResourcesConfig{
+							LimitsConfig: v1alpha1.Unstructured{
+								Object: map[string]interface{}{
+									"memory": "256Mi",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ControlPlaneResourcesInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						ResourcesConfig: &v1alpha1.ResourcesConfig{
+							RequestsConfig: v1alpha1.Unstructured{
+								Object: map[string]interface{}{
+									"cpu":     "one",
+									"memory":  "5iii",
+									"storage": "1Gi",
+								},
+							},
+						},
+					},
+					ControllerManagerConfig: &v1alpha1.ControllerManagerConfig{
+						ResourcesConfig: &v1alpha1.ResourcesConfig{
+							RequestsConfig: v1alpha1.Unstructured{
+								Object: map[string]interface{}{
+									"cpu": "1..2",
+								},
+							},
+							LimitsConfig: v1alpha1.Unstructured{
+								Object: map[string]interface{}{
+									"memory": "-1Gi",
+								},
+							},
+						},
+					},
+					SchedulerConfig: &v1alpha1.SchedulerConfig{
+						ResourcesConfig: &v1alpha1.ResourcesConfig{
+							RequestsConfig: v1alpha1.Unstructured{
+								Object: map[string]interface{}{
+									"cpu":    "1500m",
+									"memory": "1e9",
+								},
+							},
+							LimitsConfig: v1alpha1.Unstructured{
+								Object: map[string]interface{}{
+									"cpu":    1,
+									"memory": "1Gi",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: "6 errors occurred:\n\t* API server resource requests: invalid cpu quantity \"one\"\n" +
+				"\t* API server resource requests: invalid memory quantity \"5iii\"\n" +
+				"\t* API server resource requests: unsupported resource \"storage\"\n" +
+				"\t* controller manager resource requests: invalid cpu quantity \"1..2\"\n" +
+				"\t* controller manager resource limits: invalid memory quantity \"-1Gi\"\n" +
+				"\t* scheduler resource requests: cpu request \"1500m\" exceeds the limit \"1\"\n\n",
+		},
+		{
+			name: "ControlPlanePriorityClassName",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						PriorityClassNameConfig: "system-node-critical",
+					},
+					ControllerManagerConfig: &v1alpha1.ControllerManagerConfig{
+						PriorityClassNameConfig: "Control_Plane",
+					},
+					SchedulerConfig: &v1alpha1.SchedulerConfig{
+						PriorityClassNameConfig: "-scheduler",
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* controller manager priority class name \"Control_Plane\" is not a valid DNS subdomain name\n" +
+				"\t* scheduler priority class name \"-scheduler\" is not a valid DNS subdomain name\n\n",
+		},
+		{
+			name: "APIServerAuditLogInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						AuditLogConfig: &v1alpha1.AuditLogConfig{
+							AuditLogMaxAge:     pointer.To(-1),
+							AuditLogMaxBackups: pointer.To(0),
+							AuditLogMaxSize:    pointer.To(0),
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* API server audit log maxAge should not be negative: -1\n" +
+				"\t* API server audit log maxSize should be positive: 0\n\n",
+		},
+		{
+			name: "APIServerOIDC",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						OIDCConfig: &v1alpha1.OIDCConfig{
+							OIDCIssuerURL:         "https://accounts.example.com/realms/talos",
+							OIDCClientID:          "kubernetes",
+							OIDCSigningAlgorithms: []string{"RS256", "ES256"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "APIServerOIDCInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						ExtraArgsConfig: map[string]string{
+							"oidc-issuer-url": "https://accounts.example.com",
+						},
+						OIDCConfig: &v1alpha1.OIDCConfig{
+							OIDCIssuerURL:         "http://accounts.example.com",
+							OIDCSigningAlgorithms: []string{"HS256"},
+							OIDCCA: &x509.PEMEncodedCertificateAndKey{
+								Crt: []byte("not a certificate"),
+							},
+						},
+					},
+				},
+			},
+			expectedError: "5 errors occurred:\n\t* API server OIDC issuer URL should be an https:// URL: \"http://accounts.example.com\"\n" +
+				"\t* API server OIDC client ID is required\n" +
+				"\t* API server OIDC signing algorithm \"HS256\" is not supported\n" +
+				"\t* API server OIDC CA: failed to parse PEM block\n" +
+				"\t* API server extra argument \"oidc-issuer-url\" conflicts with the OIDC configuration\n\n",
+		},
+		{
+			name: "VolumeGroups",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineVolumeGroups: []*v1alpha1.VolumeGroup{
+						{
+							VolumeGroupName:            "data",
+							VolumeGroupPhysicalVolumes: []string{"/dev/sdb", "/dev/sdc"},
+							VolumeGroupLogicalVolumes: []*v1alpha1.LogicalVolume{
+								{
+									LogicalVolumeName:       "mirror",
+									LogicalVolumeType:       "raid1",
+									LogicalVolumeSize:       100 * 1024 * 1024 * 1024,
+									LogicalVolumeMountPoint: "/var/mnt/mirror",
+								},
+								{
+									LogicalVolumeName:       "scratch",
+									LogicalVolumeMountPoint: "/var/mnt/scratch",
+									LogicalVolumeFilesystem: "ext4",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VolumeGroupsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/sdb",
+						},
+					},
+					MachineVolumeGroups: []*v1alpha1.VolumeGroup{
+						{
+							VolumeGroupName:            "data",
+							VolumeGroupPhysicalVolumes: []string{"/dev/sdb", "/dev/sdc"},
+							VolumeGroupLogicalVolumes: []*v1alpha1.LogicalVolume{
+								{
+									LogicalVolumeName: "all",
+								},
+								{
+									LogicalVolumeName:       "fast",
+									LogicalVolumeType:       "raid10",
+									LogicalVolumeSize:       1024 * 1024 * 1024,
+									LogicalVolumeMountPoint: "/mnt/fast",
+								},
+								{
+									LogicalVolumeName:       "fast",
+									LogicalVolumeType:       "raid5",
+									LogicalVolumeMirrors:    1,
+									LogicalVolumeSize:       1024 * 1024 * 1024,
+									LogicalVolumeFilesystem: "btrfs",
+								},
+							},
+						},
+						{
+							VolumeGroupName: "-data",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "9 errors occurred:\n\t* volume group \"data\": device \"/dev/sdb\" is already used by machine disks\n" +
+				"\t* volume group \"data\": logical volume \"all\" is set to occupy all free space, but it's not the last logical volume in the list\n" +
+				"\t* volume group \"data\": logical volume \"fast\" of type raid10 requires at least 4 physical volumes\n" +
+				"\t* volume group \"data\": logical volume \"fast\": mount point \"/mnt/fast\" should be under /var\n" +
+				"\t* volume group \"data\": duplicate logical volume \"fast\"\n" +
+				"\t* volume group \"data\": logical volume \"fast\": unsupported type \"raid5\"\n" +
+				"\t* volume group \"data\": logical volume \"fast\": unsupported filesystem \"btrfs\"\n" +
+				"\t* volume group: invalid name \"-data\"\n" +
+				"\t* volume group \"-data\": at least one physical volume is required\n\n",
+		},
+		{
+			name: "DiscoveryServiceEndpoint",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ClusterID:     "foo",
+					ClusterSecret: "bar",
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+						DiscoveryEnabled: pointer.To(true),
+						DiscoveryRegistries: v1alpha1.DiscoveryRegistriesConfig{
+							RegistryService: v1alpha1.RegistryServiceConfig{
+								RegistryEndpoint: "foo",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* cluster discovery service registry endpoint is invalid: parse \"foo\": invalid URI for request\n\n",
+		},
+		{
+			name: "DiscoveryServiceClusterIDSecret",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+						DiscoveryEnabled: pointer.To(true),
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* cluster discovery service requires .cluster.id\n\t* cluster discovery service requires .cluster.secret\n" +
+				"\t* cluster discovery Kubernetes registry requires .cluster.secret\n\n",
+		},
+		{
+			name: "DiscoveryKubernetesAcceptedSecrets",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterSecret: "I+1In7fLnpcRIjUmEoeugZnSyFoTF6MztLxICL5Yu0s=",
+					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+						DiscoveryEnabled: pointer.To(true),
+						DiscoveryRegistries: v1alpha1.DiscoveryRegistriesConfig{
+							RegistryKubernetes: v1alpha1.RegistryKubernetesConfig{
+								RegistryAcceptedSecrets: []string{"aHWbdz5cMjxIzlQNUUGNxiIa3bmcsCWDOw7xAVp1pbU=", "not base64!"},
+							},
+							RegistryService: v1alpha1.RegistryServiceConfig{
+								RegistryDisabled: pointer.To(true),
+							},
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* cluster discovery Kubernetes registry accepted secret is invalid: illegal base64 data at input byte 3\n\n",
+		},
+		{
+			name: "GoodEtcdSubnet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA: &x509.PEMEncodedCertificateAndKey{},
+						EtcdAdvertisedSubnets: []string{
+							"10.0.0.0/8",
+							"!1.1.1.1/32",
+						},
+						EtcdListenSubnets: []string{
+							"10.0.0.0/8",
+							"1.1.1.1/32",
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "BadEtcdSubnet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA: &x509.PEMEncodedCertificateAndKey{},
+						EtcdAdvertisedSubnets: []string{
+							"1234:",
+						},
+						EtcdListenSubnets: []string{
+							"10",
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* etcd advertised subnet is not valid: \"1234:\"\n\t* etcd listen subnet is not valid: \"10\"\n\n",
+		},
+		{
+			name: "GoodKubeletSubnet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletNodeIP: &v1alpha1.KubeletNodeIPConfig{
+							KubeletNodeIPValidSubnets: []string{
+								"10.0.0.0/8",
+								"!10.0.0.3/32",
+								"!fd00::169:254:2:53/128",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "BadKubeletSubnet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletNodeIP: &v1alpha1.KubeletNodeIPConfig{
+							KubeletNodeIPValidSubnets: []string{
+								"10.0.0.0.3",
+								"[fd00::169:254:2:53]:344",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n" +
+				"\t* kubelet nodeIP subnet is not valid: \"10.0.0.0.3\"\n" +
+				"\t* kubelet nodeIP subnet is not valid: \"[fd00::169:254:2:53]:344\"\n" +
+				"\n",
+		},
+		{
+			name: "BadKubeletExtraConfig",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletExtraConfig: v1alpha1.Unstructured{
+							Object: map[string]interface{}{
+								"port": 345,
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* kubelet configuration field \"port\" can't be overridden\n\n",
+		},
+		{
+			name: "KubeletCredentialProviderConfig",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletCredentialProviderConfig: v1alpha1.Unstructured{
+							Object: map[string]interface{}{
+								"providers": []interface{}{
+									map[string]interface{}{
+										"name":                 "ecr-credential-provider",
+										"apiVersion":           "credentialprovider.kubelet.k8s.io/v1beta1",
+										"matchImages":          []interface{}{"*.dkr.ecr.*.amazonaws.com"},
+										"defaultCacheDuration": "12h",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BadKubeletCredentialProviderConfig",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletCredentialProviderConfig: v1alpha1.Unstructured{
+							Object: map[string]interface{}{
+								"providers": []interface{}{
+									map[string]interface{}{
+										"name":                 "../bin/sh",
+										"apiVersion":           "credentialprovider.kubelet.k8s.io/v1beta1",
+										"defaultCacheDuration": "12 hours",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n" +
+				"\t* [machine.kubelet.credentialProviderConfig] provider 0: invalid name \"../bin/sh\"\n" +
+				"\t* [machine.kubelet.credentialProviderConfig] provider 0: matchImages is required\n" +
+				"\t* [machine.kubelet.credentialProviderConfig] provider 0: invalid defaultCacheDuration: time: unknown unit \" hours\" in duration \"12 hours\"\n\n",
+		},
+		{
+			name: "BadKubeletCredentialProviderConfigVersion",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletCredentialProviderConfig: v1alpha1.Unstructured{
+							Object: map[string]interface{}{
+								"apiVersion": "kubelet.config.k8s.io/v1alpha1",
+								"kind":       "CredentialProviderConfig",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n" +
+				"\t* [machine.kubelet.credentialProviderConfig] unsupported credential provider configuration kubelet.config.k8s.io/v1alpha1/CredentialProviderConfig\n\n",
 		},
 		{
 			name: "DeviceInterfaceInvalid",
@@ -9,6 +9,10 @@
 
 package v1alpha1
 
+import (
+	x509 "github.com/siderolabs/crypto/x509"
+)
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerConfig) DeepCopyInto(out *APIServerConfig) {
 	*out = *in
@@ -53,6 +57,32 @@ func (in *APIServerConfig) DeepCopyInto(out *APIServerConfig) {
 		}
 	}
 	in.AuditPolicyConfig.DeepCopyInto(&out.AuditPolicyConfig)
+	if in.KMSProvidersConfig != nil {
+		in, out := &in.KMSProvidersConfig, &out.KMSProvidersConfig
+		*out = make([]*KMSProviderConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(KMSProviderConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ResourcesConfig != nil {
+		in, out := &in.ResourcesConfig, &out.ResourcesConfig
+		*out = new(ResourcesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuditLogConfig != nil {
+		in, out := &in.AuditLogConfig, &out.AuditLogConfig
+		*out = new(AuditLogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OIDCConfig != nil {
+		in, out := &in.OIDCConfig, &out.OIDCConfig
+		*out = new(OIDCConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -125,6 +155,37 @@ func (in AdmissionPluginConfigList) DeepCopy() AdmissionPluginConfigList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogConfig) DeepCopyInto(out *AuditLogConfig) {
+	*out = *in
+	if in.AuditLogMaxAge != nil {
+		in, out := &in.AuditLogMaxAge, &out.AuditLogMaxAge
+		*out = new(int)
+		**out = **in
+	}
+	if in.AuditLogMaxBackups != nil {
+		in, out := &in.AuditLogMaxBackups, &out.AuditLogMaxBackups
+		*out = new(int)
+		**out = **in
+	}
+	if in.AuditLogMaxSize != nil {
+		in, out := &in.AuditLogMaxSize, &out.AuditLogMaxSize
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogConfig.
+func (in *AuditLogConfig) DeepCopy() *AuditLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Base64Bytes) DeepCopyInto(out *Base64Bytes) {
 	{
@@ -240,6 +301,16 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		in, out := &in.ClusterCA, &out.ClusterCA
 		*out = (*in).DeepCopy()
 	}
+	if in.ClusterAcceptedCAs != nil {
+		in, out := &in.ClusterAcceptedCAs, &out.ClusterAcceptedCAs
+		*out = make([]*x509.PEMEncodedCertificateAndKey, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = (*in).DeepCopy()
+			}
+		}
+	}
 	if in.ClusterAggregatorCA != nil {
 		in, out := &in.ClusterAggregatorCA, &out.ClusterAggregatorCA
 		*out = (*in).DeepCopy()
@@ -248,6 +319,16 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		in, out := &in.ClusterServiceAccount, &out.ClusterServiceAccount
 		*out = (*in).DeepCopy()
 	}
+	if in.ClusterAcceptedServiceAccountKeys != nil {
+		in, out := &in.ClusterAcceptedServiceAccountKeys, &out.ClusterAcceptedServiceAccountKeys
+		*out = make([]*x509.PEMEncodedKey, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = (*in).DeepCopy()
+			}
+		}
+	}
 	if in.APIServerConfig != nil {
 		in, out := &in.APIServerConfig, &out.APIServerConfig
 		*out = new(APIServerConfig)
@@ -300,10 +381,22 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.ExtraManifestsPruneConfig != nil {
+		in, out := &in.ExtraManifestsPruneConfig, &out.ExtraManifestsPruneConfig
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ClusterInlineManifests != nil {
 		in, out := &in.ClusterInlineManifests, &out.ClusterInlineManifests
 		*out = make(ClusterInlineManifests, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BootstrapManifestsPruneConfig != nil {
+		in, out := &in.BootstrapManifestsPruneConfig, &out.BootstrapManifestsPruneConfig
+		*out = new(bool)
+		**out = **in
 	}
 	if in.AdminKubeconfigConfig != nil {
 		in, out := &in.AdminKubeconfigConfig, &out.AdminKubeconfigConfig
@@ -320,6 +413,11 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KubeletServingCertificateApproverDisabled != nil {
+		in, out := &in.KubeletServingCertificateApproverDisabled, &out.KubeletServingCertificateApproverDisabled
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -358,6 +456,11 @@ func (in *ClusterDiscoveryConfig) DeepCopy() *ClusterDiscoveryConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterInlineManifest) DeepCopyInto(out *ClusterInlineManifest) {
 	*out = *in
+	if in.InlineManifestPrune != nil {
+		in, out := &in.InlineManifestPrune, &out.InlineManifestPrune
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -376,7 +479,9 @@ func (in ClusterInlineManifests) DeepCopyInto(out *ClusterInlineManifests) {
 	{
 		in := &in
 		*out = make(ClusterInlineManifests, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 		return
 	}
 }
@@ -500,6 +605,11 @@ func (in *ControllerManagerConfig) DeepCopyInto(out *ControllerManagerConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.ResourcesConfig != nil {
+		in, out := &in.ResourcesConfig, &out.ResourcesConfig
+		*out = new(ResourcesConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -547,6 +657,16 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DHCPUserClasses != nil {
+		in, out := &in.DHCPUserClasses, &out.DHCPUserClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DHCPRequestedOptions != nil {
+		in, out := &in.DHCPRequestedOptions, &out.DHCPRequestedOptions
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -635,6 +755,11 @@ func (in *Device) DeepCopyInto(out *Device) {
 		*out = new(DeviceVIPConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeviceIPv6Config != nil {
+		in, out := &in.DeviceIPv6Config, &out.DeviceIPv6Config
+		*out = new(DeviceIPv6Config)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -648,6 +773,27 @@ func (in *Device) DeepCopy() *Device {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceIPv6Config) DeepCopyInto(out *DeviceIPv6Config) {
+	*out = *in
+	if in.IPv6AcceptRA != nil {
+		in, out := &in.IPv6AcceptRA, &out.IPv6AcceptRA
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceIPv6Config.
+func (in *DeviceIPv6Config) DeepCopy() *DeviceIPv6Config {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceIPv6Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceVIPConfig) DeepCopyInto(out *DeviceVIPConfig) {
 	*out = *in
@@ -661,6 +807,11 @@ func (in *DeviceVIPConfig) DeepCopyInto(out *DeviceVIPConfig) {
 		*out = new(VIPHCloudConfig)
 		**out = **in
 	}
+	if in.BGPConfig != nil {
+		in, out := &in.BGPConfig, &out.BGPConfig
+		*out = new(VIPBGPConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -743,6 +894,11 @@ func (in *DiscoveryRegistriesConfig) DeepCopy() *DiscoveryRegistriesConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskPartition) DeepCopyInto(out *DiskPartition) {
 	*out = *in
+	if in.DiskMountOptions != nil {
+		in, out := &in.DiskMountOptions, &out.DiskMountOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -966,6 +1122,27 @@ func (in *FeaturesConfig) DeepCopy() *FeaturesConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageGCConfig) DeepCopyInto(out *ImageGCConfig) {
+	*out = *in
+	if in.ImageGCEnabled != nil {
+		in, out := &in.ImageGCEnabled, &out.ImageGCEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageGCConfig.
+func (in *ImageGCConfig) DeepCopy() *ImageGCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageGCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallConfig) DeepCopyInto(out *InstallConfig) {
 	*out = *in
@@ -999,6 +1176,11 @@ func (in *InstallConfig) DeepCopyInto(out *InstallConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.InstallGrowPartitions != nil {
+		in, out := &in.InstallGrowPartitions, &out.InstallGrowPartitions
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1082,6 +1264,27 @@ func (in *InstallExtensionConfig) DeepCopy() *InstallExtensionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSProviderConfig) DeepCopyInto(out *KMSProviderConfig) {
+	*out = *in
+	if in.KMSDecryptOnly != nil {
+		in, out := &in.KMSDecryptOnly, &out.KMSDecryptOnly
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSProviderConfig.
+func (in *KMSProviderConfig) DeepCopy() *KMSProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KernelConfig) DeepCopyInto(out *KernelConfig) {
 	*out = *in
@@ -1153,6 +1356,7 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 		}
 	}
 	in.KubeletExtraConfig.DeepCopyInto(&out.KubeletExtraConfig)
+	in.KubeletCredentialProviderConfig.DeepCopyInto(&out.KubeletCredentialProviderConfig)
 	if in.KubeletDefaultRuntimeSeccompProfileEnabled != nil {
 		in, out := &in.KubeletDefaultRuntimeSeccompProfileEnabled, &out.KubeletDefaultRuntimeSeccompProfileEnabled
 		*out = new(bool)
@@ -1286,6 +1490,22 @@ func (in *LoggingDestination) DeepCopy() *LoggingDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalVolume) DeepCopyInto(out *LogicalVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalVolume.
+func (in *LogicalVolume) DeepCopy() *LogicalVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 	*out = *in
@@ -1293,6 +1513,16 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 		in, out := &in.MachineCA, &out.MachineCA
 		*out = (*in).DeepCopy()
 	}
+	if in.MachineAcceptedCAs != nil {
+		in, out := &in.MachineAcceptedCAs, &out.MachineAcceptedCAs
+		*out = make([]*x509.PEMEncodedCertificateAndKey, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = (*in).DeepCopy()
+			}
+		}
+	}
 	if in.MachineCertSANs != nil {
 		in, out := &in.MachineCertSANs, &out.MachineCertSANs
 		*out = make([]string, len(*in))
@@ -1331,6 +1561,17 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			}
 		}
 	}
+	if in.MachineVolumeGroups != nil {
+		in, out := &in.MachineVolumeGroups, &out.MachineVolumeGroups
+		*out = make([]*VolumeGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VolumeGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.MachineInstall != nil {
 		in, out := &in.MachineInstall, &out.MachineInstall
 		*out = new(InstallConfig)
@@ -1399,6 +1640,11 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 		*out = new(KernelConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MachineImageGC != nil {
+		in, out := &in.MachineImageGC, &out.MachineImageGC
+		*out = new(ImageGCConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MachineSeccompProfiles != nil {
 		in, out := &in.MachineSeccompProfiles, &out.MachineSeccompProfiles
 		*out = make([]*MachineSeccompProfile, len(*in))
@@ -1410,6 +1656,27 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			}
 		}
 	}
+	if in.MachineNodeLabels != nil {
+		in, out := &in.MachineNodeLabels, &out.MachineNodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MachineNodeAnnotations != nil {
+		in, out := &in.MachineNodeAnnotations, &out.MachineNodeAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MachineNodeTaints != nil {
+		in, out := &in.MachineNodeTaints, &out.MachineNodeTaints
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1480,7 +1747,7 @@ func (in *MachineDisk) DeepCopyInto(out *MachineDisk) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(DiskPartition)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -1591,6 +1858,33 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NetworkProbes != nil {
+		in, out := &in.NetworkProbes, &out.NetworkProbes
+		*out = make([]*NetworkProbe, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkProbe)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.NetworkRules != nil {
+		in, out := &in.NetworkRules, &out.NetworkRules
+		*out = make([]*NetworkRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkRule)
+				**out = **in
+			}
+		}
+	}
+	if in.NetworkLLDP != nil {
+		in, out := &in.NetworkLLDP, &out.NetworkLLDP
+		*out = new(NetworkLLDP)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1682,6 +1976,154 @@ func (in *NetworkKubeSpan) DeepCopy() *NetworkKubeSpan {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkLLDP) DeepCopyInto(out *NetworkLLDP) {
+	*out = *in
+	if in.LLDPTransmit != nil {
+		in, out := &in.LLDPTransmit, &out.LLDPTransmit
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkLLDP.
+func (in *NetworkLLDP) DeepCopy() *NetworkLLDP {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkLLDP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProbe) DeepCopyInto(out *NetworkProbe) {
+	*out = *in
+	if in.ProbeTCP != nil {
+		in, out := &in.ProbeTCP, &out.ProbeTCP
+		*out = new(NetworkProbeTCP)
+		**out = **in
+	}
+	if in.ProbeICMP != nil {
+		in, out := &in.ProbeICMP, &out.ProbeICMP
+		*out = new(NetworkProbeICMP)
+		**out = **in
+	}
+	if in.ProbeHTTP != nil {
+		in, out := &in.ProbeHTTP, &out.ProbeHTTP
+		*out = new(NetworkProbeHTTP)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProbe.
+func (in *NetworkProbe) DeepCopy() *NetworkProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProbeHTTP) DeepCopyInto(out *NetworkProbeHTTP) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProbeHTTP.
+func (in *NetworkProbeHTTP) DeepCopy() *NetworkProbeHTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProbeHTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProbeICMP) DeepCopyInto(out *NetworkProbeICMP) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProbeICMP.
+func (in *NetworkProbeICMP) DeepCopy() *NetworkProbeICMP {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProbeICMP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProbeTCP) DeepCopyInto(out *NetworkProbeTCP) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProbeTCP.
+func (in *NetworkProbeTCP) DeepCopy() *NetworkProbeTCP {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProbeTCP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRule) DeepCopyInto(out *NetworkRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRule.
+func (in *NetworkRule) DeepCopy() *NetworkRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
+	*out = *in
+	if in.OIDCRequiredClaims != nil {
+		in, out := &in.OIDCRequiredClaims, &out.OIDCRequiredClaims
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.OIDCSigningAlgorithms != nil {
+		in, out := &in.OIDCSigningAlgorithms, &out.OIDCSigningAlgorithms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OIDCCA != nil {
+		in, out := &in.OIDCCA, &out.OIDCCA
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfig.
+func (in *OIDCConfig) DeepCopy() *OIDCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodCheckpointer) DeepCopyInto(out *PodCheckpointer) {
 	*out = *in
@@ -1822,6 +2264,16 @@ func (in *RegistryKubernetesConfig) DeepCopyInto(out *RegistryKubernetesConfig)
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RegistryAcceptedSecrets != nil {
+		in, out := &in.RegistryAcceptedSecrets, &out.RegistryAcceptedSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RegistryLegacyAnnotations != nil {
+		in, out := &in.RegistryLegacyAnnotations, &out.RegistryLegacyAnnotations
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1907,6 +2359,24 @@ func (in *RegistryTLSConfig) DeepCopy() *RegistryTLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcesConfig) DeepCopyInto(out *ResourcesConfig) {
+	*out = *in
+	in.RequestsConfig.DeepCopyInto(&out.RequestsConfig)
+	in.LimitsConfig.DeepCopyInto(&out.LimitsConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcesConfig.
+func (in *ResourcesConfig) DeepCopy() *ResourcesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
@@ -1966,6 +2436,11 @@ func (in *SchedulerConfig) DeepCopyInto(out *SchedulerConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.ResourcesConfig != nil {
+		in, out := &in.ResourcesConfig, &out.ResourcesConfig
+		*out = new(ResourcesConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2059,6 +2534,49 @@ func (in *Unstructured) DeepCopyInto(out *Unstructured) {
 	return
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VIPBGPConfig) DeepCopyInto(out *VIPBGPConfig) {
+	*out = *in
+	if in.BGPPeers != nil {
+		in, out := &in.BGPPeers, &out.BGPPeers
+		*out = make([]*VIPBGPPeer, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VIPBGPPeer)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VIPBGPConfig.
+func (in *VIPBGPConfig) DeepCopy() *VIPBGPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VIPBGPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VIPBGPPeer) DeepCopyInto(out *VIPBGPPeer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VIPBGPPeer.
+func (in *VIPBGPPeer) DeepCopy() *VIPBGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(VIPBGPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VIPEquinixMetalConfig) DeepCopyInto(out *VIPEquinixMetalConfig) {
 	*out = *in
@@ -2138,6 +2656,38 @@ func (in *Vlan) DeepCopy() *Vlan {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeGroup) DeepCopyInto(out *VolumeGroup) {
+	*out = *in
+	if in.VolumeGroupPhysicalVolumes != nil {
+		in, out := &in.VolumeGroupPhysicalVolumes, &out.VolumeGroupPhysicalVolumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeGroupLogicalVolumes != nil {
+		in, out := &in.VolumeGroupLogicalVolumes, &out.VolumeGroupLogicalVolumes
+		*out = make([]*LogicalVolume, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LogicalVolume)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeGroup.
+func (in *VolumeGroup) DeepCopy() *VolumeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMountConfig) DeepCopyInto(out *VolumeMountConfig) {
 	*out = *in
@@ -232,12 +232,30 @@ const (
 	// KubernetesAuditLogDir defines the ephemeral directory where the kube-apiserver will store its audit logs.
 	KubernetesAuditLogDir = EphemeralMountPoint + "/" + "log" + "/" + "audit" + "/" + "kube"
 
+	// KubernetesAuditLogID is the log ID of the kube-apiserver audit log forwarded by Talos.
+	KubernetesAuditLogID = "kube-apiserver-audit"
+
+	// KubernetesAuditLogDefaultMaxAge is the default number of days to retain the rotated kube-apiserver audit logs.
+	KubernetesAuditLogDefaultMaxAge = 30
+
+	// KubernetesAuditLogDefaultMaxBackups is the default number of the rotated kube-apiserver audit logs to retain.
+	KubernetesAuditLogDefaultMaxBackups = 10
+
+	// KubernetesAuditLogDefaultMaxSize is the default size in megabytes of the kube-apiserver audit log before it gets rotated.
+	KubernetesAuditLogDefaultMaxSize = 100
+
 	// KubernetesAPIServerSecretsDir defines directory with kube-apiserver secrets.
 	KubernetesAPIServerSecretsDir = KubebernetesStaticSecretsDir + "/" + "kube-apiserver"
 
 	// KubernetesAPIServerConfigDir defines directory with kube-apiserver configs.
 	KubernetesAPIServerConfigDir = KubebernetesStaticConfigDir + "/" + "kube-apiserver"
 
+	// KubernetesAPIServerKMSDefaultTimeout is the default timeout for the kube-apiserver calls to the KMS plugin.
+	KubernetesAPIServerKMSDefaultTimeout = 3 * time.Second
+
+	// KubernetesControlPlaneDefaultPriorityClassName is the default priority class of the control plane static pods.
+	KubernetesControlPlaneDefaultPriorityClassName = "system-cluster-critical"
+
 	// KubernetesControllerManagerSecretsDir defines ephemeral directory with kube-controller-manager secrets.
 	KubernetesControllerManagerSecretsDir = KubebernetesStaticSecretsDir + "/" + "kube-controller-manager"
 
@@ -278,6 +296,14 @@ const (
 	// SystemKubeletPKIDir is the path to the directory where Talos copies kubelet issued certificates and keys.
 	SystemKubeletPKIDir = "/system/secrets/kubelet"
 
+	// KubeletCredentialProviderBinDir is the path to the directory with kubelet image credential provider plugin binaries.
+	//
+	// Plugin binaries are installed via system extensions.
+	KubeletCredentialProviderBinDir = "/usr/local/lib/kubelet/credentialproviders"
+
+	// KubeletCredentialProviderConfig is the path to the kubelet image credential provider configuration.
+	KubeletCredentialProviderConfig = "/etc/kubernetes/kubelet-credentialproviderconfig.yaml"
+
 	// KubeletShutdownGracePeriod is the kubelet shutdown grace period.
 	KubeletShutdownGracePeriod = 30 * time.Second
 
@@ -424,6 +450,12 @@ const (
 	// SystemContainerdNamespace is the Containerd namespace for Talos services.
 	SystemContainerdNamespace = "system"
 
+	// DefaultImageGCInterval is the default interval between garbage collection runs for the system containerd namespace images.
+	DefaultImageGCInterval = time.Hour
+
+	// DefaultImageGCMinAge is the default minimum age of the unreferenced system containerd namespace image before it is pruned.
+	DefaultImageGCMinAge = 24 * time.Hour
+
 	// SystemContainerdAddress is the path to the system containerd socket.
 	SystemContainerdAddress = SystemRunPath + "/containerd/containerd.sock"
 
@@ -599,6 +631,15 @@ const (
 	// AnnotationStaticPodConfigFileVersion is the annotation key for the static pod configuration file version.
 	AnnotationStaticPodConfigFileVersion = "talos.dev/config-file-version"
 
+	// AnnotationOwnedLabels is the annotation key for the list of node labels owned by Talos.
+	AnnotationOwnedLabels = "talos.dev/owned-labels"
+
+	// AnnotationOwnedAnnotations is the annotation key for the list of node annotations owned by Talos.
+	AnnotationOwnedAnnotations = "talos.dev/owned-annotations"
+
+	// AnnotationOwnedTaints is the annotation key for the list of node taints owned by Talos.
+	AnnotationOwnedTaints = "talos.dev/owned-taints"
+
 	// DefaultNTPServer is the NTP server to use if not configured explicitly.
 	//
 	// TODO: Once we get naming sorted we need to apply for a project specific address
@@ -661,6 +702,9 @@ const (
 	// ClusterNodeIDAnnotation is the node annotation used to represent node ID.
 	ClusterNodeIDAnnotation = "cluster.talos.dev/node-id"
 
+	// ClusterAffiliateDataAnnotation is the node annotation holding the encrypted and authenticated affiliate data of the node.
+	ClusterAffiliateDataAnnotation = "cluster.talos.dev/affiliate-data"
+
 	// KubeSpanIPAnnotation is the node annotation to be used for indicating the Wireguard IP of the node.
 	KubeSpanIPAnnotation = "networking.talos.dev/kubespan-ip"
 
@@ -679,6 +723,30 @@ const (
 	// KubeSpanLinkMTU is the default link mtu size for the KubeSpan Wireguard interface.
 	KubeSpanLinkMTU = 1420
 
+	// NetworkProbeDefaultInterval is the default interval between network probe attempts.
+	NetworkProbeDefaultInterval = 5 * time.Second
+
+	// NetworkProbeDefaultFailureThreshold is the default number of consecutive failures for the network probe to be considered failed.
+	NetworkProbeDefaultFailureThreshold = 3
+
+	// NetworkProbeDefaultTimeout is the default timeout for a single network probe attempt.
+	NetworkProbeDefaultTimeout = 5 * time.Second
+
+	// LogicalVolumeTypeLinear is the default LVM logical volume type.
+	LogicalVolumeTypeLinear = "linear"
+
+	// LogicalVolumeTypeRAID1 is the LVM RAID1 (mirror) logical volume type.
+	LogicalVolumeTypeRAID1 = "raid1"
+
+	// LogicalVolumeTypeRAID10 is the LVM RAID10 (striped mirror) logical volume type.
+	LogicalVolumeTypeRAID10 = "raid10"
+
+	// UserDiskFilesystemXFS is the default filesystem for user disk partitions.
+	UserDiskFilesystemXFS = "xfs"
+
+	// UserDiskFilesystemExt4 is the ext4 filesystem for user disk partitions.
+	UserDiskFilesystemExt4 = "ext4"
+
 	// UdevRulesPath rules file path.
 	UdevRulesPath = "/usr/etc/udev/rules.d/99-talos.rules"
 
@@ -720,6 +788,12 @@ const (
 	// KubernetesTalosAPIServiceNamespace is the namespace of the Kubernetes service to access Talos API.
 	KubernetesTalosAPIServiceNamespace = "default"
 
+	// KubernetesManifestInventoryLabel is the label applied to the objects from the Talos manifests which are pruned when removed.
+	KubernetesManifestInventoryLabel = "manifests.talos.dev/inventory"
+
+	// KubernetesManifestInventory is the value of the KubernetesManifestInventoryLabel.
+	KubernetesManifestInventory = "talos"
+
 	// TalosDir is the default name of the Talos directory under user home.
 	TalosDir = ".talos"
 
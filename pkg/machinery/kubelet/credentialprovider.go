@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubelet
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Kubelet image credential provider configuration versions supported by Talos.
+const (
+	CredentialProviderConfigAPIVersion = "kubelet.config.k8s.io/v1beta1"
+	CredentialProviderConfigKind       = "CredentialProviderConfig"
+	CredentialProviderAPIVersion       = "credentialprovider.kubelet.k8s.io/v1beta1"
+)
+
+// ValidateCredentialProviderConfig validates the kubelet image credential provider configuration.
+//
+// Missing `apiVersion` and `kind` are allowed, as they are filled in with the defaults.
+//
+//nolint:gocyclo,cyclop
+func ValidateCredentialProviderConfig(cfg map[string]interface{}) error {
+	apiVersion, _ := cfg["apiVersion"].(string) //nolint:errcheck
+	kind, _ := cfg["kind"].(string)             //nolint:errcheck
+
+	if apiVersion == "" {
+		apiVersion = CredentialProviderConfigAPIVersion
+	}
+
+	if kind == "" {
+		kind = CredentialProviderConfigKind
+	}
+
+	if apiVersion != CredentialProviderConfigAPIVersion || kind != CredentialProviderConfigKind {
+		return fmt.Errorf("unsupported credential provider configuration %s/%s", apiVersion, kind)
+	}
+
+	var result *multierror.Error
+
+	providers, ok := cfg["providers"].([]interface{})
+	if !ok || len(providers) == 0 {
+		return multierror.Append(result, fmt.Errorf("at least one provider is required"))
+	}
+
+	for i, p := range providers {
+		provider, ok := p.(map[string]interface{})
+		if !ok {
+			result = multierror.Append(result, fmt.Errorf("provider %d: should be an object", i))
+
+			continue
+		}
+
+		name, _ := provider["name"].(string) //nolint:errcheck
+
+		switch {
+		case name == "":
+			result = multierror.Append(result, fmt.Errorf("provider %d: name is required", i))
+		case strings.ContainsAny(name, `/\`) || name == "." || name == "..":
+			result = multierror.Append(result, fmt.Errorf("provider %d: invalid name %q", i, name))
+		}
+
+		if matchImages, ok := provider["matchImages"].([]interface{}); !ok || len(matchImages) == 0 {
+			result = multierror.Append(result, fmt.Errorf("provider %d: matchImages is required", i))
+		} else {
+			for _, image := range matchImages {
+				if s, ok := image.(string); !ok || s == "" {
+					result = multierror.Append(result, fmt.Errorf("provider %d: invalid matchImages entry %v", i, image))
+				}
+			}
+		}
+
+		switch duration := provider["defaultCacheDuration"].(type) {
+		case nil:
+			result = multierror.Append(result, fmt.Errorf("provider %d: defaultCacheDuration is required", i))
+		case string:
+			if _, err := time.ParseDuration(duration); err != nil {
+				result = multierror.Append(result, fmt.Errorf("provider %d: invalid defaultCacheDuration: %w", i, err))
+			}
+		default:
+			result = multierror.Append(result, fmt.Errorf("provider %d: invalid defaultCacheDuration %v", i, duration))
+		}
+
+		if providerAPIVersion, _ := provider["apiVersion"].(string); providerAPIVersion != CredentialProviderAPIVersion { //nolint:errcheck
+			result = multierror.Append(result, fmt.Errorf("provider %d: unsupported apiVersion %q", i, providerAPIVersion))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
@@ -0,0 +1,244 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package labels validates Kubernetes labels, annotations and taints.
+package labels
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/maps"
+)
+
+const (
+	maxNameLength   = 63
+	maxPrefixLength = 253
+	maxValueLength  = 63
+
+	// TotalAnnotationSizeLimitB is the maximum total size of the annotations (keys and values).
+	TotalAnnotationSizeLimitB = 256 * 1024
+)
+
+// Taint effects supported by Kubernetes.
+const (
+	TaintEffectNoSchedule       = "NoSchedule"
+	TaintEffectPreferNoSchedule = "PreferNoSchedule"
+	TaintEffectNoExecute        = "NoExecute"
+)
+
+var (
+	nameRegexp   = regexp.MustCompile(`^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$`)
+	prefixRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// Labels and label namespaces in the Kubernetes namespaces the kubelet is allowed to set,
+// see k8s.io/kubernetes/pkg/kubelet/apis/well_known_labels.go.
+var (
+	kubeletLabels = map[string]struct{}{
+		"kubernetes.io/hostname":                   {},
+		"kubernetes.io/arch":                       {},
+		"kubernetes.io/os":                         {},
+		"beta.kubernetes.io/arch":                  {},
+		"beta.kubernetes.io/os":                    {},
+		"beta.kubernetes.io/instance-type":         {},
+		"node.kubernetes.io/instance-type":         {},
+		"failure-domain.beta.kubernetes.io/region": {},
+		"failure-domain.beta.kubernetes.io/zone":   {},
+		"topology.kubernetes.io/region":            {},
+		"topology.kubernetes.io/zone":              {},
+	}
+
+	kubeletLabelNamespaces = []string{
+		"kubelet.kubernetes.io",
+		"node.kubernetes.io",
+	}
+
+	kubernetesNamespaces = []string{
+		"kubernetes.io",
+		"k8s.io",
+	}
+)
+
+// ValidateQualifiedName validates the key of a label, annotation or taint.
+//
+// The key consists of an optional DNS subdomain prefix and a name separated by '/'.
+func ValidateQualifiedName(key string) error {
+	prefix, name, found := strings.Cut(key, "/")
+	if !found {
+		name, prefix = prefix, ""
+	} else if prefix == "" {
+		return fmt.Errorf("prefix of %q can't be empty", key)
+	}
+
+	if prefix != "" {
+		if len(prefix) > maxPrefixLength {
+			return fmt.Errorf("prefix of %q is too long: %d > %d", key, len(prefix), maxPrefixLength)
+		}
+
+		if !prefixRegexp.MatchString(prefix) {
+			return fmt.Errorf("prefix of %q should be a valid DNS subdomain", key)
+		}
+	}
+
+	if name == "" {
+		return fmt.Errorf("name of %q can't be empty", key)
+	}
+
+	if len(name) > maxNameLength {
+		return fmt.Errorf("name of %q is too long: %d > %d", key, len(name), maxNameLength)
+	}
+
+	if !nameRegexp.MatchString(name) {
+		return fmt.Errorf("name of %q should consist of alphanumeric characters, '-', '_' or '.', and start and end with an alphanumeric character", key)
+	}
+
+	return nil
+}
+
+// ValidateLabelValue validates the value of a label or taint.
+func ValidateLabelValue(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	if len(value) > maxValueLength {
+		return fmt.Errorf("value %q is too long: %d > %d", value, len(value), maxValueLength)
+	}
+
+	if !nameRegexp.MatchString(value) {
+		return fmt.Errorf("value %q should consist of alphanumeric characters, '-', '_' or '.', and start and end with an alphanumeric character", value)
+	}
+
+	return nil
+}
+
+// Validate validates the node labels.
+//
+// The labels are set with the kubelet credentials, so the labels forbidden by
+// the NodeRestriction admission plugin are rejected.
+func Validate(labels map[string]string) error {
+	var result *multierror.Error
+
+	for _, key := range sortedKeys(labels) {
+		if err := ValidateQualifiedName(key); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid label key: %w", err))
+		} else if err = validateKubeletLabel(key); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if err := ValidateLabelValue(labels[key]); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid label %q: %w", key, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validateKubeletLabel checks that the kubelet is allowed to set the label.
+//
+// NodeRestriction admission plugin forbids the kubelet to set labels in the `kubernetes.io` and `k8s.io` namespaces
+// except for the well-known kubelet labels.
+func validateKubeletLabel(key string) error {
+	prefix, _, found := strings.Cut(key, "/")
+	if !found {
+		return nil
+	}
+
+	if !inNamespace(prefix, kubernetesNamespaces) {
+		return nil
+	}
+
+	if _, ok := kubeletLabels[key]; ok {
+		return nil
+	}
+
+	if inNamespace(prefix, kubeletLabelNamespaces) {
+		return nil
+	}
+
+	return fmt.Errorf("label %q is forbidden for the kubelet by the NodeRestriction admission plugin", key)
+}
+
+func inNamespace(prefix string, namespaces []string) bool {
+	for _, namespace := range namespaces {
+		if prefix == namespace || strings.HasSuffix(prefix, "."+namespace) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateAnnotations validates the annotations.
+//
+// Annotation values are not restricted, only their total size is limited.
+func ValidateAnnotations(annotations map[string]string) error {
+	var result *multierror.Error
+
+	totalSize := 0
+
+	for _, key := range sortedKeys(annotations) {
+		if err := ValidateQualifiedName(key); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid annotation key: %w", err))
+		}
+
+		totalSize += len(key) + len(annotations[key])
+	}
+
+	if totalSize > TotalAnnotationSizeLimitB {
+		result = multierror.Append(result, fmt.Errorf("annotations size %d is larger than limit %d", totalSize, TotalAnnotationSizeLimitB))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// ParseTaint parses the taint in the `value:effect` format (value is optional).
+func ParseTaint(taint string) (value, effect string, err error) {
+	idx := strings.LastIndexByte(taint, ':')
+	if idx == -1 {
+		return "", "", fmt.Errorf("taint %q should be in the 'value:effect' format", taint)
+	}
+
+	value, effect = taint[:idx], taint[idx+1:]
+
+	switch effect {
+	case TaintEffectNoSchedule, TaintEffectPreferNoSchedule, TaintEffectNoExecute:
+	default:
+		return "", "", fmt.Errorf("taint effect %q is not supported", effect)
+	}
+
+	if err = ValidateLabelValue(value); err != nil {
+		return "", "", err
+	}
+
+	return value, effect, nil
+}
+
+// ValidateTaints validates the taints in the `key: value:effect` format.
+func ValidateTaints(taints map[string]string) error {
+	var result *multierror.Error
+
+	for _, key := range sortedKeys(taints) {
+		if err := ValidateQualifiedName(key); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid taint key: %w", err))
+		}
+
+		if _, _, err := ParseTaint(taints[key]); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid taint %q: %w", key, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := maps.Keys(m)
+	sort.Strings(keys)
+
+	return keys
+}
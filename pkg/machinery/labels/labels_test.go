@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package labels_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/labels"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		labels map[string]string
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			labels: map[string]string{
+				"zone":                        "us-east-1a",
+				"example.com/pool":            "gpu_workers.1",
+				"node.example.com/no-value":   "",
+				"topology.kubernetes.io/zone": "us-east-1a",
+				"node.kubernetes.io/exclude-from-external-load-balancers": "",
+			},
+		},
+		{
+			name: "restricted",
+			labels: map[string]string{
+				"node-role.kubernetes.io/worker":           "",
+				"node-restriction.kubernetes.io/dedicated": "gpu",
+				"k8s.io/pool":        "gpu",
+				"example.com/k8s.io": "ok",
+			},
+			expectedError: "3 errors occurred:\n" +
+				"\t* label \"k8s.io/pool\" is forbidden for the kubelet by the NodeRestriction admission plugin\n" +
+				"\t* label \"node-restriction.kubernetes.io/dedicated\" is forbidden for the kubelet by the NodeRestriction admission plugin\n" +
+				"\t* label \"node-role.kubernetes.io/worker\" is forbidden for the kubelet by the NodeRestriction admission plugin\n\n",
+		},
+		{
+			name: "invalid key",
+			labels: map[string]string{
+				"-zone":        "a",
+				"/zone":        "b",
+				"Example.com/": "c",
+			},
+			expectedError: "3 errors occurred:\n" +
+				"\t* invalid label key: name of \"-zone\" should consist of alphanumeric characters, '-', '_' or '.', and start and end with an alphanumeric character\n" +
+				"\t* invalid label key: prefix of \"/zone\" can't be empty\n" +
+				"\t* invalid label key: prefix of \"Example.com/\" should be a valid DNS subdomain\n\n",
+		},
+		{
+			name: "invalid value",
+			labels: map[string]string{
+				"zone": "a b",
+				"pool": strings.Repeat("a", 64),
+			},
+			expectedError: "2 errors occurred:\n" +
+				"\t* invalid label \"pool\": value \"" + strings.Repeat("a", 64) + "\" is too long: 64 > 63\n" +
+				"\t* invalid label \"zone\": value \"a b\" should consist of alphanumeric characters, '-', '_' or '.', and start and end with an alphanumeric character\n\n",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := labels.Validate(test.labels)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestValidateAnnotations(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, labels.ValidateAnnotations(map[string]string{
+		"example.com/description": "any value: goes here",
+	}))
+
+	assert.EqualError(t, labels.ValidateAnnotations(map[string]string{
+		"example.com/description": strings.Repeat("a", labels.TotalAnnotationSizeLimitB),
+	}), "1 error occurred:\n\t* annotations size 262167 is larger than limit 262144\n\n")
+}
+
+func TestParseTaint(t *testing.T) {
+	t.Parallel()
+
+	value, effect, err := labels.ParseTaint("gpu:NoSchedule")
+	require.NoError(t, err)
+	assert.Equal(t, "gpu", value)
+	assert.Equal(t, labels.TaintEffectNoSchedule, effect)
+
+	value, effect, err = labels.ParseTaint(":NoExecute")
+	require.NoError(t, err)
+	assert.Equal(t, "", value)
+	assert.Equal(t, labels.TaintEffectNoExecute, effect)
+
+	_, _, err = labels.ParseTaint("gpu")
+	assert.EqualError(t, err, "taint \"gpu\" should be in the 'value:effect' format")
+
+	_, _, err = labels.ParseTaint("gpu:Schedule")
+	assert.EqualError(t, err, "taint effect \"Schedule\" is not supported")
+}
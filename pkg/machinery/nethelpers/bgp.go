@@ -0,0 +1,21 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import "net/netip"
+
+// BGPDefaultPort is the default port of the BGP peer.
+const BGPDefaultPort = 179
+
+// ParseBGPPeerAddress parses BGP peer address in the form of IP or IP:port.
+//
+// If the port is not specified, BGPDefaultPort is used.
+func ParseBGPPeerAddress(address string) (netip.AddrPort, error) {
+	if addr, err := netip.ParseAddr(address); err == nil {
+		return netip.AddrPortFrom(addr, BGPDefaultPort), nil
+	}
+
+	return netip.ParseAddrPort(address)
+}
@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+func TestParseBGPPeerAddress(t *testing.T) {
+	for _, tt := range []struct {
+		address  string
+		expected netip.AddrPort
+	}{
+		{
+			address:  "192.168.1.1",
+			expected: netip.MustParseAddrPort("192.168.1.1:179"),
+		},
+		{
+			address:  "192.168.1.1:1179",
+			expected: netip.MustParseAddrPort("192.168.1.1:1179"),
+		},
+		{
+			address:  "2001:db8::1",
+			expected: netip.MustParseAddrPort("[2001:db8::1]:179"),
+		},
+		{
+			address:  "[2001:db8::1]:1179",
+			expected: netip.MustParseAddrPort("[2001:db8::1]:1179"),
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.address, func(t *testing.T) {
+			addr, err := nethelpers.ParseBGPPeerAddress(tt.address)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, addr)
+		})
+	}
+
+	_, err := nethelpers.ParseBGPPeerAddress("router.example.com")
+	assert.Error(t, err)
+}
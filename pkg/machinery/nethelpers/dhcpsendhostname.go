@@ -0,0 +1,17 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=DHCPSendHostname -linecomment -text
+
+// DHCPSendHostname is the hostname send policy for DHCPv4 requests.
+type DHCPSendHostname uint8
+
+// DHCPSendHostname constants.
+const (
+	DHCPSendHostnameNone     DHCPSendHostname = iota // none
+	DHCPSendHostnameHostname                         // hostname
+	DHCPSendHostnameFQDN                             // fqdn
+)
@@ -0,0 +1,19 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=IPv6Mode -linecomment -text
+
+// IPv6Mode is the IPv6 addressing mode of the link.
+type IPv6Mode uint8
+
+// IPv6Mode constants.
+const (
+	IPv6ModeUnspec   IPv6Mode = iota // unspec
+	IPv6ModeDisabled                 // disabled
+	IPv6ModeSLAAC                    // slaac
+	IPv6ModeDHCP6                    // dhcp6
+	IPv6ModeStatic                   // static
+)
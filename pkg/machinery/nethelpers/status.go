@@ -17,4 +17,5 @@ const (
 	StatusConnectivity Status = 2 // connectivity
 	StatusHostname     Status = 3 // hostname
 	StatusEtcFiles     Status = 4 // etcfiles
+	StatusProbes       Status = 5 // probes
 )
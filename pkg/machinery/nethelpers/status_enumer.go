@@ -6,9 +6,9 @@ import (
 	"fmt"
 )
 
-const _StatusName = "addressesconnectivityhostnameetcfiles"
+const _StatusName = "addressesconnectivityhostnameetcfilesprobes"
 
-var _StatusIndex = [...]uint8{0, 9, 21, 29, 37}
+var _StatusIndex = [...]uint8{0, 9, 21, 29, 37, 43}
 
 func (i Status) String() string {
 	i -= 1
@@ -18,13 +18,14 @@ func (i Status) String() string {
 	return _StatusName[_StatusIndex[i]:_StatusIndex[i+1]]
 }
 
-var _StatusValues = []Status{1, 2, 3, 4}
+var _StatusValues = []Status{1, 2, 3, 4, 5}
 
 var _StatusNameToValueMap = map[string]Status{
 	_StatusName[0:9]:   1,
 	_StatusName[9:21]:  2,
 	_StatusName[21:29]: 3,
 	_StatusName[29:37]: 4,
+	_StatusName[37:43]: 5,
 }
 
 // StatusString retrieves an enum value from the enum constants string name.
@@ -204,9 +204,10 @@ func ExampleVIPOperatorSpec_outputProtoencMarshal() {
 
 	// Output:
 	// 00000000  0a 06 0a 04 c0 a8 01 01  10 01 1a 09 0a 01 61 12  |..............a.|
-	// 00000010  01 62 1a 01 63 22 07 08  03 10 04 1a 01 64        |.b..c".......d|
+	// 00000010  01 62 1a 01 63 22 07 08  03 10 04 1a 01 64 2a 06  |.b..c".......d*.|
+	// 00000020  08 00 12 00 1a 00                                 |......|
 	//
-	// 0a060a04c0a8010110011a090a01611201621a01632207080310041a0164
+	// 0a060a04c0a8010110011a090a01611201621a01632207080310041a01642a06080012001a00
 }
 
 func TestMemberSpec(t *testing.T) {
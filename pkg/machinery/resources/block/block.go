@@ -0,0 +1,12 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import "github.com/cosi-project/runtime/pkg/resource"
+
+//go:generate deep-copy -type DiskSpec -type DiskHealthSpec -type DiscoveryStatusSpec -type FilesystemSpec -type LogicalVolumeSpec -type PartitionSpec -type PartitionGrowthSpec -type VolumeGroupSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+
+// NamespaceName contains resources related to block devices.
+const NamespaceName resource.Namespace = "block"
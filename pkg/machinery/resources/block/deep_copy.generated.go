@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Code generated by "deep-copy -type DiskSpec -type DiskHealthSpec -type DiscoveryStatusSpec -type FilesystemSpec -type LogicalVolumeSpec -type PartitionSpec -type PartitionGrowthSpec -type VolumeGroupSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+
+package block
+
+// DeepCopy generates a deep copy of DiskSpec.
+func (o DiskSpec) DeepCopy() DiskSpec {
+	var cp DiskSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of DiskHealthSpec.
+func (o DiskHealthSpec) DeepCopy() DiskHealthSpec {
+	var cp DiskHealthSpec = o
+	if o.Warnings != nil {
+		cp.Warnings = make([]string, len(o.Warnings))
+		copy(cp.Warnings, o.Warnings)
+	}
+	if o.WarningKeys != nil {
+		cp.WarningKeys = make([]string, len(o.WarningKeys))
+		copy(cp.WarningKeys, o.WarningKeys)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of DiscoveryStatusSpec.
+func (o DiscoveryStatusSpec) DeepCopy() DiscoveryStatusSpec {
+	var cp DiscoveryStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of FilesystemSpec.
+func (o FilesystemSpec) DeepCopy() FilesystemSpec {
+	var cp FilesystemSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of LogicalVolumeSpec.
+func (o LogicalVolumeSpec) DeepCopy() LogicalVolumeSpec {
+	var cp LogicalVolumeSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of PartitionSpec.
+func (o PartitionSpec) DeepCopy() PartitionSpec {
+	var cp PartitionSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of PartitionGrowthSpec.
+func (o PartitionGrowthSpec) DeepCopy() PartitionGrowthSpec {
+	var cp PartitionGrowthSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of VolumeGroupSpec.
+func (o VolumeGroupSpec) DeepCopy() VolumeGroupSpec {
+	var cp VolumeGroupSpec = o
+	if o.PhysicalVolumes != nil {
+		cp.PhysicalVolumes = make([]string, len(o.PhysicalVolumes))
+		copy(cp.PhysicalVolumes, o.PhysicalVolumes)
+	}
+	return cp
+}
@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// DiscoveryStatusType is type of DiscoveryStatus resource.
+const DiscoveryStatusType = resource.Type("DiscoveryStatuses.block.talos.dev")
+
+// DiscoveryStatusID is the ID of the singleton DiscoveryStatus resource.
+const DiscoveryStatusID resource.ID = "devices"
+
+// DiscoveryStatus resource reports the status of the block device discovery.
+type DiscoveryStatus = typed.Resource[DiscoveryStatusSpec, DiscoveryStatusRD]
+
+// DiscoveryStatusSpec describes the status of the block device discovery.
+//
+//gotagsrewrite:gen
+type DiscoveryStatusSpec struct {
+	// Ready is set once the block devices are scanned, and Disk, Partition and Filesystem resources are populated.
+	Ready bool `yaml:"ready" protobuf:"1"`
+}
+
+// NewDiscoveryStatus initializes a DiscoveryStatus resource.
+func NewDiscoveryStatus(namespace resource.Namespace, id resource.ID) *DiscoveryStatus {
+	return typed.NewResource[DiscoveryStatusSpec, DiscoveryStatusRD](
+		resource.NewMetadata(namespace, DiscoveryStatusType, id, resource.VersionUndefined),
+		DiscoveryStatusSpec{},
+	)
+}
+
+// DiscoveryStatusRD provides auxiliary methods for DiscoveryStatus.
+type DiscoveryStatusRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (DiscoveryStatusRD) ResourceDefinition(resource.Metadata, DiscoveryStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiscoveryStatusType,
+		Aliases:          []resource.Type{"discoverystatus", "discoverystatuses"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Ready",
+				JSONPath: `{.ready}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DiscoveryStatusSpec](DiscoveryStatusType, &DiscoveryStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// DiskType is type of Disk resource.
+const DiskType = resource.Type("Disks.block.talos.dev")
+
+// Disk resource holds information about a block device (whole disk).
+type Disk = typed.Resource[DiskSpec, DiskRD]
+
+// DiskSpec describes a block device.
+//
+//gotagsrewrite:gen
+type DiskSpec struct {
+	DevPath string `yaml:"devPath" protobuf:"1"`
+	// Size is in bytes.
+	Size       uint64 `yaml:"size" protobuf:"2"`
+	PrettySize string `yaml:"prettySize" protobuf:"3"`
+	ReadOnly   bool   `yaml:"readOnly" protobuf:"4"`
+
+	Model    string `yaml:"model,omitempty" protobuf:"5"`
+	Serial   string `yaml:"serial,omitempty" protobuf:"6"`
+	WWID     string `yaml:"wwid,omitempty" protobuf:"7"`
+	UUID     string `yaml:"uuid,omitempty" protobuf:"8"`
+	Name     string `yaml:"name,omitempty" protobuf:"9"`
+	Modalias string `yaml:"modalias,omitempty" protobuf:"10"`
+	BusPath  string `yaml:"busPath,omitempty" protobuf:"11"`
+
+	Transport  string `yaml:"transport,omitempty" protobuf:"12"`
+	Rotational bool   `yaml:"rotational" protobuf:"13"`
+
+	// PartitionTableType is the type of the partition table (gpt, dos), if any.
+	PartitionTableType string `yaml:"partitionTableType,omitempty" protobuf:"14"`
+	PartitionTableUUID string `yaml:"partitionTableUUID,omitempty" protobuf:"15"`
+}
+
+// NewDisk initializes a Disk resource.
+func NewDisk(namespace resource.Namespace, id resource.ID) *Disk {
+	return typed.NewResource[DiskSpec, DiskRD](
+		resource.NewMetadata(namespace, DiskType, id, resource.VersionUndefined),
+		DiskSpec{},
+	)
+}
+
+// DiskRD provides auxiliary methods for Disk.
+type DiskRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (DiskRD) ResourceDefinition(resource.Metadata, DiskSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiskType,
+		Aliases:          []resource.Type{"disk", "disks"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Size",
+				JSONPath: `{.prettySize}`,
+			},
+			{
+				Name:     "Read Only",
+				JSONPath: `{.readOnly}`,
+			},
+			{
+				Name:     "Transport",
+				JSONPath: `{.transport}`,
+			},
+			{
+				Name:     "Rotational",
+				JSONPath: `{.rotational}`,
+			},
+			{
+				Name:     "WWID",
+				JSONPath: `{.wwid}`,
+			},
+			{
+				Name:     "Model",
+				JSONPath: `{.model}`,
+			},
+			{
+				Name:     "Serial",
+				JSONPath: `{.serial}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DiskSpec](DiskType, &Disk{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// DiskHealthType is type of DiskHealth resource.
+const DiskHealthType = resource.Type("DiskHealths.block.talos.dev")
+
+// DiskHealth resource holds S.M.A.R.T./NVMe health information of a Disk.
+//
+// DiskHealth ID matches the ID of the Disk.
+type DiskHealth = typed.Resource[DiskHealthSpec, DiskHealthRD]
+
+// DiskHealthSpec describes the disk health as reported by the device.
+//
+//gotagsrewrite:gen
+type DiskHealthSpec struct {
+	DevPath string `yaml:"devPath" protobuf:"1"`
+	// Protocol used to read the health information (ata, nvme).
+	Protocol string `yaml:"protocol,omitempty" protobuf:"2"`
+	// Supported is false if the disk doesn't support health reporting (e.g. SAS/SCSI disks), other fields are not set in that case.
+	Supported bool `yaml:"supported" protobuf:"16"`
+	// Healthy is true if health reporting is supported and there are no warnings.
+	Healthy bool `yaml:"healthy" protobuf:"3"`
+	// Warnings are human-readable, and they include the current values.
+	Warnings []string `yaml:"warnings,omitempty" protobuf:"4"`
+	// WarningKeys identify the warnings (attribute or threshold), they don't change when the values change.
+	WarningKeys []string `yaml:"warningKeys,omitempty" protobuf:"17"`
+
+	// Temperature in degrees Celsius.
+	Temperature  int64  `yaml:"temperature" protobuf:"5"`
+	PowerOnHours uint64 `yaml:"powerOnHours" protobuf:"6"`
+	PowerCycles  uint64 `yaml:"powerCycles" protobuf:"7"`
+
+	// ATA attributes.
+	ReallocatedSectors   uint64 `yaml:"reallocatedSectors,omitempty" protobuf:"8"`
+	PendingSectors       uint64 `yaml:"pendingSectors,omitempty" protobuf:"9"`
+	OfflineUncorrectable uint64 `yaml:"offlineUncorrectable,omitempty" protobuf:"10"`
+
+	// NVMe SMART/health information.
+	CriticalWarning uint32 `yaml:"criticalWarning,omitempty" protobuf:"11"`
+	AvailableSpare  uint32 `yaml:"availableSpare,omitempty" protobuf:"12"`
+	PercentageUsed  uint32 `yaml:"percentageUsed,omitempty" protobuf:"13"`
+	MediaErrors     uint64 `yaml:"mediaErrors,omitempty" protobuf:"14"`
+	UnsafeShutdowns uint64 `yaml:"unsafeShutdowns,omitempty" protobuf:"15"`
+}
+
+// NewDiskHealth initializes a DiskHealth resource.
+func NewDiskHealth(namespace resource.Namespace, id resource.ID) *DiskHealth {
+	return typed.NewResource[DiskHealthSpec, DiskHealthRD](
+		resource.NewMetadata(namespace, DiskHealthType, id, resource.VersionUndefined),
+		DiskHealthSpec{},
+	)
+}
+
+// DiskHealthRD provides auxiliary methods for DiskHealth.
+type DiskHealthRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (DiskHealthRD) ResourceDefinition(resource.Metadata, DiskHealthSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiskHealthType,
+		Aliases:          []resource.Type{"diskhealth", "diskhealths"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Protocol",
+				JSONPath: `{.protocol}`,
+			},
+			{
+				Name:     "Supported",
+				JSONPath: `{.supported}`,
+			},
+			{
+				Name:     "Healthy",
+				JSONPath: `{.healthy}`,
+			},
+			{
+				Name:     "Temperature",
+				JSONPath: `{.temperature}`,
+			},
+			{
+				Name:     "Power On Hours",
+				JSONPath: `{.powerOnHours}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DiskHealthSpec](DiskHealthType, &DiskHealth{})
+	if err != nil {
+		panic(err)
+	}
+}
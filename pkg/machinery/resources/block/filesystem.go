@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// FilesystemType is type of Filesystem resource.
+const FilesystemType = resource.Type("Filesystems.block.talos.dev")
+
+// Filesystem resource holds information about a filesystem (or other signature) found on a disk or partition.
+type Filesystem = typed.Resource[FilesystemSpec, FilesystemRD]
+
+// FilesystemSpec describes a filesystem.
+//
+//gotagsrewrite:gen
+type FilesystemSpec struct {
+	DevPath string `yaml:"devPath" protobuf:"1"`
+	// Type is the filesystem type as detected by blkid (e.g. xfs, vfat, LVM2_member).
+	Type    string `yaml:"type" protobuf:"2"`
+	UUID    string `yaml:"uuid,omitempty" protobuf:"3"`
+	Label   string `yaml:"label,omitempty" protobuf:"4"`
+	Version string `yaml:"version,omitempty" protobuf:"5"`
+}
+
+// NewFilesystem initializes a Filesystem resource.
+func NewFilesystem(namespace resource.Namespace, id resource.ID) *Filesystem {
+	return typed.NewResource[FilesystemSpec, FilesystemRD](
+		resource.NewMetadata(namespace, FilesystemType, id, resource.VersionUndefined),
+		FilesystemSpec{},
+	)
+}
+
+// FilesystemRD provides auxiliary methods for Filesystem.
+type FilesystemRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (FilesystemRD) ResourceDefinition(resource.Metadata, FilesystemSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             FilesystemType,
+		Aliases:          []resource.Type{"filesystem", "filesystems", "fs"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: `{.type}`,
+			},
+			{
+				Name:     "Label",
+				JSONPath: `{.label}`,
+			},
+			{
+				Name:     "UUID",
+				JSONPath: `{.uuid}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[FilesystemSpec](FilesystemType, &Filesystem{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// LogicalVolumeType is type of LogicalVolume resource.
+const LogicalVolumeType = resource.Type("LogicalVolumes.block.talos.dev")
+
+// LogicalVolume resource holds the status of an LVM logical volume.
+//
+// LogicalVolume ID is <volume group>/<logical volume>.
+type LogicalVolume = typed.Resource[LogicalVolumeSpec, LogicalVolumeRD]
+
+// LogicalVolumeSpec describes an LVM logical volume.
+//
+//gotagsrewrite:gen
+type LogicalVolumeSpec struct {
+	Name        string `yaml:"name" protobuf:"1"`
+	VolumeGroup string `yaml:"volumeGroup" protobuf:"2"`
+	UUID        string `yaml:"uuid" protobuf:"3"`
+	DevPath     string `yaml:"devPath" protobuf:"4"`
+	// Type is the LVM segment type (e.g. linear, raid1, raid10).
+	Type string `yaml:"type" protobuf:"5"`
+	// Size is in bytes.
+	Size       uint64 `yaml:"size" protobuf:"6"`
+	PrettySize string `yaml:"prettySize" protobuf:"7"`
+	Active     bool   `yaml:"active" protobuf:"8"`
+	// HealthStatus is reported by LVM for RAID logical volumes (e.g. partial, refresh needed, mismatches exist).
+	HealthStatus string `yaml:"healthStatus,omitempty" protobuf:"9"`
+	// SyncPercent is the RAID synchronization progress.
+	SyncPercent string `yaml:"syncPercent,omitempty" protobuf:"10"`
+}
+
+// NewLogicalVolume initializes a LogicalVolume resource.
+func NewLogicalVolume(namespace resource.Namespace, id resource.ID) *LogicalVolume {
+	return typed.NewResource[LogicalVolumeSpec, LogicalVolumeRD](
+		resource.NewMetadata(namespace, LogicalVolumeType, id, resource.VersionUndefined),
+		LogicalVolumeSpec{},
+	)
+}
+
+// LogicalVolumeRD provides auxiliary methods for LogicalVolume.
+type LogicalVolumeRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (LogicalVolumeRD) ResourceDefinition(resource.Metadata, LogicalVolumeSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             LogicalVolumeType,
+		Aliases:          []resource.Type{"logicalvolume", "logicalvolumes", "lv", "lvs"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: `{.type}`,
+			},
+			{
+				Name:     "Size",
+				JSONPath: `{.prettySize}`,
+			},
+			{
+				Name:     "Active",
+				JSONPath: `{.active}`,
+			},
+			{
+				Name:     "Health",
+				JSONPath: `{.healthStatus}`,
+			},
+			{
+				Name:     "Sync",
+				JSONPath: `{.syncPercent}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[LogicalVolumeSpec](LogicalVolumeType, &LogicalVolume{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// PartitionType is type of Partition resource.
+const PartitionType = resource.Type("Partitions.block.talos.dev")
+
+// Partition resource holds information about a partition of a disk.
+type Partition = typed.Resource[PartitionSpec, PartitionRD]
+
+// PartitionSpec describes a partition.
+//
+//gotagsrewrite:gen
+type PartitionSpec struct {
+	DevPath string `yaml:"devPath" protobuf:"1"`
+	// Parent is the ID of the Disk resource.
+	Parent string `yaml:"parent" protobuf:"2"`
+	Number uint32 `yaml:"number" protobuf:"3"`
+
+	// Start and Size are in bytes.
+	Start      uint64 `yaml:"start" protobuf:"4"`
+	Size       uint64 `yaml:"size" protobuf:"5"`
+	PrettySize string `yaml:"prettySize" protobuf:"6"`
+
+	PartitionUUID  string `yaml:"partitionUUID,omitempty" protobuf:"7"`
+	PartitionLabel string `yaml:"partitionLabel,omitempty" protobuf:"8"`
+	TypeUUID       string `yaml:"typeUUID,omitempty" protobuf:"9"`
+}
+
+// NewPartition initializes a Partition resource.
+func NewPartition(namespace resource.Namespace, id resource.ID) *Partition {
+	return typed.NewResource[PartitionSpec, PartitionRD](
+		resource.NewMetadata(namespace, PartitionType, id, resource.VersionUndefined),
+		PartitionSpec{},
+	)
+}
+
+// PartitionRD provides auxiliary methods for Partition.
+type PartitionRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (PartitionRD) ResourceDefinition(resource.Metadata, PartitionSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             PartitionType,
+		Aliases:          []resource.Type{"partition", "partitions"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Disk",
+				JSONPath: `{.parent}`,
+			},
+			{
+				Name:     "Number",
+				JSONPath: `{.number}`,
+			},
+			{
+				Name:     "Size",
+				JSONPath: `{.prettySize}`,
+			},
+			{
+				Name:     "Label",
+				JSONPath: `{.partitionLabel}`,
+			},
+			{
+				Name:     "UUID",
+				JSONPath: `{.partitionUUID}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[PartitionSpec](PartitionType, &Partition{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// PartitionGrowthType is type of PartitionGrowth resource.
+const PartitionGrowthType = resource.Type("PartitionGrowths.block.talos.dev")
+
+// PartitionGrowth resource holds the result of the last attempt to grow a partition to the free space after it.
+//
+// PartitionGrowth ID matches the ID of the Partition.
+type PartitionGrowth = typed.Resource[PartitionGrowthSpec, PartitionGrowthRD]
+
+// PartitionGrowthSpec describes the partition growth.
+//
+//gotagsrewrite:gen
+type PartitionGrowthSpec struct {
+	DevPath    string `yaml:"devPath" protobuf:"1"`
+	MountPoint string `yaml:"mountPoint" protobuf:"2"`
+	Encrypted  bool   `yaml:"encrypted" protobuf:"3"`
+
+	// PreviousSize and Size are in bytes.
+	PreviousSize uint64 `yaml:"previousSize" protobuf:"4"`
+	Size         uint64 `yaml:"size" protobuf:"5"`
+	PrettySize   string `yaml:"prettySize" protobuf:"6"`
+	Grown        bool   `yaml:"grown" protobuf:"7"`
+}
+
+// NewPartitionGrowth initializes a PartitionGrowth resource.
+func NewPartitionGrowth(namespace resource.Namespace, id resource.ID) *PartitionGrowth {
+	return typed.NewResource[PartitionGrowthSpec, PartitionGrowthRD](
+		resource.NewMetadata(namespace, PartitionGrowthType, id, resource.VersionUndefined),
+		PartitionGrowthSpec{},
+	)
+}
+
+// PartitionGrowthRD provides auxiliary methods for PartitionGrowth.
+type PartitionGrowthRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (PartitionGrowthRD) ResourceDefinition(resource.Metadata, PartitionGrowthSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             PartitionGrowthType,
+		Aliases:          []resource.Type{"partitiongrowth", "partitiongrowths"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Mount Point",
+				JSONPath: `{.mountPoint}`,
+			},
+			{
+				Name:     "Size",
+				JSONPath: `{.prettySize}`,
+			},
+			{
+				Name:     "Grown",
+				JSONPath: `{.grown}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[PartitionGrowthSpec](PartitionGrowthType, &PartitionGrowth{})
+	if err != nil {
+		panic(err)
+	}
+}
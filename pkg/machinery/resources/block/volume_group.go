@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// VolumeGroupType is type of VolumeGroup resource.
+const VolumeGroupType = resource.Type("VolumeGroups.block.talos.dev")
+
+// VolumeGroup resource holds the status of an LVM volume group.
+//
+// VolumeGroup ID is the name of the volume group.
+type VolumeGroup = typed.Resource[VolumeGroupSpec, VolumeGroupRD]
+
+// VolumeGroupSpec describes an LVM volume group.
+//
+//gotagsrewrite:gen
+type VolumeGroupSpec struct {
+	UUID string `yaml:"uuid" protobuf:"1"`
+	// Size is in bytes.
+	Size       uint64 `yaml:"size" protobuf:"2"`
+	PrettySize string `yaml:"prettySize" protobuf:"3"`
+	// Free is in bytes.
+	Free            uint64   `yaml:"free" protobuf:"4"`
+	PhysicalVolumes []string `yaml:"physicalVolumes" protobuf:"5"`
+	LogicalVolumes  int      `yaml:"logicalVolumes" protobuf:"6"`
+}
+
+// NewVolumeGroup initializes a VolumeGroup resource.
+func NewVolumeGroup(namespace resource.Namespace, id resource.ID) *VolumeGroup {
+	return typed.NewResource[VolumeGroupSpec, VolumeGroupRD](
+		resource.NewMetadata(namespace, VolumeGroupType, id, resource.VersionUndefined),
+		VolumeGroupSpec{},
+	)
+}
+
+// VolumeGroupRD provides auxiliary methods for VolumeGroup.
+type VolumeGroupRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (VolumeGroupRD) ResourceDefinition(resource.Metadata, VolumeGroupSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             VolumeGroupType,
+		Aliases:          []resource.Type{"volumegroup", "volumegroups", "vg", "vgs"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Size",
+				JSONPath: `{.prettySize}`,
+			},
+			{
+				Name:     "PVs",
+				JSONPath: `{.physicalVolumes}`,
+			},
+			{
+				Name:     "LVs",
+				JSONPath: `{.logicalVolumes}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[VolumeGroupSpec](VolumeGroupType, &VolumeGroup{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -34,6 +34,12 @@ type ConfigSpec struct {
 	ServiceEndpointInsecure   bool   `yaml:"serviceEndpointInsecure,omitempty" protobuf:"5"`
 	ServiceEncryptionKey      []byte `yaml:"serviceEncryptionKey" protobuf:"6"`
 	ServiceClusterID          string `yaml:"serviceClusterID" protobuf:"7"`
+	// RegistryKubernetesEncryptionKeys are used to seal affiliate data in the Kubernetes registry.
+	//
+	// The first key is used to encrypt the data, all keys are tried to decrypt it.
+	RegistryKubernetesEncryptionKeys [][]byte `yaml:"registryKubernetesEncryptionKeys,omitempty" protobuf:"8"`
+	// RegistryKubernetesLegacyAnnotations enables reading plain text affiliate annotations in the Kubernetes registry.
+	RegistryKubernetesLegacyAnnotations bool `yaml:"registryKubernetesLegacyAnnotations,omitempty" protobuf:"9"`
 }
 
 // NewConfig initializes a Config resource.
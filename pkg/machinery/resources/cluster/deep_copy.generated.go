@@ -35,6 +35,16 @@ func (o ConfigSpec) DeepCopy() ConfigSpec {
 		cp.ServiceEncryptionKey = make([]byte, len(o.ServiceEncryptionKey))
 		copy(cp.ServiceEncryptionKey, o.ServiceEncryptionKey)
 	}
+	if o.RegistryKubernetesEncryptionKeys != nil {
+		cp.RegistryKubernetesEncryptionKeys = make([][]byte, len(o.RegistryKubernetesEncryptionKeys))
+		copy(cp.RegistryKubernetesEncryptionKeys, o.RegistryKubernetesEncryptionKeys)
+		for i2 := range o.RegistryKubernetesEncryptionKeys {
+			if o.RegistryKubernetesEncryptionKeys[i2] != nil {
+				cp.RegistryKubernetesEncryptionKeys[i2] = make([]byte, len(o.RegistryKubernetesEncryptionKeys[i2]))
+				copy(cp.RegistryKubernetesEncryptionKeys[i2], o.RegistryKubernetesEncryptionKeys[i2])
+			}
+		}
+	}
 	return cp
 }
 
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type MemoryModuleSpec -type ProcessorSpec -type SystemInformationSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type MemoryModuleSpec -type PCIDeviceSpec -type ProcessorSpec -type SystemInformationSpec -type USBDeviceSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package hardware
 
@@ -12,6 +12,12 @@ func (o MemoryModuleSpec) DeepCopy() MemoryModuleSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of PCIDeviceSpec.
+func (o PCIDeviceSpec) DeepCopy() PCIDeviceSpec {
+	var cp PCIDeviceSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of ProcessorSpec.
 func (o ProcessorSpec) DeepCopy() ProcessorSpec {
 	var cp ProcessorSpec = o
@@ -23,3 +29,13 @@ func (o SystemInformationSpec) DeepCopy() SystemInformationSpec {
 	var cp SystemInformationSpec = o
 	return cp
 }
+
+// DeepCopy generates a deep copy of USBDeviceSpec.
+func (o USBDeviceSpec) DeepCopy() USBDeviceSpec {
+	var cp USBDeviceSpec = o
+	if o.Drivers != nil {
+		cp.Drivers = make([]string, len(o.Drivers))
+		copy(cp.Drivers, o.Drivers)
+	}
+	return cp
+}
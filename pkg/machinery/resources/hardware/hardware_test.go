@@ -27,6 +27,9 @@ func TestRegisterResource(t *testing.T) {
 	for _, resource := range []resource.Resource{
 		&hardware.Processor{},
 		&hardware.MemoryModule{},
+		&hardware.PCIDevice{},
+		&hardware.SystemInformation{},
+		&hardware.USBDevice{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// PCIDeviceType is type of PCIDevice resource.
+const PCIDeviceType = resource.Type("PCIDevices.hardware.talos.dev")
+
+// PCIDevice resource holds node PCIDevice information.
+type PCIDevice = typed.Resource[PCIDeviceSpec, PCIDeviceRD]
+
+// PCIDeviceSpec represents a single PCI device.
+//
+//gotagsrewrite:gen
+type PCIDeviceSpec struct {
+	Class    string `yaml:"class,omitempty" protobuf:"1"`
+	Subclass string `yaml:"subclass,omitempty" protobuf:"2"`
+	Vendor   string `yaml:"vendor,omitempty" protobuf:"3"`
+	Product  string `yaml:"product,omitempty" protobuf:"4"`
+
+	ClassID    string `yaml:"classID" protobuf:"5"`
+	SubclassID string `yaml:"subclassID" protobuf:"6"`
+	VendorID   string `yaml:"vendorID" protobuf:"7"`
+	ProductID  string `yaml:"productID" protobuf:"8"`
+
+	Driver     string `yaml:"driver,omitempty" protobuf:"9"`
+	IOMMUGroup string `yaml:"iommuGroup,omitempty" protobuf:"10"`
+}
+
+// NewPCIDeviceInfo initializes a PCIDeviceInfo resource.
+func NewPCIDeviceInfo(id string) *PCIDevice {
+	return typed.NewResource[PCIDeviceSpec, PCIDeviceRD](
+		resource.NewMetadata(NamespaceName, PCIDeviceType, id, resource.VersionUndefined),
+		PCIDeviceSpec{},
+	)
+}
+
+// PCIDeviceRD provides auxiliary methods for PCIDevice info.
+type PCIDeviceRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (c PCIDeviceRD) ResourceDefinition(resource.Metadata, PCIDeviceSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type: PCIDeviceType,
+		Aliases: []resource.Type{
+			"pcidevice",
+			"pcidevices",
+			"pci",
+		},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Class",
+				JSONPath: `{.class}`,
+			},
+			{
+				Name:     "Subclass",
+				JSONPath: `{.subclass}`,
+			},
+			{
+				Name:     "Vendor",
+				JSONPath: `{.vendor}`,
+			},
+			{
+				Name:     "Product",
+				JSONPath: `{.product}`,
+			},
+			{
+				Name:     "Driver",
+				JSONPath: `{.driver}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[PCIDeviceSpec](PCIDeviceType, &PCIDevice{})
+	if err != nil {
+		panic(err)
+	}
+}
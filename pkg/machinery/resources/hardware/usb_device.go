@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// USBDeviceType is type of USBDevice resource.
+const USBDeviceType = resource.Type("USBDevices.hardware.talos.dev")
+
+// USBDevice resource holds node USBDevice information.
+type USBDevice = typed.Resource[USBDeviceSpec, USBDeviceRD]
+
+// USBDeviceSpec represents a single USB device.
+//
+//gotagsrewrite:gen
+type USBDeviceSpec struct {
+	BusNum uint32 `yaml:"busNum" protobuf:"1"`
+	DevNum uint32 `yaml:"devNum" protobuf:"2"`
+
+	// Vendor, Product and Serial are reported by the device itself.
+	Vendor  string `yaml:"vendor,omitempty" protobuf:"3"`
+	Product string `yaml:"product,omitempty" protobuf:"4"`
+	Serial  string `yaml:"serial,omitempty" protobuf:"5"`
+
+	VendorID  string `yaml:"vendorID" protobuf:"6"`
+	ProductID string `yaml:"productID" protobuf:"7"`
+
+	Class   string `yaml:"class,omitempty" protobuf:"8"`
+	ClassID string `yaml:"classID" protobuf:"9"`
+
+	// Speed is in Mbit/s.
+	Speed   string `yaml:"speed,omitempty" protobuf:"10"`
+	Version string `yaml:"version,omitempty" protobuf:"11"`
+
+	// Drivers bound to the device interfaces.
+	Drivers []string `yaml:"drivers,omitempty" protobuf:"12"`
+}
+
+// NewUSBDeviceInfo initializes a USBDeviceInfo resource.
+func NewUSBDeviceInfo(id string) *USBDevice {
+	return typed.NewResource[USBDeviceSpec, USBDeviceRD](
+		resource.NewMetadata(NamespaceName, USBDeviceType, id, resource.VersionUndefined),
+		USBDeviceSpec{},
+	)
+}
+
+// USBDeviceRD provides auxiliary methods for USBDevice info.
+type USBDeviceRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (c USBDeviceRD) ResourceDefinition(resource.Metadata, USBDeviceSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type: USBDeviceType,
+		Aliases: []resource.Type{
+			"usbdevice",
+			"usbdevices",
+			"usb",
+		},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Vendor ID",
+				JSONPath: `{.vendorID}`,
+			},
+			{
+				Name:     "Product ID",
+				JSONPath: `{.productID}`,
+			},
+			{
+				Name:     "Class",
+				JSONPath: `{.class}`,
+			},
+			{
+				Name:     "Vendor",
+				JSONPath: `{.vendor}`,
+			},
+			{
+				Name:     "Product",
+				JSONPath: `{.product}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[USBDeviceSpec](USBDeviceType, &USBDevice{})
+	if err != nil {
+		panic(err)
+	}
+}
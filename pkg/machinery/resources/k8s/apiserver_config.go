@@ -35,6 +35,43 @@ type ExtraVolume struct {
 	ReadOnly  bool   `yaml:"readonly" protobuf:"4"`
 }
 
+// Resources is a configuration of cpu and memory resources.
+//
+//gotagsrewrite:gen
+type Resources struct {
+	Requests map[string]string `yaml:"requests,omitempty" protobuf:"1"`
+	Limits   map[string]string `yaml:"limits,omitempty" protobuf:"2"`
+}
+
+// AuditLog describes the kube-apiserver audit log retention.
+//
+//gotagsrewrite:gen
+type AuditLog struct {
+	MaxAge     int `yaml:"maxAge" protobuf:"1"`
+	MaxBackups int `yaml:"maxBackups" protobuf:"2"`
+	MaxSize    int `yaml:"maxSize" protobuf:"3"`
+}
+
+// OIDC describes the kube-apiserver OpenID Connect authentication.
+//
+//gotagsrewrite:gen
+type OIDC struct {
+	IssuerURL         string            `yaml:"issuerURL" protobuf:"1"`
+	ClientID          string            `yaml:"clientID" protobuf:"2"`
+	UsernameClaim     string            `yaml:"usernameClaim,omitempty" protobuf:"3"`
+	UsernamePrefix    string            `yaml:"usernamePrefix,omitempty" protobuf:"4"`
+	GroupsClaim       string            `yaml:"groupsClaim,omitempty" protobuf:"5"`
+	GroupsPrefix      string            `yaml:"groupsPrefix,omitempty" protobuf:"6"`
+	RequiredClaims    map[string]string `yaml:"requiredClaims,omitempty" protobuf:"7"`
+	SigningAlgorithms []string          `yaml:"signingAlgorithms,omitempty" protobuf:"8"`
+	CAEnabled         bool              `yaml:"caEnabled" protobuf:"9"`
+}
+
+// Enabled returns true if OIDC authentication is configured.
+func (oidc OIDC) Enabled() bool {
+	return oidc.IssuerURL != ""
+}
+
 // APIServerConfigSpec is configuration for kube-apiserver.
 //
 //gotagsrewrite:gen
@@ -50,6 +87,11 @@ type APIServerConfigSpec struct {
 	EnvironmentVariables     map[string]string `yaml:"environmentVariables" protobuf:"9"`
 	PodSecurityPolicyEnabled bool              `yaml:"podSecurityPolicyEnabled" protobuf:"10"`
 	AdvertisedAddress        string            `yaml:"advertisedAddress" protobuf:"11"`
+	Resources                Resources         `yaml:"resources" protobuf:"12"`
+	AuditLog                 AuditLog          `yaml:"auditLog" protobuf:"13"`
+	OIDC                     OIDC              `yaml:"oidc,omitempty" protobuf:"14"`
+	KMSEnabled               bool              `yaml:"kmsEnabled,omitempty" protobuf:"15"`
+	PriorityClassName        string            `yaml:"priorityClassName" protobuf:"16"`
 }
 
 // NewAPIServerConfig returns new APIServerConfig resource.
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type AuditPolicyConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type BootstrapManifestsConfigSpec -type KubeletConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodenameSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -type StaticPodServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type AuditPolicyConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type BootstrapManifestsConfigSpec -type KubeletConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodeMetadataConfigSpec -type NodenameSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -type StaticPodServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package k8s
 
@@ -57,6 +57,28 @@ func (o APIServerConfigSpec) DeepCopy() APIServerConfigSpec {
 			cp.EnvironmentVariables[k2] = v2
 		}
 	}
+	if o.Resources.Requests != nil {
+		cp.Resources.Requests = make(map[string]string, len(o.Resources.Requests))
+		for k3, v3 := range o.Resources.Requests {
+			cp.Resources.Requests[k3] = v3
+		}
+	}
+	if o.Resources.Limits != nil {
+		cp.Resources.Limits = make(map[string]string, len(o.Resources.Limits))
+		for k3, v3 := range o.Resources.Limits {
+			cp.Resources.Limits[k3] = v3
+		}
+	}
+	if o.OIDC.RequiredClaims != nil {
+		cp.OIDC.RequiredClaims = make(map[string]string, len(o.OIDC.RequiredClaims))
+		for k3, v3 := range o.OIDC.RequiredClaims {
+			cp.OIDC.RequiredClaims[k3] = v3
+		}
+	}
+	if o.OIDC.SigningAlgorithms != nil {
+		cp.OIDC.SigningAlgorithms = make([]string, len(o.OIDC.SigningAlgorithms))
+		copy(cp.OIDC.SigningAlgorithms, o.OIDC.SigningAlgorithms)
+	}
 	return cp
 }
 
@@ -105,6 +127,18 @@ func (o ControllerManagerConfigSpec) DeepCopy() ControllerManagerConfigSpec {
 			cp.EnvironmentVariables[k2] = v2
 		}
 	}
+	if o.Resources.Requests != nil {
+		cp.Resources.Requests = make(map[string]string, len(o.Resources.Requests))
+		for k3, v3 := range o.Resources.Requests {
+			cp.Resources.Requests[k3] = v3
+		}
+	}
+	if o.Resources.Limits != nil {
+		cp.Resources.Limits = make(map[string]string, len(o.Resources.Limits))
+		for k3, v3 := range o.Resources.Limits {
+			cp.Resources.Limits[k3] = v3
+		}
+	}
 	return cp
 }
 
@@ -165,6 +199,12 @@ func (o KubeletSpecSpec) DeepCopy() KubeletSpecSpec {
 			cp.Config[k2] = v2
 		}
 	}
+	if o.CredentialProviderConfig != nil {
+		cp.CredentialProviderConfig = make(map[string]interface{}, len(o.CredentialProviderConfig))
+		for k2, v2 := range o.CredentialProviderConfig {
+			cp.CredentialProviderConfig[k2] = v2
+		}
+	}
 	return cp
 }
 
@@ -239,6 +279,16 @@ func (o KubeletConfigSpec) DeepCopy() KubeletConfigSpec {
 			cp.ExtraConfig[k2] = v2
 		}
 	}
+	if o.CredentialProviderConfig != nil {
+		cp.CredentialProviderConfig = make(map[string]interface{}, len(o.CredentialProviderConfig))
+		for k2, v2 := range o.CredentialProviderConfig {
+			cp.CredentialProviderConfig[k2] = v2
+		}
+	}
+	if o.RegisterWithTaints != nil {
+		cp.RegisterWithTaints = make([]Taint, len(o.RegisterWithTaints))
+		copy(cp.RegisterWithTaints, o.RegisterWithTaints)
+	}
 	return cp
 }
 
@@ -266,6 +316,28 @@ func (o NodeIPConfigSpec) DeepCopy() NodeIPConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of NodeMetadataConfigSpec.
+func (o NodeMetadataConfigSpec) DeepCopy() NodeMetadataConfigSpec {
+	var cp NodeMetadataConfigSpec = o
+	if o.Labels != nil {
+		cp.Labels = make(map[string]string, len(o.Labels))
+		for k2, v2 := range o.Labels {
+			cp.Labels[k2] = v2
+		}
+	}
+	if o.Annotations != nil {
+		cp.Annotations = make(map[string]string, len(o.Annotations))
+		for k2, v2 := range o.Annotations {
+			cp.Annotations[k2] = v2
+		}
+	}
+	if o.Taints != nil {
+		cp.Taints = make([]Taint, len(o.Taints))
+		copy(cp.Taints, o.Taints)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of NodenameSpec.
 func (o NodenameSpec) DeepCopy() NodenameSpec {
 	var cp NodenameSpec = o
@@ -291,6 +363,18 @@ func (o SchedulerConfigSpec) DeepCopy() SchedulerConfigSpec {
 			cp.EnvironmentVariables[k2] = v2
 		}
 	}
+	if o.Resources.Requests != nil {
+		cp.Resources.Requests = make(map[string]string, len(o.Resources.Requests))
+		for k3, v3 := range o.Resources.Requests {
+			cp.Resources.Requests[k3] = v3
+		}
+	}
+	if o.Resources.Limits != nil {
+		cp.Resources.Limits = make(map[string]string, len(o.Resources.Limits))
+		for k3, v3 := range o.Resources.Limits {
+			cp.Resources.Limits[k3] = v3
+		}
+	}
 	return cp
 }
 
@@ -41,6 +41,7 @@ type ExtraManifest struct {
 	Priority       string            `yaml:"priority" protobuf:"3"`
 	ExtraHeaders   map[string]string `yaml:"extraHeaders" protobuf:"4"`
 	InlineManifest string            `yaml:"inlineManifest" protobuf:"5"`
+	Prune          bool              `yaml:"prune" protobuf:"6"`
 }
 
 // NewExtraManifestsConfig returns new ExtraManifestsConfig resource.
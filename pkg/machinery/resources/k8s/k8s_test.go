@@ -44,6 +44,7 @@ func TestRegisterResource(t *testing.T) {
 		&k8s.Nodename{},
 		&k8s.NodeIP{},
 		&k8s.NodeIPConfig{},
+		&k8s.NodeMetadataConfig{},
 		&k8s.SchedulerConfig{},
 		&k8s.SecretsStatus{},
 		&k8s.StaticPodStatus{},
@@ -38,6 +38,8 @@ type KubeletConfigSpec struct {
 	SkipNodeRegistration         bool                   `yaml:"skipNodeRegistration" protobuf:"9"`
 	StaticPodListURL             string                 `yaml:"staticPodListURL" protobuf:"10"`
 	DisableManifestsDirectory    bool                   `yaml:"disableManifestsDirectory" protobuf:"11"`
+	CredentialProviderConfig     map[string]interface{} `yaml:"credentialProviderConfig,omitempty" protobuf:"12"`
+	RegisterWithTaints           []Taint                `yaml:"registerWithTaints,omitempty" protobuf:"13"`
 }
 
 // NewKubeletConfig initializes an empty KubeletConfig resource.
@@ -51,6 +51,8 @@ type BootstrapManifestsConfigSpec struct {
 	PodSecurityPolicyEnabled bool `yaml:"podSecurityPolicyEnabled" protobuf:"14"`
 
 	TalosAPIServiceEnabled bool `yaml:"talosAPIServiceEnabled" protobuf:"15"`
+
+	Prune bool `yaml:"prune" protobuf:"16"`
 }
 
 // NewBootstrapManifestsConfig returns new BootstrapManifestsConfig resource.
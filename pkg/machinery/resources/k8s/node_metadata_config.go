@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// NodeMetadataConfigType is type of NodeMetadataConfig resource.
+const NodeMetadataConfigType = resource.Type("NodeMetadataConfigs.kubernetes.talos.dev")
+
+// NodeMetadataConfigID is a singleton resource ID for NodeMetadataConfig.
+const NodeMetadataConfigID = resource.ID("node-metadata")
+
+// NodeMetadataConfig resource holds the labels, annotations and taints of the Kubernetes Node.
+type NodeMetadataConfig = typed.Resource[NodeMetadataConfigSpec, NodeMetadataConfigRD]
+
+// Taint describes a Kubernetes Node taint.
+//
+//gotagsrewrite:gen
+type Taint struct {
+	Key    string `yaml:"key" protobuf:"1"`
+	Value  string `yaml:"value,omitempty" protobuf:"2"`
+	Effect string `yaml:"effect" protobuf:"3"`
+}
+
+// NodeMetadataConfigSpec describes the labels, annotations and taints of the Kubernetes Node managed by Talos.
+//
+//gotagsrewrite:gen
+type NodeMetadataConfigSpec struct {
+	Labels      map[string]string `yaml:"labels,omitempty" protobuf:"1"`
+	Annotations map[string]string `yaml:"annotations,omitempty" protobuf:"2"`
+	Taints      []Taint           `yaml:"taints,omitempty" protobuf:"3"`
+}
+
+// NewNodeMetadataConfig initializes a NodeMetadataConfig resource.
+func NewNodeMetadataConfig(namespace resource.Namespace, id resource.ID) *NodeMetadataConfig {
+	return typed.NewResource[NodeMetadataConfigSpec, NodeMetadataConfigRD](
+		resource.NewMetadata(namespace, NodeMetadataConfigType, id, resource.VersionUndefined),
+		NodeMetadataConfigSpec{},
+	)
+}
+
+// NodeMetadataConfigRD provides auxiliary methods for NodeMetadataConfig.
+type NodeMetadataConfigRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (NodeMetadataConfigRD) ResourceDefinition(resource.Metadata, NodeMetadataConfigSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NodeMetadataConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[NodeMetadataConfigSpec](NodeMetadataConfigType, &NodeMetadataConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -79,6 +79,11 @@ func EtcFilesReady(spec *StatusSpec) bool {
 	return spec.EtcFilesReady
 }
 
+// ProbesReady checks if all network probes are successful.
+func ProbesReady(spec *StatusSpec) bool {
+	return spec.ProbesReady
+}
+
 // StatusChecksFromStatuses converts nethelpers.Status list into list of checks.
 func StatusChecksFromStatuses(statuses ...nethelpers.Status) []StatusCheck {
 	checks := make([]StatusCheck, 0, len(statuses))
@@ -93,6 +98,8 @@ func StatusChecksFromStatuses(statuses ...nethelpers.Status) []StatusCheck {
 			checks = append(checks, HostnameReady)
 		case nethelpers.StatusEtcFiles:
 			checks = append(checks, EtcFilesReady)
+		case nethelpers.StatusProbes:
+			checks = append(checks, ProbesReady)
 		}
 	}
 
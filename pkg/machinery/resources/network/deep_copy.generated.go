@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type HardwareAddrSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type HardwareAddrSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type LLDPNeighborSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteRuleSpecSpec -type RouteRuleStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -94,6 +94,12 @@ func (o LinkStatusSpec) DeepCopy() LinkStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of LLDPNeighborSpec.
+func (o LLDPNeighborSpec) DeepCopy() LLDPNeighborSpec {
+	var cp LLDPNeighborSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of NodeAddressSpec.
 func (o NodeAddressSpec) DeepCopy() NodeAddressSpec {
 	var cp NodeAddressSpec = o
@@ -121,6 +127,30 @@ func (o NodeAddressFilterSpec) DeepCopy() NodeAddressFilterSpec {
 // DeepCopy generates a deep copy of OperatorSpecSpec.
 func (o OperatorSpecSpec) DeepCopy() OperatorSpecSpec {
 	var cp OperatorSpecSpec = o
+	if o.DHCP4.UserClasses != nil {
+		cp.DHCP4.UserClasses = make([]string, len(o.DHCP4.UserClasses))
+		copy(cp.DHCP4.UserClasses, o.DHCP4.UserClasses)
+	}
+	if o.DHCP4.RequestedOptions != nil {
+		cp.DHCP4.RequestedOptions = make([]uint32, len(o.DHCP4.RequestedOptions))
+		copy(cp.DHCP4.RequestedOptions, o.DHCP4.RequestedOptions)
+	}
+	if o.VIP.BGP.Peers != nil {
+		cp.VIP.BGP.Peers = make([]VIPBGPPeerSpec, len(o.VIP.BGP.Peers))
+		copy(cp.VIP.BGP.Peers, o.VIP.BGP.Peers)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of ProbeSpecSpec.
+func (o ProbeSpecSpec) DeepCopy() ProbeSpecSpec {
+	var cp ProbeSpecSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of ProbeStatusSpec.
+func (o ProbeStatusSpec) DeepCopy() ProbeStatusSpec {
+	var cp ProbeStatusSpec = o
 	return cp
 }
 
@@ -131,6 +161,10 @@ func (o ResolverSpecSpec) DeepCopy() ResolverSpecSpec {
 		cp.DNSServers = make([]netip.Addr, len(o.DNSServers))
 		copy(cp.DNSServers, o.DNSServers)
 	}
+	if o.SearchDomains != nil {
+		cp.SearchDomains = make([]string, len(o.SearchDomains))
+		copy(cp.SearchDomains, o.SearchDomains)
+	}
 	return cp
 }
 
@@ -141,6 +175,22 @@ func (o ResolverStatusSpec) DeepCopy() ResolverStatusSpec {
 		cp.DNSServers = make([]netip.Addr, len(o.DNSServers))
 		copy(cp.DNSServers, o.DNSServers)
 	}
+	if o.SearchDomains != nil {
+		cp.SearchDomains = make([]string, len(o.SearchDomains))
+		copy(cp.SearchDomains, o.SearchDomains)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of RouteRuleSpecSpec.
+func (o RouteRuleSpecSpec) DeepCopy() RouteRuleSpecSpec {
+	var cp RouteRuleSpecSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of RouteRuleStatusSpec.
+func (o RouteRuleStatusSpec) DeepCopy() RouteRuleStatusSpec {
+	var cp RouteRuleStatusSpec = o
 	return cp
 }
 
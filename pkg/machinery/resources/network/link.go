@@ -91,6 +91,17 @@ type WireguardPeer struct {
 	AllowedIPs                  []netip.Prefix `yaml:"allowedIPs" protobuf:"5"`
 }
 
+// IPv6Spec describes IPv6 settings of the link.
+//
+// IPv6 settings are applied only if Mode is set.
+//
+//gotagsrewrite:gen
+type IPv6Spec struct {
+	Mode        nethelpers.IPv6Mode `yaml:"mode,omitempty" protobuf:"1"`
+	AcceptRA    bool                `yaml:"acceptRA,omitempty" protobuf:"2"`
+	UseTempAddr int                 `yaml:"useTempAddr,omitempty" protobuf:"3"`
+}
+
 // ID Returns the VID for type VLANSpec.
 func (vlan VLANSpec) ID() uint16 {
 	return vlan.VID
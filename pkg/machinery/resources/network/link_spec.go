@@ -59,6 +59,9 @@ type LinkSpecSpec struct {
 
 	// Configuration layer.
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"14"`
+
+	// IPv6 settings of the link.
+	IPv6 IPv6Spec `yaml:"ipv6,omitempty" protobuf:"15"`
 }
 
 // BondSlave contains a bond's master name and slave index.
@@ -96,6 +99,7 @@ func (spec *LinkSpecSpec) Merge(other *LinkSpecSpec) error {
 	updateIfNotZero(&spec.BondMaster, other.BondMaster)
 	updateIfNotZero(&spec.BridgeMaster, other.BridgeMaster)
 	updateIfNotZero(&spec.BridgeSlave, other.BridgeSlave)
+	updateIfNotZero(&spec.IPv6, other.IPv6)
 
 	// Wireguard config should be able to apply non-zero values in earlier config layers which may be zero values in later layers.
 	// Thus, we handle each Wireguard configuration value discretely.
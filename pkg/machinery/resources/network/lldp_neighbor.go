@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// LLDPNeighborType is type of LLDPNeighbor resource.
+const LLDPNeighborType = resource.Type("LLDPNeighbors.net.talos.dev")
+
+// LLDPNeighbor resource holds information about the neighbor discovered via LLDP on the link.
+//
+// LLDPNeighbor resource ID is the link name.
+type LLDPNeighbor = typed.Resource[LLDPNeighborSpec, LLDPNeighborRD]
+
+// LLDPNeighborSpec describes the LLDP neighbor.
+//
+//gotagsrewrite:gen
+type LLDPNeighborSpec struct {
+	ChassisID         string     `yaml:"chassisID" protobuf:"1"`
+	PortID            string     `yaml:"portID" protobuf:"2"`
+	PortDescription   string     `yaml:"portDescription,omitempty" protobuf:"3"`
+	SystemName        string     `yaml:"systemName,omitempty" protobuf:"4"`
+	SystemDescription string     `yaml:"systemDescription,omitempty" protobuf:"5"`
+	VLAN              uint16     `yaml:"vlan,omitempty" protobuf:"6"`
+	ManagementAddress netip.Addr `yaml:"managementAddress,omitempty" protobuf:"7"`
+}
+
+// NewLLDPNeighbor initializes a LLDPNeighbor resource.
+func NewLLDPNeighbor(namespace resource.Namespace, id resource.ID) *LLDPNeighbor {
+	return typed.NewResource[LLDPNeighborSpec, LLDPNeighborRD](
+		resource.NewMetadata(namespace, LLDPNeighborType, id, resource.VersionUndefined),
+		LLDPNeighborSpec{},
+	)
+}
+
+// LLDPNeighborRD provides auxiliary methods for LLDPNeighbor.
+type LLDPNeighborRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (LLDPNeighborRD) ResourceDefinition(resource.Metadata, LLDPNeighborSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             LLDPNeighborType,
+		Aliases:          []resource.Type{"lldp", "lldpneighbor", "lldpneighbors"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Chassis ID",
+				JSONPath: "{.chassisID}",
+			},
+			{
+				Name:     "Port ID",
+				JSONPath: "{.portID}",
+			},
+			{
+				Name:     "System Name",
+				JSONPath: "{.systemName}",
+			},
+			{
+				Name:     "VLAN",
+				JSONPath: "{.vlan}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[LLDPNeighborSpec](LLDPNeighborType, &LLDPNeighbor{})
+	if err != nil {
+		panic(err)
+	}
+}
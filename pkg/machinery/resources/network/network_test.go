@@ -32,14 +32,19 @@ func TestRegisterResource(t *testing.T) {
 		&network.HostnameSpec{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
+		&network.LLDPNeighbor{},
 		&network.LinkSpec{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
+		&network.ProbeSpec{},
+		&network.ProbeStatus{},
 		&network.ResolverStatus{},
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
+		&network.RouteRuleStatus{},
+		&network.RouteRuleSpec{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
@@ -6,6 +6,7 @@ package network
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -40,8 +41,13 @@ type OperatorSpecSpec struct {
 //
 //gotagsrewrite:gen
 type DHCP4OperatorSpec struct {
-	RouteMetric         uint32 `yaml:"routeMetric" protobuf:"1"`
-	SkipHostnameRequest bool   `yaml:"skipHostnameRequest,omitempty" protobuf:"2"`
+	RouteMetric         uint32   `yaml:"routeMetric" protobuf:"1"`
+	SkipHostnameRequest bool     `yaml:"skipHostnameRequest,omitempty" protobuf:"2"`
+	ClientIdentifier    string   `yaml:"clientIdentifier,omitempty" protobuf:"3"`
+	VendorClass         string   `yaml:"vendorClass,omitempty" protobuf:"4"`
+	UserClasses         []string `yaml:"userClasses,omitempty" protobuf:"5"`
+	RequestedOptions    []uint32 `yaml:"requestedOptions,omitempty" protobuf:"6"`
+	Hostname            string   `yaml:"hostname,omitempty" protobuf:"7"`
 }
 
 // DHCP6OperatorSpec describes DHCP6 operator options.
@@ -62,6 +68,7 @@ type VIPOperatorSpec struct {
 
 	EquinixMetal VIPEquinixMetalSpec `yaml:"equinixMetal,omitempty" protobuf:"3"`
 	HCloud       VIPHCloudSpec       `yaml:"hcloud,omitempty" protobuf:"4"`
+	BGP          VIPBGPSpec          `yaml:"bgp,omitempty" protobuf:"5"`
 }
 
 // VIPEquinixMetalSpec describes virtual (elastic) IP settings for Equinix Metal.
@@ -82,6 +89,24 @@ type VIPHCloudSpec struct {
 	APIToken  string `yaml:"apiToken" protobuf:"3"`
 }
 
+// VIPBGPSpec describes virtual IP settings for announcing the IP over BGP.
+//
+//gotagsrewrite:gen
+type VIPBGPSpec struct {
+	LocalASN uint32           `yaml:"localASN" protobuf:"1"`
+	RouterID netip.Addr       `yaml:"routerID,omitempty" protobuf:"2"`
+	HoldTime time.Duration    `yaml:"holdTime,omitempty" protobuf:"3"`
+	Peers    []VIPBGPPeerSpec `yaml:"peers" protobuf:"4"`
+}
+
+// VIPBGPPeerSpec describes BGP peer.
+//
+//gotagsrewrite:gen
+type VIPBGPPeerSpec struct {
+	Address netip.AddrPort `yaml:"address" protobuf:"1"`
+	ASN     uint32         `yaml:"asn" protobuf:"2"`
+}
+
 // NewOperatorSpec initializes a OperatorSpec resource.
 func NewOperatorSpec(namespace resource.Namespace, id resource.ID) *OperatorSpec {
 	return typed.NewResource[OperatorSpecSpec, OperatorSpecRD](
@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// ProbeSpecType is type of ProbeSpec resource.
+const ProbeSpecType = resource.Type("ProbeSpecs.net.talos.dev")
+
+// ProbeSpec resource holds Probe specification to be run.
+type ProbeSpec = typed.Resource[ProbeSpecSpec, ProbeSpecRD]
+
+// ProbeSpecSpec describes the Probe.
+//
+// Exactly one of TCP, ICMP or HTTP should be set.
+//
+//gotagsrewrite:gen
+type ProbeSpecSpec struct {
+	// Interval between the probe attempts.
+	Interval time.Duration `yaml:"interval" protobuf:"1"`
+	// FailureThreshold is the number of consecutive failures for the probe to be considered failed after having succeeded.
+	FailureThreshold int `yaml:"failureThreshold" protobuf:"2"`
+	// TCP probe: connect to the endpoint.
+	TCP TCPProbeSpec `yaml:"tcp,omitempty" protobuf:"3"`
+	// ICMP probe: send echo request to the host.
+	ICMP ICMPProbeSpec `yaml:"icmp,omitempty" protobuf:"4"`
+	// HTTP probe: GET the URL.
+	HTTP HTTPProbeSpec `yaml:"http,omitempty" protobuf:"5"`
+	// Configuration layer.
+	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"6"`
+}
+
+// TCPProbeSpec describes the TCP connect probe.
+//
+//gotagsrewrite:gen
+type TCPProbeSpec struct {
+	// Endpoint to probe: host:port.
+	Endpoint string `yaml:"endpoint" protobuf:"1"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
+}
+
+// ICMPProbeSpec describes the ICMP echo probe.
+//
+//gotagsrewrite:gen
+type ICMPProbeSpec struct {
+	// Host to probe: IP address or a hostname.
+	Host string `yaml:"host" protobuf:"1"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
+}
+
+// HTTPProbeSpec describes the HTTP GET probe.
+//
+//gotagsrewrite:gen
+type HTTPProbeSpec struct {
+	// URL to probe.
+	URL string `yaml:"url" protobuf:"1"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
+}
+
+// NewProbeSpec initializes a ProbeSpec resource.
+func NewProbeSpec(namespace resource.Namespace, id resource.ID) *ProbeSpec {
+	return typed.NewResource[ProbeSpecSpec, ProbeSpecRD](
+		resource.NewMetadata(namespace, ProbeSpecType, id, resource.VersionUndefined),
+		ProbeSpecSpec{},
+	)
+}
+
+// ProbeSpecRD provides auxiliary methods for ProbeSpec.
+type ProbeSpecRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (ProbeSpecRD) ResourceDefinition(resource.Metadata, ProbeSpecSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ProbeSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ProbeSpecSpec](ProbeSpecType, &ProbeSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
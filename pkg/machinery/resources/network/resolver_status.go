@@ -25,7 +25,8 @@ type ResolverStatus = typed.Resource[ResolverStatusSpec, ResolverStatusRD]
 //
 //gotagsrewrite:gen
 type ResolverStatusSpec struct {
-	DNSServers []netip.Addr `yaml:"dnsServers" protobuf:"1"`
+	DNSServers    []netip.Addr `yaml:"dnsServers" protobuf:"1"`
+	SearchDomains []string     `yaml:"searchDomains,omitempty" protobuf:"2"`
 }
 
 // NewResolverStatus initializes a ResolverStatus resource.
@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// RouteRuleStatusType is type of RouteRuleStatus resource.
+const RouteRuleStatusType = resource.Type("RouteRuleStatuses.net.talos.dev")
+
+// RouteRuleStatus resource holds routing policy rule status.
+type RouteRuleStatus = typed.Resource[RouteRuleStatusSpec, RouteRuleStatusRD]
+
+// RouteRuleStatusSpec describes status of the routing policy rule.
+//
+//gotagsrewrite:gen
+type RouteRuleStatusSpec struct {
+	Family      nethelpers.Family        `yaml:"family" protobuf:"1"`
+	Source      netip.Prefix             `yaml:"src" protobuf:"2"`
+	Destination netip.Prefix             `yaml:"dst" protobuf:"3"`
+	Table       nethelpers.RoutingTable  `yaml:"table" protobuf:"4"`
+	Priority    uint32                   `yaml:"priority" protobuf:"5"`
+	FwMark      uint32                   `yaml:"fwMark,omitempty" protobuf:"6"`
+	FwMask      uint32                   `yaml:"fwMask,omitempty" protobuf:"7"`
+	IIFName     string                   `yaml:"iifName,omitempty" protobuf:"8"`
+	OIFName     string                   `yaml:"oifName,omitempty" protobuf:"9"`
+	Protocol    nethelpers.RouteProtocol `yaml:"protocol" protobuf:"10"`
+}
+
+// NewRouteRuleStatus initializes a RouteRuleStatus resource.
+func NewRouteRuleStatus(namespace resource.Namespace, id resource.ID) *RouteRuleStatus {
+	return typed.NewResource[RouteRuleStatusSpec, RouteRuleStatusRD](
+		resource.NewMetadata(namespace, RouteRuleStatusType, id, resource.VersionUndefined),
+		RouteRuleStatusSpec{},
+	)
+}
+
+// RouteRuleStatusRD provides auxiliary methods for RouteRuleStatus.
+type RouteRuleStatusRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (RouteRuleStatusRD) ResourceDefinition(resource.Metadata, RouteRuleStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RouteRuleStatusType,
+		Aliases:          []resource.Type{"rule", "rules"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Priority",
+				JSONPath: `{.priority}`,
+			},
+			{
+				Name:     "Source",
+				JSONPath: `{.src}`,
+			},
+			{
+				Name:     "Destination",
+				JSONPath: `{.dst}`,
+			},
+			{
+				Name:     "Table",
+				JSONPath: `{.table}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[RouteRuleStatusSpec](RouteRuleStatusType, &RouteRuleStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -27,9 +27,10 @@ type API = typed.Resource[APICertsSpec, APIRD]
 //
 //gotagsrewrite:gen
 type APICertsSpec struct {
-	CA     *x509.PEMEncodedCertificateAndKey `yaml:"ca" protobuf:"1"` // only cert is passed, without key
-	Client *x509.PEMEncodedCertificateAndKey `yaml:"client" protobuf:"2"`
-	Server *x509.PEMEncodedCertificateAndKey `yaml:"server" protobuf:"3"`
+	CA          *x509.PEMEncodedCertificateAndKey   `yaml:"ca" protobuf:"1"` // only cert is passed, without key
+	Client      *x509.PEMEncodedCertificateAndKey   `yaml:"client" protobuf:"2"`
+	Server      *x509.PEMEncodedCertificateAndKey   `yaml:"server" protobuf:"3"`
+	AcceptedCAs []*x509.PEMEncodedCertificateAndKey `yaml:"acceptedCAs,omitempty" protobuf:"4"` // only certs are passed, without keys
 }
 
 // NewAPI initializes an API resource.
@@ -10,6 +10,8 @@ import (
 	"net"
 	"net/netip"
 	"net/url"
+
+	x509 "github.com/siderolabs/crypto/x509"
 )
 
 // DeepCopy generates a deep copy of APICertsSpec.
@@ -24,6 +26,15 @@ func (o APICertsSpec) DeepCopy() APICertsSpec {
 	if o.Server != nil {
 		cp.Server = o.Server.DeepCopy()
 	}
+	if o.AcceptedCAs != nil {
+		cp.AcceptedCAs = make([]*x509.PEMEncodedCertificateAndKey, len(o.AcceptedCAs))
+		copy(cp.AcceptedCAs, o.AcceptedCAs)
+		for i2 := range o.AcceptedCAs {
+			if o.AcceptedCAs[i2] != nil {
+				cp.AcceptedCAs[i2] = o.AcceptedCAs[i2].DeepCopy()
+			}
+		}
+	}
 	return cp
 }
 
@@ -82,6 +93,15 @@ func (o KubeletSpec) DeepCopy() KubeletSpec {
 	if o.CA != nil {
 		cp.CA = o.CA.DeepCopy()
 	}
+	if o.AcceptedCAs != nil {
+		cp.AcceptedCAs = make([]*x509.PEMEncodedCertificateAndKey, len(o.AcceptedCAs))
+		copy(cp.AcceptedCAs, o.AcceptedCAs)
+		for i2 := range o.AcceptedCAs {
+			if o.AcceptedCAs[i2] != nil {
+				cp.AcceptedCAs[i2] = o.AcceptedCAs[i2].DeepCopy()
+			}
+		}
+	}
 	return cp
 }
 
@@ -142,6 +162,31 @@ func (o KubernetesRootSpec) DeepCopy() KubernetesRootSpec {
 	if o.AggregatorCA != nil {
 		cp.AggregatorCA = o.AggregatorCA.DeepCopy()
 	}
+	if o.KMSProviders != nil {
+		cp.KMSProviders = make([]KubernetesKMSProvider, len(o.KMSProviders))
+		copy(cp.KMSProviders, o.KMSProviders)
+	}
+	if o.AcceptedCAs != nil {
+		cp.AcceptedCAs = make([]*x509.PEMEncodedCertificateAndKey, len(o.AcceptedCAs))
+		copy(cp.AcceptedCAs, o.AcceptedCAs)
+		for i2 := range o.AcceptedCAs {
+			if o.AcceptedCAs[i2] != nil {
+				cp.AcceptedCAs[i2] = o.AcceptedCAs[i2].DeepCopy()
+			}
+		}
+	}
+	if o.AcceptedServiceAccountKeys != nil {
+		cp.AcceptedServiceAccountKeys = make([]*x509.PEMEncodedKey, len(o.AcceptedServiceAccountKeys))
+		copy(cp.AcceptedServiceAccountKeys, o.AcceptedServiceAccountKeys)
+		for i2 := range o.AcceptedServiceAccountKeys {
+			if o.AcceptedServiceAccountKeys[i2] != nil {
+				cp.AcceptedServiceAccountKeys[i2] = o.AcceptedServiceAccountKeys[i2].DeepCopy()
+			}
+		}
+	}
+	if o.OIDCCA != nil {
+		cp.OIDCCA = o.OIDCCA.DeepCopy()
+	}
 	return cp
 }
 
@@ -159,6 +204,15 @@ func (o OSRootSpec) DeepCopy() OSRootSpec {
 		cp.CertSANDNSNames = make([]string, len(o.CertSANDNSNames))
 		copy(cp.CertSANDNSNames, o.CertSANDNSNames)
 	}
+	if o.AcceptedCAs != nil {
+		cp.AcceptedCAs = make([]*x509.PEMEncodedCertificateAndKey, len(o.AcceptedCAs))
+		copy(cp.AcceptedCAs, o.AcceptedCAs)
+		for i2 := range o.AcceptedCAs {
+			if o.AcceptedCAs[i2] != nil {
+				cp.AcceptedCAs[i2] = o.AcceptedCAs[i2].DeepCopy()
+			}
+		}
+	}
 	return cp
 }
 
@@ -35,6 +35,8 @@ type KubeletSpec struct {
 
 	BootstrapTokenID     string `yaml:"bootstrapTokenID" protobuf:"3"`
 	BootstrapTokenSecret string `yaml:"bootstrapTokenSecret" protobuf:"4"`
+
+	AcceptedCAs []*x509.PEMEncodedCertificateAndKey `yaml:"acceptedCAs,omitempty" protobuf:"5"`
 }
 
 // NewKubelet initializes a Kubelet resource.
@@ -7,6 +7,7 @@ package secrets
 import (
 	"net"
 	"net/url"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -47,6 +48,22 @@ type KubernetesRootSpec struct {
 	BootstrapTokenSecret string `yaml:"bootstrapTokenSecret" protobuf:"12"`
 
 	SecretboxEncryptionSecret string `yaml:"secretboxEncryptionSecret" protobuf:"13"`
+
+	KMSProviders []KubernetesKMSProvider `yaml:"kmsProviders,omitempty" protobuf:"14"`
+
+	AcceptedCAs                []*x509.PEMEncodedCertificateAndKey `yaml:"acceptedCAs,omitempty" protobuf:"15"`
+	AcceptedServiceAccountKeys []*x509.PEMEncodedKey               `yaml:"acceptedServiceAccountKeys,omitempty" protobuf:"16"`
+	OIDCCA                     *x509.PEMEncodedCertificateAndKey   `yaml:"oidcCA,omitempty" protobuf:"17"`
+}
+
+// KubernetesKMSProvider describes kube-apiserver KMS v2 encryption provider.
+//
+//gotagsrewrite:gen
+type KubernetesKMSProvider struct {
+	Name        string        `yaml:"name" protobuf:"1"`
+	Endpoint    string        `yaml:"endpoint" protobuf:"2"`
+	Timeout     time.Duration `yaml:"timeout" protobuf:"3"`
+	DecryptOnly bool          `yaml:"decryptOnly" protobuf:"4"`
 }
 
 // NewKubernetesRoot initializes a KubernetesRoot resource.
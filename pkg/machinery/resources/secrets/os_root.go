@@ -29,9 +29,10 @@ type OSRoot = typed.Resource[OSRootSpec, OSRootRD]
 //
 //gotagsrewrite:gen
 type OSRootSpec struct {
-	CA              *x509.PEMEncodedCertificateAndKey `yaml:"ca" protobuf:"1"`
-	CertSANIPs      []netip.Addr                      `yaml:"certSANIPs" protobuf:"2"`
-	CertSANDNSNames []string                          `yaml:"certSANDNSNames" protobuf:"3"`
+	CA              *x509.PEMEncodedCertificateAndKey   `yaml:"ca" protobuf:"1"`
+	CertSANIPs      []netip.Addr                        `yaml:"certSANIPs" protobuf:"2"`
+	CertSANDNSNames []string                            `yaml:"certSANDNSNames" protobuf:"3"`
+	AcceptedCAs     []*x509.PEMEncodedCertificateAndKey `yaml:"acceptedCAs,omitempty" protobuf:"5"`
 
 	Token string `yaml:"token" protobuf:"4"`
 }